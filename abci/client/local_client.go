@@ -23,14 +23,37 @@ var _ Client = (*localClient)(nil)
 // methods of the given app.
 //
 // The client methods ignore their context argument.
+//
+// If the app can hold its DB still while the node takes an online snapshot of
+// it, the client implements dbsync.SnapshotCoordinator.
 func NewLocalClient(logger log.Logger, app types.Application) Client {
 	cli := &localClient{
 		Application: app,
 	}
 	cli.BaseService = *service.NewBaseService(logger, "localClient", cli)
+	if coordinator, ok := app.(snapshotCoordinator); ok {
+		return &localSnapshotClient{localClient: cli, coordinator: coordinator}
+	}
 	return cli
 }
 
+// snapshotCoordinator is implemented by applications that can hold their DB
+// still while the node takes an online snapshot of it.
+type snapshotCoordinator interface {
+	CoordinateSnapshot(ctx context.Context, take func(height uint64) error) error
+}
+
+// localSnapshotClient is a local client whose application coordinates online
+// snapshots.
+type localSnapshotClient struct {
+	*localClient
+	coordinator snapshotCoordinator
+}
+
+func (cli *localSnapshotClient) CoordinateSnapshot(ctx context.Context, take func(height uint64) error) error {
+	return cli.coordinator.CoordinateSnapshot(ctx, take)
+}
+
 func (*localClient) OnStart(context.Context) error { return nil }
 func (*localClient) OnStop()                       {}
 func (*localClient) Error() error                  { return nil }
@@ -2793,6 +2793,12 @@ type ResponseCheckTx struct {
 	Codespace string `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
 	Sender    string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 	Priority  int64  `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// nonce orders the transactions of the same sender: the mempool reaps a
+	// sender's transactions in increasing nonce order. Only used if sender is set.
+	Nonce uint64 `protobuf:"varint,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// pending reports that nonce is ahead of the sender's next expected nonce.
+	// The mempool holds such a transaction back until its predecessor arrives.
+	Pending bool `protobuf:"varint,13,opt,name=pending,proto3" json:"pending,omitempty"`
 }
 
 func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
@@ -2877,6 +2883,20 @@ func (m *ResponseCheckTx) GetPriority() int64 {
 	return 0
 }
 
+func (m *ResponseCheckTx) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ResponseCheckTx) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
 type ResponseDeliverTx struct {
 	Code      uint32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Data      []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -4789,251 +4809,252 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3903 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x93, 0x1b, 0xc7,
-	0x79, 0xc7, 0xfb, 0xf1, 0xe1, 0x35, 0xdb, 0xbb, 0x24, 0x41, 0x50, 0x5a, 0xae, 0x46, 0xa6, 0x4c,
-	0xd1, 0xd2, 0xae, 0x43, 0x15, 0x15, 0xca, 0x54, 0x4c, 0xef, 0x03, 0x34, 0x96, 0x5c, 0xee, 0xae,
-	0x67, 0xc1, 0x15, 0x13, 0xbb, 0x3c, 0xea, 0x05, 0x7a, 0x81, 0x31, 0x01, 0xcc, 0x78, 0x66, 0xb0,
-	0xc2, 0xba, 0x2a, 0x87, 0xe4, 0x90, 0x52, 0x9c, 0x4a, 0x95, 0x0e, 0x39, 0x25, 0xf1, 0x3d, 0xc7,
-	0x1c, 0x7d, 0xc8, 0x25, 0x37, 0x57, 0x2a, 0x07, 0x57, 0x2a, 0x87, 0x9c, 0x9c, 0x14, 0x95, 0x5c,
-	0xf2, 0x07, 0xe4, 0x90, 0x53, 0xaa, 0x1f, 0xf3, 0x02, 0x06, 0x33, 0x80, 0xc8, 0x72, 0x95, 0x2b,
-	0xba, 0x4d, 0xf7, 0x7c, 0xdf, 0xd7, 0xd3, 0xdd, 0xdf, 0xab, 0x7f, 0x5f, 0x0f, 0xdc, 0xb0, 0xc9,
-	0xa8, 0x4b, 0xcc, 0xa1, 0x36, 0xb2, 0xb7, 0xf0, 0x59, 0x47, 0xdb, 0xb2, 0x2f, 0x0d, 0x62, 0x6d,
-	0x1a, 0xa6, 0x6e, 0xeb, 0x08, 0x59, 0x44, 0xf3, 0xde, 0x6f, 0xd2, 0xf7, 0x8d, 0x37, 0x7d, 0x0c,
-	0x1d, 0xf3, 0xd2, 0xb0, 0xf5, 0x2d, 0xc3, 0xd4, 0xf5, 0x73, 0xce, 0xd2, 0x78, 0x63, 0xf6, 0xf5,
-	0x0b, 0x72, 0x29, 0x04, 0x06, 0x98, 0xd9, 0x40, 0x5b, 0x06, 0x36, 0xf1, 0xd0, 0x0a, 0x61, 0xe6,
-	0xaf, 0x7d, 0x5f, 0xd3, 0xb8, 0xd9, 0xd3, 0xf5, 0xde, 0x80, 0x6c, 0xb1, 0xd6, 0xd9, 0xf8, 0x7c,
-	0xcb, 0xd6, 0x86, 0xc4, 0xb2, 0xf1, 0xd0, 0x10, 0x04, 0x6b, 0x3d, 0xbd, 0xa7, 0xb3, 0xc7, 0x2d,
-	0xfa, 0xc4, 0x7b, 0xe5, 0xcf, 0xcb, 0x90, 0x57, 0xc8, 0x4f, 0xc7, 0xc4, 0xb2, 0xd1, 0x3d, 0xc8,
-	0x90, 0x4e, 0x5f, 0xaf, 0x27, 0x37, 0x92, 0xb7, 0x4b, 0x77, 0x6f, 0x6e, 0xce, 0xce, 0x6f, 0x53,
-	0x90, 0x36, 0x3b, 0x7d, 0xbd, 0x95, 0x50, 0x18, 0x39, 0xba, 0x0f, 0xd9, 0xf3, 0xc1, 0xd8, 0xea,
-	0xd7, 0x53, 0x8c, 0x6f, 0x23, 0x82, 0xef, 0x11, 0xa5, 0x6b, 0x25, 0x14, 0xce, 0x40, 0x07, 0xd4,
-	0x46, 0xe7, 0x7a, 0x3d, 0x1d, 0x3b, 0xe0, 0xfe, 0xe8, 0x9c, 0x0d, 0x48, 0xc9, 0x51, 0x13, 0x40,
-	0x1b, 0x69, 0xb6, 0xda, 0xe9, 0x63, 0x6d, 0x54, 0xcf, 0x30, 0xe6, 0x6f, 0x44, 0x32, 0x6b, 0xf6,
-	0x2e, 0xa5, 0x6d, 0x25, 0x94, 0xa2, 0xe6, 0x34, 0xe8, 0x77, 0xff, 0x74, 0x4c, 0xcc, 0xcb, 0x7a,
-	0x36, 0xf6, 0xbb, 0x7f, 0x40, 0xe9, 0xe8, 0x77, 0x33, 0x06, 0xf4, 0x10, 0x0a, 0x9d, 0x3e, 0xe9,
-	0xbc, 0x50, 0xed, 0x49, 0x3d, 0xcf, 0x98, 0xe5, 0x08, 0xe6, 0x5d, 0x4a, 0xda, 0x9e, 0xb4, 0x12,
-	0x4a, 0xbe, 0xc3, 0x1f, 0xd1, 0x03, 0xc8, 0x75, 0xf4, 0xe1, 0x50, 0xb3, 0xeb, 0xc0, 0xd8, 0xdf,
-	0x8a, 0x62, 0x67, 0x84, 0xad, 0x84, 0x22, 0x58, 0xd0, 0x0f, 0xa0, 0x3a, 0xd0, 0x2c, 0x5b, 0xb5,
-	0x46, 0xd8, 0xb0, 0xfa, 0xba, 0x6d, 0xd5, 0x4b, 0x4c, 0xc8, 0xed, 0x08, 0x21, 0x07, 0x9a, 0x65,
-	0x9f, 0x38, 0xf4, 0xad, 0x84, 0x52, 0x19, 0xf8, 0x3b, 0xa8, 0x48, 0xfd, 0xfc, 0x9c, 0x98, 0xae,
-	0xcc, 0x7a, 0x39, 0x56, 0xe4, 0x11, 0x65, 0x70, 0x44, 0x50, 0x91, 0xba, 0xbf, 0x03, 0xfd, 0x18,
-	0x56, 0x07, 0x3a, 0xee, 0xba, 0x12, 0xd5, 0x4e, 0x7f, 0x3c, 0x7a, 0x51, 0xaf, 0x30, 0xb9, 0xef,
-	0x45, 0x7d, 0xaa, 0x8e, 0xbb, 0x8e, 0x94, 0x5d, 0xca, 0xd3, 0x4a, 0x28, 0x2b, 0x83, 0xe9, 0x4e,
-	0x84, 0x61, 0x0d, 0x1b, 0xc6, 0xe0, 0x72, 0x7a, 0x80, 0x2a, 0x1b, 0xe0, 0xfd, 0x88, 0x01, 0xb6,
-	0x29, 0xdb, 0xf4, 0x08, 0x08, 0xcf, 0xf4, 0xa2, 0x4f, 0x40, 0x32, 0x4c, 0x62, 0x60, 0x93, 0xa8,
-	0x86, 0xa9, 0x1b, 0xba, 0x85, 0x07, 0xf5, 0x1a, 0x13, 0x7f, 0x27, 0x42, 0xfc, 0x31, 0x67, 0x39,
-	0x16, 0x1c, 0xad, 0x84, 0x52, 0x33, 0x82, 0x5d, 0x5c, 0xb0, 0xde, 0x21, 0x96, 0xe5, 0x09, 0x96,
-	0x16, 0x10, 0xcc, 0x58, 0x82, 0x82, 0x03, 0x5d, 0xa8, 0x05, 0x25, 0x32, 0xa1, 0xec, 0xea, 0x85,
-	0x6e, 0x93, 0xfa, 0x0a, 0x93, 0x79, 0x2b, 0xca, 0x90, 0x19, 0xf5, 0xa9, 0x6e, 0x93, 0x56, 0x42,
-	0x01, 0xe2, 0xb6, 0x50, 0x17, 0xae, 0x5c, 0x10, 0x53, 0x3b, 0xbf, 0x64, 0x92, 0x54, 0xf6, 0xc6,
-	0xd2, 0xf4, 0x51, 0x1d, 0x31, 0x99, 0x9b, 0x11, 0x32, 0x4f, 0x19, 0x1f, 0x95, 0xd2, 0x74, 0xb8,
-	0x5a, 0x09, 0x65, 0xf5, 0x62, 0xb6, 0x9b, 0xea, 0xdd, 0xb9, 0x36, 0xc2, 0x03, 0xed, 0x67, 0x44,
-	0x3d, 0x1b, 0xe8, 0x9d, 0x17, 0xf5, 0xd5, 0x58, 0xbd, 0x7b, 0x24, 0x18, 0x76, 0x28, 0x3d, 0xd5,
-	0xbb, 0x73, 0x7f, 0x07, 0x5d, 0x82, 0x33, 0xd2, 0xd3, 0x46, 0x42, 0xde, 0x5a, 0xec, 0x12, 0xec,
-	0x50, 0x6a, 0x47, 0x18, 0x9c, 0xb9, 0x2d, 0xea, 0x66, 0xba, 0x64, 0xa0, 0x5d, 0x10, 0x93, 0xda,
-	0xf9, 0x95, 0x58, 0x37, 0xb3, 0xc7, 0x89, 0x99, 0xa5, 0x17, 0xbb, 0x4e, 0x03, 0xed, 0x40, 0x91,
-	0x6e, 0x08, 0xff, 0x9c, 0xab, 0x4c, 0xca, 0xdb, 0x51, 0x3b, 0x32, 0xea, 0x3a, 0x1f, 0x53, 0x20,
-	0xe2, 0x99, 0x4e, 0x8a, 0x19, 0xd3, 0x00, 0xdb, 0xc4, 0xb2, 0xeb, 0xd7, 0x62, 0x27, 0x45, 0x8d,
-	0xe8, 0x80, 0x11, 0xd3, 0x49, 0x0d, 0xdc, 0xd6, 0x4e, 0x1e, 0xb2, 0x17, 0x78, 0x30, 0x26, 0x8f,
-	0x33, 0x85, 0x9c, 0x94, 0x7f, 0x9c, 0x29, 0x14, 0xa4, 0xe2, 0xe3, 0x4c, 0xa1, 0x28, 0x81, 0xfc,
-	0x4d, 0x28, 0xf9, 0xdc, 0x3b, 0xaa, 0x43, 0x7e, 0x48, 0x2c, 0x0b, 0xf7, 0x08, 0x0b, 0x08, 0x45,
-	0xc5, 0x69, 0xca, 0x55, 0x28, 0xfb, 0xfd, 0xb9, 0xfc, 0x45, 0xd2, 0xe5, 0xa4, 0x7e, 0x9a, 0x72,
-	0x5e, 0x10, 0x93, 0x69, 0x8b, 0xe0, 0x14, 0x4d, 0xf4, 0x36, 0x54, 0xd8, 0x3a, 0xa8, 0xce, 0x7b,
-	0x1a, 0x32, 0x32, 0x4a, 0x99, 0x75, 0x9e, 0x0a, 0xa2, 0x9b, 0x50, 0x32, 0xee, 0x1a, 0x2e, 0x49,
-	0x9a, 0x91, 0x80, 0x71, 0xd7, 0x70, 0x08, 0xde, 0x82, 0x32, 0x9d, 0xab, 0x4b, 0x91, 0x61, 0x83,
-	0x94, 0x68, 0x9f, 0x20, 0x91, 0xff, 0x25, 0x05, 0xd2, 0xb4, 0xf7, 0x47, 0xf7, 0x21, 0x43, 0x83,
-	0xa2, 0x88, 0x6f, 0x8d, 0x4d, 0x1e, 0x31, 0x37, 0x9d, 0x88, 0xb9, 0xd9, 0x76, 0x22, 0xe6, 0x4e,
-	0xe1, 0x57, 0xbf, 0xb9, 0x99, 0xf8, 0xe2, 0xdf, 0x6f, 0x26, 0x15, 0xc6, 0x81, 0xae, 0x53, 0x87,
-	0x8f, 0xb5, 0x91, 0xaa, 0x75, 0xd9, 0x27, 0x17, 0xa9, 0x2b, 0xc7, 0xda, 0x68, 0xbf, 0x8b, 0x8e,
-	0x40, 0xea, 0xe8, 0x23, 0x8b, 0x8c, 0xac, 0xb1, 0xa5, 0xf2, 0x78, 0x2d, 0xe2, 0xd9, 0xb4, 0xae,
-	0xf0, 0x68, 0xbd, 0xeb, 0x10, 0x1f, 0x33, 0x5a, 0xa5, 0xd6, 0x09, 0x76, 0xa0, 0x7d, 0x80, 0x0b,
-	0x3c, 0xd0, 0xba, 0xd8, 0xd6, 0x4d, 0xab, 0x9e, 0xd9, 0x48, 0xcf, 0x53, 0x98, 0x53, 0x87, 0xea,
-	0x99, 0xd1, 0xc5, 0x36, 0xd9, 0xc9, 0xd0, 0x8f, 0x56, 0x7c, 0xcc, 0xe8, 0x1d, 0xa8, 0x61, 0xc3,
-	0x50, 0x2d, 0x1b, 0xdb, 0x44, 0x3d, 0xbb, 0xb4, 0x89, 0xc5, 0x62, 0x5d, 0x59, 0xa9, 0x60, 0xc3,
-	0x38, 0xa1, 0xbd, 0x3b, 0xb4, 0x13, 0xdd, 0x82, 0x2a, 0x0d, 0x8b, 0x1a, 0x1e, 0xa8, 0x7d, 0xa2,
-	0xf5, 0xfa, 0x76, 0x3d, 0xb7, 0x91, 0xbc, 0x9d, 0x56, 0x2a, 0xa2, 0xb7, 0xc5, 0x3a, 0xe5, 0xae,
-	0xbb, 0xef, 0x2c, 0x1e, 0x22, 0x04, 0x99, 0x2e, 0xb6, 0x31, 0x5b, 0xcf, 0xb2, 0xc2, 0x9e, 0x69,
-	0x9f, 0x81, 0xed, 0xbe, 0x58, 0x25, 0xf6, 0x8c, 0xae, 0x42, 0x4e, 0x88, 0x4d, 0x33, 0xb1, 0xa2,
-	0x85, 0xd6, 0x20, 0x6b, 0x98, 0xfa, 0x05, 0x61, 0x1b, 0x58, 0x50, 0x78, 0x43, 0x7e, 0x06, 0xd5,
-	0x60, 0xe0, 0x44, 0x55, 0x48, 0xd9, 0x13, 0x31, 0x4a, 0xca, 0x9e, 0xa0, 0x0f, 0x20, 0x43, 0xd7,
-	0x92, 0x8d, 0x51, 0x0d, 0x4f, 0x1b, 0x04, 0x6b, 0xfb, 0xd2, 0x20, 0x0a, 0x23, 0x96, 0x6b, 0x50,
-	0x09, 0x04, 0x54, 0xf9, 0x2a, 0xac, 0x85, 0x05, 0x47, 0xf9, 0x85, 0xdb, 0x1f, 0x88, 0x70, 0xe8,
-	0x3e, 0x14, 0xdc, 0xe8, 0xc8, 0x35, 0xe8, 0x8d, 0xb0, 0x91, 0x1d, 0x7a, 0xc5, 0xa5, 0xa6, 0xda,
-	0x43, 0xb7, 0xa1, 0x8f, 0x45, 0x8e, 0x54, 0x56, 0xf2, 0xd8, 0x30, 0x5a, 0xd8, 0xea, 0xcb, 0x9f,
-	0x42, 0x7d, 0x5e, 0xd8, 0xf3, 0x2d, 0x5b, 0x92, 0x99, 0x80, 0xb3, 0x6c, 0x57, 0x21, 0x77, 0xae,
-	0x9b, 0x43, 0x6c, 0x33, 0x61, 0x15, 0x45, 0xb4, 0xe8, 0x72, 0xf2, 0x10, 0x98, 0x66, 0xdd, 0xbc,
-	0x21, 0xab, 0x70, 0x7d, 0x6e, 0xdc, 0xa3, 0x2c, 0xda, 0xa8, 0x4b, 0xf8, 0xe2, 0x56, 0x14, 0xde,
-	0xf0, 0x04, 0xf1, 0x8f, 0xe5, 0x0d, 0x3a, 0xac, 0xc5, 0xe6, 0xca, 0xe4, 0x17, 0x15, 0xd1, 0x92,
-	0xff, 0x39, 0x07, 0x57, 0xc3, 0x43, 0x1f, 0xda, 0x80, 0xf2, 0x10, 0x4f, 0x54, 0x7b, 0x22, 0x94,
-	0x2f, 0xc9, 0xb6, 0x1f, 0x86, 0x78, 0xd2, 0x9e, 0x70, 0xcd, 0x93, 0x20, 0x6d, 0x4f, 0xac, 0x7a,
-	0x6a, 0x23, 0x7d, 0xbb, 0xac, 0xd0, 0x47, 0xf4, 0x1c, 0x56, 0x06, 0x7a, 0x07, 0x0f, 0xd4, 0x01,
-	0xb6, 0x6c, 0x55, 0x64, 0x49, 0xdc, 0xa0, 0xde, 0x09, 0x5b, 0x6f, 0x1e, 0xc1, 0x48, 0x97, 0xef,
-	0x2a, 0xf5, 0x3f, 0xc2, 0x10, 0x6a, 0x4c, 0xcc, 0x01, 0x76, 0x36, 0x1c, 0x3d, 0x87, 0xb5, 0xb3,
-	0xcb, 0x9f, 0xe1, 0x91, 0xad, 0x8d, 0x88, 0x3a, 0x63, 0x62, 0xa1, 0x6a, 0xf4, 0x54, 0xb3, 0xce,
-	0x48, 0x1f, 0x5f, 0x68, 0xba, 0x29, 0xa4, 0xae, 0xba, 0x22, 0x4e, 0x3d, 0x3b, 0xf3, 0x76, 0x2a,
-	0x1b, 0x50, 0x70, 0xc7, 0xe1, 0xe4, 0x96, 0x76, 0x38, 0xdf, 0x86, 0xb5, 0x11, 0x99, 0xd8, 0xbe,
-	0xcf, 0xe4, 0xea, 0x93, 0x67, 0x3b, 0x82, 0xe8, 0x3b, 0x6f, 0x7c, 0xaa, 0x49, 0xe8, 0x5d, 0x96,
-	0x53, 0x18, 0xba, 0x45, 0x4c, 0x15, 0x77, 0xbb, 0x26, 0xb1, 0xac, 0x7a, 0x81, 0x51, 0xd7, 0x9c,
-	0xfe, 0x6d, 0xde, 0x1d, 0xd0, 0xc7, 0x62, 0x40, 0x1f, 0xd1, 0x37, 0xa1, 0x36, 0x3d, 0x24, 0x30,
-	0x8a, 0xea, 0x45, 0x70, 0xb8, 0x5b, 0x50, 0xf5, 0xdc, 0x1e, 0xa3, 0x2b, 0x71, 0xcf, 0xe2, 0xf6,
-	0x32, 0xb2, 0x1b, 0x50, 0xa4, 0x6e, 0x81, 0x53, 0x94, 0x19, 0x45, 0x81, 0x76, 0xb0, 0x97, 0x6f,
-	0x43, 0x85, 0x5c, 0x68, 0x5d, 0x32, 0xea, 0x10, 0x4e, 0x50, 0x61, 0x04, 0x65, 0xa7, 0x93, 0x11,
-	0xbd, 0x03, 0x35, 0xa6, 0x09, 0x3c, 0x6e, 0x30, 0xb2, 0x2a, 0x1f, 0x89, 0x76, 0xf3, 0x50, 0x49,
-	0xe9, 0xee, 0xc3, 0x75, 0x1f, 0x9d, 0x81, 0x4d, 0x5b, 0xb5, 0x88, 0xad, 0xda, 0xba, 0x2d, 0xb2,
-	0xb6, 0xb4, 0x72, 0xc5, 0xe5, 0x38, 0xc6, 0xa6, 0x7d, 0x42, 0xec, 0x36, 0x7d, 0x89, 0x3e, 0x84,
-	0x7a, 0x18, 0x27, 0x1b, 0x4a, 0x62, 0x43, 0xad, 0x4d, 0x33, 0xb2, 0x11, 0x6f, 0x83, 0xe4, 0xd3,
-	0x51, 0x4e, 0xbf, 0xc2, 0x17, 0x6b, 0xe0, 0x6a, 0x1d, 0xa3, 0xbc, 0x03, 0x2b, 0x8c, 0xd2, 0x24,
-	0xd6, 0x78, 0x60, 0x8b, 0xf5, 0x42, 0x7c, 0x73, 0xe8, 0x0b, 0x85, 0xf7, 0x33, 0x8f, 0xf0, 0x0f,
-	0x7e, 0x73, 0x0a, 0x66, 0x77, 0xc2, 0x58, 0x92, 0x9e, 0xb1, 0x9c, 0xc2, 0x9a, 0xd8, 0xdc, 0x6e,
-	0xc0, 0x5e, 0xf8, 0x49, 0x6c, 0x3d, 0xd4, 0x33, 0x4e, 0xdb, 0x09, 0x72, 0x24, 0x2c, 0x60, 0x2a,
-	0xe9, 0x57, 0x36, 0x15, 0x04, 0x19, 0x36, 0xfb, 0x0c, 0x8f, 0x19, 0xf4, 0xf9, 0x77, 0xd9, 0x7c,
-	0x20, 0xd6, 0x7c, 0x4a, 0x0b, 0x9a, 0x4f, 0x39, 0xd6, 0x7c, 0x2a, 0x71, 0xe6, 0x53, 0x5d, 0xcc,
-	0x7c, 0x6a, 0x4b, 0x9b, 0x8f, 0xf4, 0x55, 0xcd, 0x67, 0x65, 0x49, 0xf3, 0x41, 0x8b, 0x9b, 0xcf,
-	0x6a, 0xb8, 0xf9, 0x3c, 0x84, 0x95, 0x99, 0xa3, 0x8d, 0xab, 0x74, 0xc9, 0x50, 0xa5, 0x4b, 0xf9,
-	0x95, 0x4e, 0xfe, 0xdb, 0x24, 0x34, 0xe6, 0x1f, 0x64, 0x42, 0x45, 0x7d, 0x0b, 0x56, 0xdc, 0xed,
-	0x75, 0x95, 0x87, 0xc7, 0x4e, 0xc9, 0x7d, 0xe1, 0x68, 0xcf, 0xbc, 0x64, 0xe8, 0x16, 0x54, 0xa7,
-	0x4e, 0x5a, 0xdc, 0x44, 0x2a, 0x17, 0xfe, 0xf1, 0xe5, 0x5f, 0xe6, 0xdc, 0xf4, 0x24, 0x70, 0x10,
-	0x0a, 0x71, 0x0e, 0x6d, 0x58, 0xed, 0x92, 0x8e, 0xd6, 0x7d, 0x05, 0xdf, 0xb0, 0x22, 0x04, 0x7c,
-	0xed, 0x1a, 0xbe, 0x76, 0x0d, 0xbf, 0xf3, 0xae, 0xe1, 0x2f, 0x53, 0xae, 0x6f, 0xf0, 0xce, 0xfc,
-	0xa1, 0x06, 0xfd, 0x11, 0xd5, 0x3a, 0x4c, 0x53, 0x5d, 0x6e, 0x2c, 0x37, 0x42, 0x4f, 0x72, 0x2d,
-	0x46, 0x22, 0x34, 0x5a, 0x30, 0x20, 0x25, 0xf8, 0xe9, 0x3e, 0x78, 0x33, 0x14, 0x22, 0xf4, 0x0c,
-	0xcb, 0x67, 0x75, 0xbe, 0x49, 0xb2, 0xf3, 0xf4, 0xb3, 0xc8, 0xc4, 0x35, 0xf4, 0x14, 0xd2, 0x14,
-	0x1b, 0x1d, 0x61, 0x6f, 0x72, 0xd3, 0x3d, 0x22, 0xbb, 0xc8, 0xc5, 0xcc, 0x51, 0xeb, 0x2d, 0x28,
-	0x5b, 0x5a, 0x4f, 0x65, 0xd8, 0x8d, 0x46, 0xf8, 0xe1, 0xb7, 0xa0, 0x94, 0x2c, 0xad, 0x77, 0x2a,
-	0xba, 0xe4, 0x77, 0xa1, 0x36, 0x05, 0x5d, 0x4c, 0x9d, 0x5c, 0x3c, 0xdf, 0xba, 0xea, 0x6e, 0x80,
-	0x87, 0x4f, 0xc8, 0xff, 0x59, 0x86, 0x82, 0x42, 0x2c, 0x83, 0x6a, 0x37, 0x6a, 0x42, 0x91, 0x4c,
-	0x3a, 0xc4, 0xb0, 0x1d, 0xf0, 0x60, 0x2e, 0xcc, 0xc1, 0x19, 0x9a, 0x0e, 0x71, 0x2b, 0xa1, 0x78,
-	0x9c, 0xe8, 0x43, 0x81, 0x64, 0x47, 0x22, 0xd2, 0x42, 0x82, 0x1f, 0xca, 0xfe, 0xc8, 0x81, 0xb2,
-	0xd3, 0x51, 0xb0, 0x2c, 0x67, 0x9c, 0xc2, 0xb2, 0x3f, 0x14, 0x58, 0x76, 0x26, 0x7e, 0xc8, 0x00,
-	0x98, 0xfd, 0x28, 0x00, 0x66, 0x67, 0xe3, 0xa7, 0x3c, 0x07, 0xcd, 0xfe, 0xc8, 0x41, 0xb3, 0x73,
-	0xf1, 0x9f, 0x3e, 0x05, 0x67, 0x7f, 0xcf, 0x07, 0x67, 0x17, 0xa2, 0x00, 0x2a, 0xce, 0x1d, 0x82,
-	0x67, 0x7f, 0xec, 0xe2, 0xd9, 0xa5, 0x28, 0x38, 0x5c, 0xf0, 0x4f, 0x03, 0xda, 0xca, 0x0c, 0xa0,
-	0xcd, 0xd1, 0xe7, 0x77, 0xa3, 0xa4, 0xc4, 0x20, 0xda, 0xca, 0x0c, 0xa2, 0x5d, 0x89, 0x97, 0x19,
-	0x03, 0x69, 0xab, 0xe1, 0x90, 0x76, 0x24, 0xe2, 0x2c, 0x3e, 0x76, 0x31, 0x4c, 0xfb, 0x6c, 0x0e,
-	0xa6, 0x5d, 0x8b, 0xc2, 0x5c, 0xf9, 0x08, 0x0b, 0x83, 0xda, 0xcf, 0x43, 0x40, 0x6d, 0x8e, 0x3d,
-	0x7f, 0x2b, 0x4a, 0xfe, 0x02, 0xa8, 0xf6, 0xf3, 0x10, 0x54, 0x7b, 0x65, 0x11, 0xc9, 0xb1, 0xb0,
-	0xf6, 0x7e, 0x10, 0xd6, 0x46, 0xf3, 0xd1, 0x00, 0xcf, 0x2f, 0xcc, 0xc1, 0xb5, 0xc9, 0x3c, 0x5c,
-	0x9b, 0x03, 0xcf, 0x5b, 0x51, 0x42, 0x97, 0x00, 0xb6, 0x95, 0x19, 0x60, 0x7b, 0x2d, 0x5e, 0xfd,
-	0x62, 0x90, 0xed, 0xfd, 0x20, 0xb2, 0x7d, 0x25, 0x7e, 0x15, 0xe6, 0x42, 0xdb, 0x8f, 0x02, 0xd0,
-	0xf6, 0xd5, 0x78, 0xa7, 0x33, 0x07, 0xdb, 0xde, 0xf5, 0x63, 0xdb, 0xd7, 0xa2, 0x10, 0x72, 0xb1,
-	0x2d, 0x61, 0xe0, 0xf6, 0x7e, 0x10, 0xdc, 0xae, 0xc7, 0xcf, 0x6b, 0x11, 0x74, 0x3b, 0x2f, 0x15,
-	0x38, 0xae, 0xfd, 0x38, 0x53, 0x00, 0xa9, 0x24, 0xbf, 0x4b, 0x63, 0xcf, 0x54, 0xd0, 0x40, 0x6b,
-	0x90, 0x25, 0xa6, 0xa9, 0x9b, 0x02, 0xa7, 0xe6, 0x0d, 0xf9, 0x36, 0x94, 0xfd, 0xd1, 0x21, 0x02,
-	0x09, 0x67, 0xa0, 0xa2, 0x2f, 0x1c, 0xc8, 0xbf, 0x4c, 0x7a, 0xbc, 0x2c, 0x76, 0xfb, 0x31, 0xd2,
-	0xa2, 0xc0, 0x48, 0x7d, 0xf8, 0x78, 0x2a, 0x88, 0x8f, 0xdf, 0x84, 0x12, 0xcd, 0x1f, 0xa7, 0xa0,
-	0x6f, 0x6c, 0xb8, 0xd0, 0xb7, 0x93, 0xef, 0x88, 0x9c, 0x8d, 0x07, 0xd9, 0x0c, 0x0b, 0xb2, 0x35,
-	0x2f, 0x6b, 0xe3, 0x39, 0xf2, 0xfb, 0xb0, 0xea, 0xa3, 0x75, 0xf3, 0x52, 0x8e, 0x00, 0x4b, 0x2e,
-	0xf5, 0xb6, 0x80, 0x22, 0xff, 0x29, 0xe9, 0xad, 0x90, 0x87, 0x99, 0x87, 0xc1, 0xdb, 0xc9, 0xd7,
-	0x07, 0x6f, 0xa7, 0x5e, 0x05, 0xde, 0xf6, 0x67, 0xdb, 0xe9, 0x20, 0xae, 0xfa, 0xbf, 0x49, 0x6f,
-	0x67, 0x5c, 0xb0, 0xba, 0xa3, 0x77, 0x89, 0x40, 0x3a, 0xd9, 0x33, 0x3d, 0x33, 0x0d, 0xf4, 0x9e,
-	0xc0, 0x33, 0xe9, 0x23, 0xa5, 0x72, 0xa3, 0x78, 0x51, 0x44, 0x68, 0x17, 0x24, 0xe5, 0x47, 0x10,
-	0x01, 0x92, 0x4a, 0x90, 0x7e, 0x41, 0x78, 0xb4, 0x2d, 0x2b, 0xf4, 0x91, 0xd2, 0x31, 0xe5, 0x13,
-	0x47, 0x09, 0xde, 0x40, 0x0f, 0xa0, 0xc8, 0x6e, 0x00, 0xa8, 0xba, 0x61, 0x89, 0xe8, 0x3a, 0x7d,
-	0xf6, 0xe2, 0x37, 0x01, 0x36, 0x8f, 0x29, 0xd9, 0x91, 0x61, 0x29, 0x05, 0x43, 0x3c, 0xf9, 0x12,
-	0xa9, 0x62, 0xe0, 0xf8, 0xf3, 0x06, 0x14, 0xe9, 0x04, 0x2c, 0x03, 0x77, 0x08, 0x3b, 0x68, 0x14,
-	0x15, 0xaf, 0x43, 0xc6, 0x80, 0x66, 0x3d, 0x00, 0x7a, 0x02, 0x39, 0x72, 0x41, 0x46, 0x36, 0x3f,
-	0x23, 0x96, 0xee, 0x5e, 0x0f, 0xcf, 0x1b, 0xc9, 0xc8, 0xde, 0xa9, 0xd3, 0xa5, 0xfe, 0xef, 0xdf,
-	0xdc, 0x94, 0x38, 0xc3, 0x7b, 0xfa, 0x50, 0xb3, 0xc9, 0xd0, 0xb0, 0x2f, 0x15, 0x21, 0x42, 0xfe,
-	0xd7, 0x24, 0xcd, 0xfa, 0x02, 0xf9, 0x40, 0xe8, 0x0a, 0x3b, 0xea, 0x9f, 0xf2, 0x95, 0x08, 0x66,
-	0x57, 0xfd, 0x4d, 0x80, 0x1e, 0xb6, 0xd4, 0xcf, 0xf0, 0xc8, 0x26, 0x5d, 0xb1, 0xcc, 0xc5, 0x1e,
-	0xb6, 0x3e, 0x61, 0x1d, 0xc1, 0xd9, 0x16, 0xa6, 0x66, 0xeb, 0xc3, 0xa5, 0x8b, 0x7e, 0x5c, 0x1a,
-	0x35, 0xa0, 0x60, 0x98, 0x9a, 0x6e, 0x6a, 0xf6, 0x25, 0x5b, 0xa2, 0xb4, 0xe2, 0xb6, 0x1f, 0x67,
-	0x0a, 0x19, 0x29, 0xeb, 0x96, 0xc0, 0xb8, 0xab, 0x28, 0x49, 0x65, 0xf9, 0xf3, 0x94, 0x67, 0x03,
-	0x5e, 0x52, 0xfc, 0xd5, 0x27, 0x16, 0xa6, 0x4e, 0xeb, 0x21, 0x93, 0xf5, 0xf5, 0xd0, 0xef, 0xa6,
-	0xad, 0xb1, 0x45, 0xba, 0xa2, 0x0c, 0xe3, 0xb6, 0x7d, 0x7b, 0x98, 0x7f, 0xe5, 0x3d, 0x8c, 0x5e,
-	0x56, 0xf9, 0xaf, 0x58, 0x05, 0x2d, 0xe8, 0xb6, 0xd1, 0xa9, 0x1f, 0xe9, 0x18, 0x33, 0xbb, 0x74,
-	0xd4, 0x69, 0x09, 0x1b, 0xf6, 0x40, 0x11, 0xde, 0x6d, 0xa1, 0x1f, 0xc2, 0xb5, 0x29, 0x2f, 0xe3,
-	0x4a, 0x4f, 0xcd, 0x4f, 0x48, 0xa7, 0x7d, 0xcd, 0x95, 0xa0, 0xaf, 0x71, 0x84, 0x7b, 0x8b, 0x96,
-	0x7e, 0x75, 0xc5, 0xbf, 0x07, 0xd5, 0x60, 0x1e, 0x4b, 0xcf, 0xda, 0x26, 0xb1, 0xb1, 0x36, 0x52,
-	0x03, 0xb8, 0x4e, 0x99, 0x77, 0x8a, 0xd2, 0xd9, 0x09, 0x5c, 0x09, 0x4d, 0x5c, 0xd1, 0x77, 0xa0,
-	0xe8, 0xa5, 0xbd, 0xc9, 0xf9, 0x07, 0x3a, 0xb7, 0xac, 0xe4, 0x91, 0xcb, 0xff, 0x98, 0xf4, 0xa4,
-	0x06, 0x6b, 0x55, 0x2d, 0xc8, 0xf1, 0x13, 0x31, 0xd3, 0xd9, 0xea, 0xdd, 0x6f, 0x2f, 0x9c, 0xf5,
-	0x6e, 0xf2, 0x13, 0xb3, 0x22, 0xf8, 0xe5, 0x1f, 0x43, 0x8e, 0xf7, 0xa0, 0x12, 0xe4, 0x9f, 0x1d,
-	0x3e, 0x39, 0x3c, 0xfa, 0xe4, 0x50, 0x4a, 0x20, 0x80, 0xdc, 0xf6, 0xee, 0x6e, 0xf3, 0xb8, 0x2d,
-	0x25, 0x51, 0x11, 0xb2, 0xdb, 0x3b, 0x47, 0x4a, 0x5b, 0x4a, 0xd1, 0x6e, 0xa5, 0xf9, 0xb8, 0xb9,
-	0xdb, 0x96, 0xd2, 0x68, 0x05, 0x2a, 0xfc, 0x59, 0x7d, 0x74, 0xa4, 0x3c, 0xdd, 0x6e, 0x4b, 0x19,
-	0x5f, 0xd7, 0x49, 0xf3, 0x70, 0xaf, 0xa9, 0x48, 0x59, 0xf9, 0xf7, 0xe0, 0xfa, 0xdc, 0x24, 0xd9,
-	0x2b, 0x44, 0x25, 0x7d, 0x85, 0x28, 0xf9, 0x6f, 0x52, 0xd0, 0x98, 0x9f, 0xf6, 0xa2, 0xa7, 0x53,
-	0x73, 0xbf, 0xb7, 0x5c, 0xda, 0x3c, 0xb5, 0x00, 0xe8, 0x16, 0x54, 0x4d, 0x72, 0x4e, 0xec, 0x4e,
-	0x9f, 0x27, 0xe3, 0x3c, 0x66, 0x55, 0x94, 0x8a, 0xe8, 0x65, 0x4c, 0x16, 0x27, 0xfb, 0x09, 0xe9,
-	0xd8, 0x2a, 0x77, 0x3f, 0x5c, 0xd9, 0x8a, 0x94, 0x8c, 0xf6, 0x9e, 0xf0, 0x4e, 0xf9, 0xd3, 0xa5,
-	0x96, 0xb3, 0x08, 0x59, 0xa5, 0xd9, 0x56, 0xfe, 0x50, 0x4a, 0x23, 0x04, 0x55, 0xf6, 0xa8, 0x9e,
-	0x1c, 0x6e, 0x1f, 0x9f, 0xb4, 0x8e, 0xe8, 0x72, 0xae, 0x42, 0xcd, 0x59, 0x4e, 0xa7, 0x33, 0x2b,
-	0xbf, 0x4c, 0xc1, 0xb5, 0x39, 0x49, 0x3b, 0x7a, 0x00, 0x60, 0x4f, 0x54, 0x93, 0x74, 0x74, 0xb3,
-	0x1b, 0xa9, 0x6d, 0xed, 0x89, 0xc2, 0x88, 0x94, 0xa2, 0x2d, 0x9e, 0xac, 0x88, 0x2a, 0x26, 0x7a,
-	0x28, 0xe4, 0x32, 0xac, 0x45, 0x58, 0xd9, 0x46, 0x78, 0xb1, 0x8e, 0x74, 0xa8, 0x6c, 0xb6, 0xc2,
-	0x4c, 0x36, 0x63, 0x41, 0xc7, 0x61, 0x7e, 0x65, 0xf1, 0xd2, 0x77, 0x88, 0x47, 0xf9, 0xd1, 0x7c,
-	0x8f, 0x92, 0x5d, 0x22, 0x7d, 0x09, 0x77, 0x29, 0xf2, 0xdf, 0xa7, 0xfd, 0x8b, 0x1c, 0x3c, 0xac,
-	0x28, 0x90, 0xb3, 0x6c, 0x6c, 0x8f, 0x2d, 0xa1, 0x7f, 0xdf, 0x59, 0xe2, 0xf0, 0xb3, 0xe9, 0x3c,
-	0x9c, 0x30, 0x09, 0x8a, 0x90, 0xf4, 0xf5, 0xda, 0xfb, 0xd6, 0xfe, 0x1e, 0x54, 0x83, 0xab, 0x34,
-	0xdf, 0x94, 0x3c, 0x77, 0x94, 0x92, 0x1f, 0x78, 0x49, 0x91, 0xaf, 0x32, 0x30, 0x8b, 0xba, 0x27,
-	0xc3, 0x50, 0xf7, 0xbf, 0x4b, 0xc2, 0x8d, 0x88, 0x53, 0x20, 0x6a, 0x4f, 0xed, 0xf9, 0xc7, 0x4b,
-	0x1e, 0x23, 0x37, 0x79, 0x5f, 0x70, 0xd7, 0xe5, 0x0f, 0xa0, 0xec, 0xef, 0x5f, 0x6c, 0x9e, 0xff,
-	0x93, 0xf2, 0x82, 0x42, 0xb0, 0x42, 0xf0, 0x3a, 0x13, 0xc0, 0x29, 0xb5, 0x4b, 0x2d, 0xaf, 0x76,
-	0xa1, 0xa9, 0x44, 0xfa, 0xd5, 0x53, 0x89, 0x08, 0xe5, 0xcb, 0xbc, 0xb2, 0xf2, 0x05, 0x0c, 0x31,
-	0x1b, 0x3c, 0x72, 0xac, 0x79, 0x0a, 0xe6, 0x43, 0x37, 0x7f, 0x04, 0xe0, 0x43, 0x72, 0xd7, 0x20,
-	0x6b, 0xea, 0xe3, 0x51, 0x97, 0xa9, 0x49, 0x56, 0xe1, 0x0d, 0x74, 0x1f, 0xb2, 0x54, 0xdd, 0x9c,
-	0x65, 0x0c, 0xf5, 0xc8, 0x54, 0x5d, 0x7c, 0x10, 0x31, 0x67, 0x90, 0x3f, 0x85, 0x6a, 0x10, 0x41,
-	0x7e, 0xed, 0x23, 0x0c, 0x00, 0xcd, 0xde, 0xb0, 0x98, 0x33, 0xca, 0xf7, 0x82, 0xa3, 0x7c, 0x23,
-	0xea, 0xba, 0x46, 0xf8, 0x68, 0x7f, 0x0c, 0x59, 0xa6, 0x80, 0x34, 0x71, 0x66, 0x57, 0x7c, 0xc4,
-	0xb1, 0x99, 0x3e, 0xa3, 0x4f, 0x01, 0xb0, 0x6d, 0x9b, 0xda, 0xd9, 0xd8, 0x1b, 0x43, 0x9e, 0xab,
-	0xc3, 0xdb, 0x0e, 0xe9, 0xce, 0x1b, 0x42, 0x99, 0xd7, 0x3c, 0x6e, 0x9f, 0x42, 0xfb, 0x64, 0xca,
-	0x87, 0x50, 0x0d, 0xf2, 0x3a, 0xa7, 0xbc, 0x64, 0xc8, 0x29, 0x2f, 0xe5, 0x3f, 0xe5, 0xb9, 0x67,
-	0xc4, 0x34, 0xbf, 0xca, 0xc4, 0x1a, 0xf2, 0x9f, 0xa4, 0xa0, 0xec, 0xd7, 0xff, 0xd7, 0x7c, 0x92,
-	0x88, 0x39, 0x36, 0x5d, 0x9f, 0x39, 0x48, 0xe4, 0x7b, 0xd8, 0x7a, 0xf6, 0x5b, 0x3e, 0x47, 0x7c,
-	0x9e, 0x84, 0x82, 0x3b, 0xff, 0x39, 0x85, 0x01, 0x6f, 0xf9, 0x52, 0xfe, 0x7b, 0x48, 0xbc, 0x18,
-	0x91, 0x76, 0x8b, 0x11, 0xdf, 0x75, 0x33, 0xbb, 0x08, 0x90, 0xdd, 0xbf, 0xde, 0x4e, 0x6d, 0x46,
-	0xe4, 0xb2, 0x7f, 0x2d, 0x3e, 0x85, 0xe6, 0x33, 0xe8, 0x0f, 0x20, 0x87, 0x3b, 0x6e, 0x99, 0xa1,
-	0x1a, 0x0e, 0x7f, 0x39, 0xd4, 0x9b, 0xed, 0xc9, 0x36, 0x23, 0x56, 0x04, 0x93, 0xf8, 0xb6, 0x94,
-	0xf3, 0x6d, 0xf2, 0x43, 0x2a, 0x9a, 0xd3, 0x04, 0xfd, 0x74, 0x15, 0xe0, 0xd9, 0xe1, 0xd3, 0xa3,
-	0xbd, 0xfd, 0x47, 0xfb, 0xcd, 0x3d, 0x91, 0xde, 0xed, 0xed, 0x35, 0xf7, 0xa4, 0x14, 0xa5, 0x53,
-	0x9a, 0x4f, 0x8f, 0x4e, 0x9b, 0x7b, 0x52, 0x5a, 0xde, 0x85, 0x92, 0x53, 0x07, 0xc3, 0x43, 0x0b,
-	0xdd, 0x80, 0xe2, 0x10, 0x07, 0xef, 0x4d, 0x15, 0x86, 0x58, 0xdc, 0x9a, 0xba, 0x06, 0x79, 0xfa,
-	0xb2, 0x87, 0x2d, 0xa7, 0x78, 0x3d, 0xc4, 0x93, 0xef, 0x63, 0x4b, 0xfe, 0x8b, 0x14, 0xd4, 0xa6,
-	0x3c, 0x19, 0xba, 0x07, 0x59, 0x8e, 0xcf, 0x45, 0x5c, 0xeb, 0xf7, 0x8d, 0xac, 0x70, 0x6a, 0xf4,
-	0x10, 0x0a, 0x4e, 0xb5, 0x70, 0xce, 0x19, 0x8c, 0x3b, 0x4e, 0xa7, 0xd2, 0x24, 0xb8, 0x5d, 0x26,
-	0xb4, 0x03, 0x45, 0xd7, 0x39, 0x47, 0xde, 0x88, 0x74, 0x3d, 0xbb, 0x10, 0xe1, 0xb1, 0xa1, 0x8f,
-	0x3d, 0xa4, 0x2c, 0x13, 0x5a, 0x58, 0x10, 0x12, 0x38, 0x8d, 0xe0, 0x77, 0x58, 0xe4, 0x07, 0x50,
-	0x74, 0x65, 0xa3, 0x3a, 0xe4, 0x9d, 0xe2, 0x6d, 0x52, 0x38, 0x6e, 0x51, 0xb4, 0x5d, 0x83, 0xac,
-	0xa1, 0x7f, 0x26, 0xee, 0xb5, 0xa5, 0x15, 0xde, 0x90, 0x7b, 0x50, 0x9b, 0x0a, 0x39, 0xe8, 0xbb,
-	0x90, 0x37, 0xc6, 0x67, 0xaa, 0xe3, 0x10, 0x66, 0xd7, 0xd2, 0x01, 0x72, 0xc6, 0x67, 0x03, 0xad,
-	0xf3, 0x84, 0x5c, 0x3a, 0xfa, 0x67, 0x8c, 0xcf, 0x9e, 0x70, 0xd7, 0xc1, 0x07, 0x4a, 0xf9, 0x07,
-	0xba, 0x84, 0x82, 0xe3, 0x0c, 0xd1, 0xb6, 0x7f, 0xcd, 0xf8, 0x18, 0x6f, 0x46, 0x06, 0x43, 0x31,
-	0x82, 0x6f, 0xc9, 0xee, 0xc0, 0x8a, 0xa5, 0xf5, 0x46, 0x4e, 0xd1, 0x9f, 0x6f, 0x3d, 0x2f, 0xdb,
-	0xd5, 0xf8, 0x8b, 0x03, 0x07, 0xf9, 0xa3, 0x69, 0x8d, 0x34, 0xed, 0x90, 0x7f, 0xcb, 0xdf, 0x10,
-	0x92, 0x81, 0xa5, 0xc3, 0x32, 0xb0, 0x3f, 0x4f, 0x41, 0xc9, 0x77, 0x8f, 0x00, 0xfd, 0xbe, 0x2f,
-	0x40, 0x54, 0xc3, 0x33, 0x06, 0x1f, 0xb9, 0x77, 0x0f, 0x34, 0x38, 0xbd, 0xd4, 0x57, 0x9a, 0xde,
-	0xbc, 0x2b, 0x1c, 0xce, 0xa5, 0x84, 0xcc, 0xd2, 0x97, 0x12, 0xde, 0x03, 0xc4, 0xca, 0xe9, 0xea,
-	0x85, 0x6e, 0x6b, 0xa3, 0x9e, 0xca, 0xd5, 0x84, 0x7b, 0x74, 0x89, 0xbd, 0x39, 0x65, 0x2f, 0x8e,
-	0x99, 0xc6, 0xfc, 0x59, 0x0a, 0x0a, 0x8e, 0xd9, 0xfd, 0xbf, 0x5e, 0x88, 0x3f, 0x4d, 0x42, 0xc1,
-	0xc5, 0x3c, 0x96, 0xbd, 0x2e, 0x7b, 0x15, 0x72, 0xe2, 0x40, 0xcf, 0xef, 0xcb, 0x8a, 0x56, 0xe8,
-	0x35, 0x94, 0x06, 0x14, 0x86, 0xc4, 0xc6, 0x2c, 0x4e, 0xf3, 0xb4, 0xcf, 0x6d, 0xdf, 0xf9, 0x08,
-	0x4a, 0xbe, 0xdb, 0xc6, 0x34, 0x74, 0x1f, 0x36, 0x3f, 0x91, 0x12, 0x8d, 0xfc, 0xcf, 0x7f, 0xb1,
-	0x91, 0x3e, 0x24, 0x9f, 0x51, 0xcf, 0xa3, 0x34, 0x77, 0x5b, 0xcd, 0xdd, 0x27, 0x52, 0xb2, 0x51,
-	0xfa, 0xf9, 0x2f, 0x36, 0xf2, 0x0a, 0x61, 0x85, 0xd3, 0x3b, 0x4f, 0xa0, 0x36, 0xb5, 0x37, 0xc1,
-	0xd8, 0x81, 0xa0, 0xba, 0xf7, 0xec, 0xf8, 0x60, 0x7f, 0x77, 0xbb, 0xdd, 0x54, 0x4f, 0x8f, 0xda,
-	0x4d, 0x29, 0x89, 0xae, 0xc1, 0xea, 0xc1, 0xfe, 0xf7, 0x5b, 0x6d, 0x75, 0xf7, 0x60, 0xbf, 0x79,
-	0xd8, 0x56, 0xb7, 0xdb, 0xed, 0xed, 0xdd, 0x27, 0x52, 0xea, 0xee, 0x7f, 0x95, 0xa1, 0xb6, 0xbd,
-	0xb3, 0xbb, 0xbf, 0x6d, 0x18, 0x03, 0xad, 0x83, 0x59, 0x24, 0xda, 0x87, 0x0c, 0xab, 0x60, 0xc4,
-	0xfd, 0xcb, 0xd5, 0x88, 0x2d, 0x91, 0xa3, 0x03, 0xc8, 0xb2, 0x12, 0x07, 0x8a, 0xfd, 0xbf, 0xab,
-	0x11, 0x5f, 0x36, 0xa7, 0x1f, 0xc6, 0x9c, 0x4d, 0xdc, 0x3f, 0x5f, 0x8d, 0xd8, 0x42, 0x3a, 0x52,
-	0x20, 0xef, 0x20, 0xd0, 0x0b, 0xfc, 0x85, 0xd5, 0x58, 0xa4, 0xb4, 0x4d, 0x27, 0xcb, 0xab, 0x06,
-	0xb1, 0x3f, 0x85, 0x35, 0xe2, 0x0b, 0xed, 0xe8, 0x08, 0x72, 0x02, 0x2b, 0x8c, 0xff, 0xcf, 0xab,
-	0xb1, 0x40, 0xe9, 0x1c, 0x3d, 0x87, 0xa2, 0x57, 0xa1, 0x59, 0xe8, 0xcf, 0xb7, 0xc6, 0x62, 0x57,
-	0x0a, 0x50, 0x17, 0x2a, 0x41, 0x7c, 0x72, 0xe1, 0x9f, 0xca, 0x1a, 0x8b, 0x57, 0xeb, 0xe9, 0x28,
-	0x41, 0xbc, 0x72, 0xe1, 0xff, 0xcc, 0x1a, 0x8b, 0xd7, 0xef, 0x91, 0x01, 0x2b, 0xb3, 0x90, 0xe2,
-	0x52, 0x7f, 0x9e, 0x35, 0x96, 0x2b, 0xea, 0x23, 0x0b, 0x50, 0x08, 0x20, 0xb9, 0xdc, 0xbf, 0x68,
-	0x8d, 0x25, 0xcb, 0xfc, 0xe8, 0x27, 0x50, 0x9b, 0xc6, 0xf9, 0x96, 0xf8, 0x3d, 0xad, 0xb1, 0x4c,
-	0xd5, 0x9f, 0x8f, 0x15, 0x84, 0xbb, 0x96, 0xf8, 0x63, 0xad, 0xb1, 0xcc, 0x3d, 0x00, 0xf4, 0x43,
-	0x00, 0x3f, 0x50, 0xb3, 0xd0, 0x4f, 0x6c, 0x8d, 0x05, 0x2f, 0x05, 0xa0, 0x0b, 0x58, 0x0d, 0xc3,
-	0x71, 0x96, 0xfc, 0xad, 0xad, 0xb1, 0xec, 0x75, 0x01, 0xaa, 0xf9, 0x41, 0x50, 0x66, 0xe1, 0x3f,
-	0xdd, 0x1a, 0x8b, 0x5f, 0x1d, 0xa0, 0x4b, 0xe7, 0x41, 0x10, 0x68, 0xb1, 0xff, 0xc4, 0x1a, 0x0b,
-	0x56, 0xdc, 0x77, 0xf6, 0x7f, 0xf5, 0x72, 0x3d, 0xf9, 0xeb, 0x97, 0xeb, 0xc9, 0xff, 0x78, 0xb9,
-	0x9e, 0xfc, 0xe2, 0xcb, 0xf5, 0xc4, 0xaf, 0xbf, 0x5c, 0x4f, 0xfc, 0xdb, 0x97, 0xeb, 0x89, 0x3f,
-	0xda, 0xea, 0x69, 0x76, 0x7f, 0x7c, 0xb6, 0xd9, 0xd1, 0x87, 0x5b, 0xd8, 0xd4, 0xde, 0xc7, 0xa3,
-	0x4e, 0x5f, 0x37, 0xb7, 0x2c, 0xa2, 0xbd, 0x1f, 0xfa, 0x1f, 0xf5, 0x59, 0x8e, 0x65, 0x04, 0x1f,
-	0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0xda, 0x1e, 0x9f, 0x67, 0x3d, 0x00, 0x00,
+	// 3920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x93, 0xe3, 0xc6,
+	0x79, 0x1f, 0xbe, 0xc9, 0x8f, 0x2f, 0xb0, 0x67, 0x76, 0x97, 0xcb, 0x95, 0x76, 0x47, 0x90, 0x25,
+	0xaf, 0xd6, 0xd2, 0x8c, 0xb3, 0xaa, 0x55, 0x24, 0xaf, 0x62, 0x79, 0x1e, 0x5c, 0x73, 0xf6, 0x31,
+	0x33, 0xc6, 0x70, 0x47, 0x9b, 0xd8, 0x65, 0xa8, 0x87, 0xec, 0xe1, 0xc0, 0x4b, 0x02, 0x30, 0x00,
+	0x8e, 0x66, 0x5c, 0x95, 0x43, 0x52, 0xa9, 0x94, 0xe2, 0x54, 0xaa, 0x74, 0xc8, 0x29, 0x89, 0xef,
+	0x39, 0xe6, 0xe8, 0x43, 0x2e, 0xb9, 0xb9, 0x52, 0x39, 0xb8, 0x72, 0xca, 0xc9, 0x49, 0x49, 0xc9,
+	0x25, 0x7f, 0x40, 0x0e, 0x39, 0xa5, 0xfa, 0x05, 0x02, 0x24, 0x08, 0x90, 0xde, 0x2d, 0x57, 0xa9,
+	0xa2, 0x1b, 0xfa, 0xc3, 0xf7, 0x7d, 0x8d, 0xee, 0xfe, 0x5e, 0xfd, 0xeb, 0x06, 0xdc, 0xf0, 0x88,
+	0xd9, 0x27, 0xce, 0xc8, 0x30, 0xbd, 0x4d, 0x7c, 0xd2, 0x33, 0x36, 0xbd, 0x4b, 0x9b, 0xb8, 0x1b,
+	0xb6, 0x63, 0x79, 0x16, 0x42, 0x2e, 0x31, 0x26, 0xef, 0x37, 0xe8, 0xfb, 0xd6, 0xab, 0x01, 0x81,
+	0x9e, 0x73, 0x69, 0x7b, 0xd6, 0xa6, 0xed, 0x58, 0xd6, 0x29, 0x17, 0x69, 0xbd, 0x32, 0xfb, 0xfa,
+	0x39, 0xb9, 0x14, 0x0a, 0x43, 0xc2, 0xac, 0xa3, 0x4d, 0x1b, 0x3b, 0x78, 0xe4, 0x46, 0x08, 0xf3,
+	0xd7, 0x81, 0xaf, 0x69, 0xdd, 0x1a, 0x58, 0xd6, 0x60, 0x48, 0x36, 0x59, 0xeb, 0x64, 0x7c, 0xba,
+	0xe9, 0x19, 0x23, 0xe2, 0x7a, 0x78, 0x64, 0x0b, 0x86, 0xb5, 0x81, 0x35, 0xb0, 0xd8, 0xe3, 0x26,
+	0x7d, 0xe2, 0x54, 0xf5, 0xb3, 0x0a, 0x14, 0x34, 0xf2, 0xd3, 0x31, 0x71, 0x3d, 0x74, 0x0f, 0xb2,
+	0xa4, 0x77, 0x66, 0x35, 0x53, 0xeb, 0xa9, 0xdb, 0xe5, 0xbb, 0xb7, 0x36, 0x66, 0xc7, 0xb7, 0x21,
+	0x58, 0xdb, 0xbd, 0x33, 0xab, 0xb3, 0xa2, 0x31, 0x76, 0xf4, 0x3e, 0xe4, 0x4e, 0x87, 0x63, 0xf7,
+	0xac, 0x99, 0x66, 0x72, 0xeb, 0x31, 0x72, 0x0f, 0x28, 0x5f, 0x67, 0x45, 0xe3, 0x02, 0xb4, 0x43,
+	0xc3, 0x3c, 0xb5, 0x9a, 0x99, 0xc4, 0x0e, 0xf7, 0xcc, 0x53, 0xd6, 0x21, 0x65, 0x47, 0x6d, 0x00,
+	0xc3, 0x34, 0x3c, 0xbd, 0x77, 0x86, 0x0d, 0xb3, 0x99, 0x65, 0xc2, 0xdf, 0x88, 0x15, 0x36, 0xbc,
+	0x1d, 0xca, 0xdb, 0x59, 0xd1, 0x4a, 0x86, 0x6c, 0xd0, 0xef, 0xfe, 0xe9, 0x98, 0x38, 0x97, 0xcd,
+	0x5c, 0xe2, 0x77, 0xff, 0x80, 0xf2, 0xd1, 0xef, 0x66, 0x02, 0xe8, 0x23, 0x28, 0xf6, 0xce, 0x48,
+	0xef, 0xb9, 0xee, 0x5d, 0x34, 0x0b, 0x4c, 0x58, 0x8d, 0x11, 0xde, 0xa1, 0xac, 0xdd, 0x8b, 0xce,
+	0x8a, 0x56, 0xe8, 0xf1, 0x47, 0x74, 0x1f, 0xf2, 0x3d, 0x6b, 0x34, 0x32, 0xbc, 0x26, 0x30, 0xf1,
+	0xd7, 0xe2, 0xc4, 0x19, 0x63, 0x67, 0x45, 0x13, 0x22, 0xe8, 0x07, 0x50, 0x1b, 0x1a, 0xae, 0xa7,
+	0xbb, 0x26, 0xb6, 0xdd, 0x33, 0xcb, 0x73, 0x9b, 0x65, 0xa6, 0xe4, 0x76, 0x8c, 0x92, 0xc7, 0x86,
+	0xeb, 0x1d, 0x49, 0xfe, 0xce, 0x8a, 0x56, 0x1d, 0x06, 0x09, 0x54, 0xa5, 0x75, 0x7a, 0x4a, 0x1c,
+	0x5f, 0x67, 0xb3, 0x92, 0xa8, 0xf2, 0x80, 0x0a, 0x48, 0x15, 0x54, 0xa5, 0x15, 0x24, 0xa0, 0x1f,
+	0xc3, 0xea, 0xd0, 0xc2, 0x7d, 0x5f, 0xa3, 0xde, 0x3b, 0x1b, 0x9b, 0xcf, 0x9b, 0x55, 0xa6, 0xf7,
+	0xed, 0xb8, 0x4f, 0xb5, 0x70, 0x5f, 0x6a, 0xd9, 0xa1, 0x32, 0x9d, 0x15, 0xad, 0x31, 0x9c, 0x26,
+	0x22, 0x0c, 0x6b, 0xd8, 0xb6, 0x87, 0x97, 0xd3, 0x1d, 0xd4, 0x58, 0x07, 0xef, 0xc4, 0x74, 0xb0,
+	0x45, 0xc5, 0xa6, 0x7b, 0x40, 0x78, 0x86, 0x8a, 0x3e, 0x06, 0xc5, 0x76, 0x88, 0x8d, 0x1d, 0xa2,
+	0xdb, 0x8e, 0x65, 0x5b, 0x2e, 0x1e, 0x36, 0xeb, 0x4c, 0xfd, 0x9d, 0x18, 0xf5, 0x87, 0x5c, 0xe4,
+	0x50, 0x48, 0x74, 0x56, 0xb4, 0xba, 0x1d, 0x26, 0x71, 0xc5, 0x56, 0x8f, 0xb8, 0xee, 0x44, 0xb1,
+	0xb2, 0x80, 0x62, 0x26, 0x12, 0x56, 0x1c, 0x22, 0xa1, 0x0e, 0x94, 0xc9, 0x05, 0x15, 0xd7, 0xcf,
+	0x2d, 0x8f, 0x34, 0x1b, 0x4c, 0xe7, 0x1b, 0x71, 0x8e, 0xcc, 0xb8, 0x8f, 0x2d, 0x8f, 0x74, 0x56,
+	0x34, 0x20, 0x7e, 0x0b, 0xf5, 0xe1, 0xca, 0x39, 0x71, 0x8c, 0xd3, 0x4b, 0xa6, 0x49, 0x67, 0x6f,
+	0x5c, 0xc3, 0x32, 0x9b, 0x88, 0xe9, 0xdc, 0x88, 0xd1, 0x79, 0xcc, 0xe4, 0xa8, 0x96, 0xb6, 0x94,
+	0xea, 0xac, 0x68, 0xab, 0xe7, 0xb3, 0x64, 0x6a, 0x77, 0xa7, 0x86, 0x89, 0x87, 0xc6, 0xcf, 0x88,
+	0x7e, 0x32, 0xb4, 0x7a, 0xcf, 0x9b, 0xab, 0x89, 0x76, 0xf7, 0x40, 0x08, 0x6c, 0x53, 0x7e, 0x6a,
+	0x77, 0xa7, 0x41, 0x02, 0x9d, 0x82, 0x13, 0x32, 0x30, 0x4c, 0xa1, 0x6f, 0x2d, 0x71, 0x0a, 0xb6,
+	0x29, 0xb7, 0x54, 0x06, 0x27, 0x7e, 0x8b, 0x86, 0x99, 0x3e, 0x19, 0x1a, 0xe7, 0xc4, 0xa1, 0x7e,
+	0x7e, 0x25, 0x31, 0xcc, 0xec, 0x72, 0x66, 0xe6, 0xe9, 0xa5, 0xbe, 0x6c, 0xa0, 0x6d, 0x28, 0xd1,
+	0x05, 0xe1, 0x9f, 0x73, 0x95, 0x69, 0x79, 0x3d, 0x6e, 0x45, 0xcc, 0xbe, 0xfc, 0x98, 0x22, 0x31,
+	0xfb, 0xfe, 0xa0, 0x98, 0x33, 0x0d, 0xb1, 0x47, 0x5c, 0xaf, 0x79, 0x2d, 0x71, 0x50, 0xd4, 0x89,
+	0x1e, 0x33, 0x66, 0x3a, 0xa8, 0xa1, 0xdf, 0xda, 0x2e, 0x40, 0xee, 0x1c, 0x0f, 0xc7, 0xe4, 0x61,
+	0xb6, 0x98, 0x57, 0x0a, 0x0f, 0xb3, 0xc5, 0xa2, 0x52, 0x7a, 0x98, 0x2d, 0x96, 0x14, 0x50, 0xbf,
+	0x09, 0xe5, 0x40, 0x78, 0x47, 0x4d, 0x28, 0x8c, 0x88, 0xeb, 0xe2, 0x01, 0x61, 0x09, 0xa1, 0xa4,
+	0xc9, 0xa6, 0x5a, 0x83, 0x4a, 0x30, 0x9e, 0xab, 0x9f, 0xa7, 0xa0, 0x1c, 0x88, 0xd3, 0x54, 0xf2,
+	0x9c, 0x38, 0xcc, 0x5a, 0x84, 0xa4, 0x68, 0xa2, 0xd7, 0xa1, 0xca, 0xe6, 0x41, 0x97, 0xef, 0x69,
+	0xca, 0xc8, 0x6a, 0x15, 0x46, 0x3c, 0x16, 0x4c, 0xb7, 0xa0, 0x6c, 0xdf, 0xb5, 0x7d, 0x96, 0x0c,
+	0x63, 0x01, 0xfb, 0xae, 0x2d, 0x19, 0x5e, 0x83, 0x0a, 0x1d, 0xab, 0xcf, 0x91, 0x65, 0x9d, 0x94,
+	0x29, 0x4d, 0xb0, 0xa8, 0xff, 0x9a, 0x06, 0x65, 0x3a, 0xfa, 0xa3, 0xf7, 0x21, 0x4b, 0x93, 0xa2,
+	0xc8, 0x6f, 0xad, 0x0d, 0x9e, 0x31, 0x37, 0x64, 0xc6, 0xdc, 0xe8, 0xca, 0x8c, 0xb9, 0x5d, 0xfc,
+	0xd5, 0x6f, 0x6e, 0xad, 0x7c, 0xfe, 0xef, 0xb7, 0x52, 0x1a, 0x93, 0x40, 0xd7, 0x69, 0xc0, 0xc7,
+	0x86, 0xa9, 0x1b, 0x7d, 0xf6, 0xc9, 0x25, 0x1a, 0xca, 0xb1, 0x61, 0xee, 0xf5, 0xd1, 0x01, 0x28,
+	0x3d, 0xcb, 0x74, 0x89, 0xe9, 0x8e, 0x5d, 0x9d, 0xe7, 0xeb, 0x66, 0x26, 0xd2, 0x56, 0x78, 0xb6,
+	0xde, 0x91, 0xcc, 0x87, 0x8c, 0x57, 0xab, 0xf7, 0xc2, 0x04, 0xb4, 0x07, 0x70, 0x8e, 0x87, 0x46,
+	0x1f, 0x7b, 0x96, 0xe3, 0x36, 0xb3, 0xeb, 0x99, 0x79, 0x06, 0x73, 0x2c, 0xb9, 0x9e, 0xda, 0x7d,
+	0xec, 0x91, 0xed, 0x2c, 0xfd, 0x68, 0x2d, 0x20, 0x8c, 0xde, 0x84, 0x3a, 0xb6, 0x6d, 0xdd, 0xf5,
+	0xb0, 0x47, 0xf4, 0x93, 0x4b, 0x8f, 0xb8, 0x2c, 0xd7, 0x55, 0xb4, 0x2a, 0xb6, 0xed, 0x23, 0x4a,
+	0xdd, 0xa6, 0x44, 0xf4, 0x06, 0xd4, 0x0c, 0xd3, 0xf0, 0x0c, 0x3c, 0xd4, 0xcf, 0x88, 0x31, 0x38,
+	0xf3, 0x9a, 0xf9, 0xf5, 0xd4, 0xed, 0x8c, 0x56, 0x15, 0xd4, 0x0e, 0x23, 0xaa, 0x7d, 0xa8, 0x04,
+	0xf3, 0x21, 0x42, 0x90, 0xed, 0x63, 0x0f, 0xb3, 0xf9, 0xac, 0x68, 0xec, 0x99, 0xd2, 0x6c, 0xec,
+	0x9d, 0x89, 0x59, 0x62, 0xcf, 0xe8, 0x2a, 0xe4, 0x85, 0xda, 0x0c, 0x53, 0x2b, 0x5a, 0x68, 0x0d,
+	0x72, 0xb6, 0x63, 0x9d, 0x13, 0xb6, 0x80, 0x45, 0x8d, 0x37, 0xd4, 0xa7, 0x50, 0x0b, 0x27, 0x4e,
+	0x54, 0x83, 0xb4, 0x77, 0x21, 0x7a, 0x49, 0x7b, 0x17, 0xe8, 0x5d, 0xc8, 0xd2, 0xb9, 0x64, 0x7d,
+	0xd4, 0xa2, 0xcb, 0x06, 0x21, 0xda, 0xbd, 0xb4, 0x89, 0xc6, 0x98, 0xd5, 0x3a, 0x54, 0x43, 0x09,
+	0x55, 0xbd, 0x0a, 0x6b, 0x51, 0xc9, 0x51, 0x7d, 0x0e, 0x6b, 0x51, 0x19, 0x0e, 0xbd, 0x0f, 0x45,
+	0x3f, 0x3b, 0x72, 0x0b, 0x7a, 0x25, 0xaa, 0x67, 0xc9, 0xaf, 0xf9, 0xdc, 0xd4, 0x7a, 0xe8, 0x32,
+	0x9c, 0x61, 0x51, 0x23, 0x55, 0xb4, 0x02, 0xb6, 0xed, 0x0e, 0x76, 0xcf, 0xd4, 0x4f, 0xa0, 0x39,
+	0x2f, 0xed, 0x05, 0xa6, 0x2d, 0xc5, 0x5c, 0x40, 0x4e, 0xdb, 0x55, 0xc8, 0x9f, 0x5a, 0xce, 0x08,
+	0x7b, 0x4c, 0x59, 0x55, 0x13, 0x2d, 0x3a, 0x9d, 0x3c, 0x05, 0x66, 0x18, 0x99, 0x37, 0x54, 0x1d,
+	0xae, 0xcf, 0xcd, 0x7b, 0x54, 0xc4, 0x30, 0xfb, 0x84, 0x4f, 0x6e, 0x55, 0xe3, 0x8d, 0x89, 0x22,
+	0xfe, 0xb1, 0xbc, 0x41, 0xbb, 0x75, 0xd9, 0x58, 0x99, 0xfe, 0x92, 0x26, 0x5a, 0xea, 0xbf, 0xe4,
+	0xe1, 0x6a, 0x74, 0xea, 0x43, 0xeb, 0x50, 0x19, 0xe1, 0x0b, 0xdd, 0xbb, 0x10, 0xc6, 0x97, 0x62,
+	0xcb, 0x0f, 0x23, 0x7c, 0xd1, 0xbd, 0xe0, 0x96, 0xa7, 0x40, 0xc6, 0xbb, 0x70, 0x9b, 0xe9, 0xf5,
+	0xcc, 0xed, 0x8a, 0x46, 0x1f, 0xd1, 0x33, 0x68, 0x0c, 0xad, 0x1e, 0x1e, 0xea, 0x43, 0xec, 0x7a,
+	0xba, 0xa8, 0x92, 0xb8, 0x43, 0xbd, 0x19, 0x35, 0xdf, 0x3c, 0x83, 0x91, 0x3e, 0x5f, 0x55, 0x1a,
+	0x7f, 0x84, 0x23, 0xd4, 0x99, 0x9a, 0xc7, 0x58, 0x2e, 0x38, 0x7a, 0x06, 0x6b, 0x27, 0x97, 0x3f,
+	0xc3, 0xa6, 0x67, 0x98, 0x44, 0x9f, 0x71, 0xb1, 0x48, 0x33, 0x7a, 0x62, 0xb8, 0x27, 0xe4, 0x0c,
+	0x9f, 0x1b, 0x96, 0x23, 0xb4, 0xae, 0xfa, 0x2a, 0x8e, 0x27, 0x7e, 0x36, 0x59, 0xa9, 0x5c, 0xc8,
+	0xc0, 0x65, 0xc0, 0xc9, 0x2f, 0x1d, 0x70, 0xbe, 0x0d, 0x6b, 0x26, 0xb9, 0xf0, 0x02, 0x9f, 0xc9,
+	0xcd, 0xa7, 0xc0, 0x56, 0x04, 0xd1, 0x77, 0x93, 0xfe, 0xa9, 0x25, 0xa1, 0xb7, 0x58, 0x4d, 0x61,
+	0x5b, 0x2e, 0x71, 0x74, 0xdc, 0xef, 0x3b, 0xc4, 0x75, 0x9b, 0x45, 0xc6, 0x5d, 0x97, 0xf4, 0x2d,
+	0x4e, 0x0e, 0xd9, 0x63, 0x29, 0x64, 0x8f, 0xe8, 0x9b, 0x50, 0x9f, 0xee, 0x12, 0x18, 0x47, 0xed,
+	0x3c, 0xdc, 0xdd, 0x1b, 0x50, 0x9b, 0x84, 0x3d, 0xc6, 0x57, 0xe6, 0x91, 0xc5, 0xa7, 0x32, 0xb6,
+	0x1b, 0x50, 0xa2, 0x61, 0x81, 0x73, 0x54, 0x18, 0x47, 0x91, 0x12, 0xd8, 0xcb, 0xd7, 0xa1, 0x4a,
+	0xce, 0x8d, 0x3e, 0x31, 0x7b, 0x84, 0x33, 0x54, 0x19, 0x43, 0x45, 0x12, 0x19, 0xd3, 0x9b, 0x50,
+	0x67, 0x96, 0xc0, 0xf3, 0x06, 0x63, 0xab, 0xf1, 0x9e, 0x28, 0x99, 0xa7, 0x4a, 0xca, 0xf7, 0x3e,
+	0x5c, 0x0f, 0xf0, 0xd9, 0xd8, 0xf1, 0x74, 0x97, 0x78, 0xba, 0x67, 0x79, 0xa2, 0x6a, 0xcb, 0x68,
+	0x57, 0x7c, 0x89, 0x43, 0xec, 0x78, 0x47, 0xc4, 0xeb, 0xd2, 0x97, 0xe8, 0x3d, 0x68, 0x46, 0x49,
+	0xb2, 0xae, 0x14, 0xd6, 0xd5, 0xda, 0xb4, 0x20, 0xeb, 0xf1, 0x36, 0x28, 0x01, 0x1b, 0xe5, 0xfc,
+	0x0d, 0x3e, 0x59, 0x43, 0xdf, 0xea, 0x18, 0xe7, 0x1d, 0x68, 0x30, 0x4e, 0x87, 0xb8, 0xe3, 0xa1,
+	0x27, 0xe6, 0x0b, 0xf1, 0xc5, 0xa1, 0x2f, 0x34, 0x4e, 0x67, 0x11, 0xe1, 0x1f, 0x83, 0xee, 0x14,
+	0xae, 0xee, 0x84, 0xb3, 0xa4, 0x26, 0xce, 0x72, 0x0c, 0x6b, 0x62, 0x71, 0xfb, 0x21, 0x7f, 0xe1,
+	0x3b, 0xb1, 0x9b, 0x91, 0x91, 0x71, 0xda, 0x4f, 0x90, 0xd4, 0xb0, 0x80, 0xab, 0x64, 0x5e, 0xd8,
+	0x55, 0x10, 0x64, 0xd9, 0xe8, 0xb3, 0x3c, 0x67, 0xd0, 0xe7, 0xaf, 0xb2, 0xfb, 0x40, 0xa2, 0xfb,
+	0x94, 0x17, 0x74, 0x9f, 0x4a, 0xa2, 0xfb, 0x54, 0x93, 0xdc, 0xa7, 0xb6, 0x98, 0xfb, 0xd4, 0x97,
+	0x76, 0x1f, 0xe5, 0xb7, 0x75, 0x9f, 0xc6, 0x92, 0xee, 0x83, 0x16, 0x77, 0x9f, 0xd5, 0x68, 0xf7,
+	0xf9, 0x08, 0x1a, 0x33, 0x5b, 0x1b, 0xdf, 0xe8, 0x52, 0x91, 0x46, 0x97, 0x0e, 0x1a, 0x9d, 0xfa,
+	0x77, 0x29, 0x68, 0xcd, 0xdf, 0xc8, 0x44, 0xaa, 0xfa, 0x16, 0x34, 0xfc, 0xe5, 0xf5, 0x8d, 0x87,
+	0xe7, 0x4e, 0xc5, 0x7f, 0x21, 0xad, 0x67, 0x5e, 0x31, 0xf4, 0x06, 0xd4, 0xa6, 0x76, 0x5a, 0xdc,
+	0x45, 0xaa, 0xe7, 0xc1, 0xfe, 0xd5, 0x5f, 0xe6, 0x61, 0x2d, 0x6a, 0x23, 0x14, 0x11, 0x1c, 0xba,
+	0xb0, 0xda, 0x27, 0x3d, 0xa3, 0xff, 0x02, 0xb1, 0xa1, 0x21, 0x14, 0x7c, 0x1d, 0x1a, 0xbe, 0x0e,
+	0x0d, 0x5f, 0xf9, 0xd0, 0xf0, 0x57, 0x69, 0x68, 0xcc, 0xec, 0xf9, 0x23, 0x1d, 0xfa, 0x03, 0x6a,
+	0x75, 0x98, 0x96, 0xba, 0xdc, 0x59, 0x6e, 0x44, 0xee, 0xe4, 0x3a, 0x8c, 0x45, 0x58, 0xb4, 0x10,
+	0x40, 0x5a, 0xf8, 0xd3, 0x03, 0xf0, 0x66, 0x24, 0x44, 0x38, 0x71, 0xac, 0x80, 0xd7, 0xd5, 0x86,
+	0x21, 0x2a, 0x7a, 0x1a, 0x5b, 0xb8, 0x46, 0xee, 0x42, 0xda, 0x62, 0xa1, 0x63, 0xfc, 0x4d, 0x6d,
+	0x83, 0x32, 0x8d, 0x5c, 0xcc, 0x6c, 0xb5, 0x5e, 0x83, 0x8a, 0x6b, 0x0c, 0x74, 0x86, 0xdd, 0x18,
+	0x84, 0x6f, 0x7e, 0x8b, 0x5a, 0xd9, 0x35, 0x06, 0xc7, 0x82, 0xa4, 0xbe, 0x05, 0xf5, 0x29, 0xe8,
+	0x62, 0x6a, 0xe7, 0x32, 0x89, 0xad, 0xab, 0xd0, 0x08, 0xec, 0x76, 0x38, 0x22, 0xa1, 0xfe, 0x67,
+	0x05, 0x8a, 0x1a, 0x71, 0x6d, 0x6a, 0xdd, 0xa8, 0x0d, 0x25, 0x72, 0xd1, 0x23, 0xb6, 0x27, 0xc1,
+	0x83, 0xb9, 0x30, 0x07, 0x17, 0x68, 0x4b, 0x66, 0x8a, 0xb9, 0xf8, 0x92, 0xe8, 0x3d, 0x81, 0x64,
+	0xc7, 0x22, 0xd2, 0x42, 0x43, 0x10, 0xca, 0xfe, 0x40, 0x42, 0xd9, 0x99, 0x38, 0x58, 0x96, 0x0b,
+	0x4e, 0x61, 0xd9, 0xef, 0x09, 0x2c, 0x3b, 0x9b, 0xdc, 0x65, 0x08, 0xcc, 0x7e, 0x10, 0x02, 0xb3,
+	0x73, 0xc9, 0x43, 0x9e, 0x83, 0x66, 0x7f, 0x20, 0xd1, 0xec, 0x7c, 0xf2, 0xa7, 0x4f, 0xc1, 0xd9,
+	0xdf, 0x0b, 0xc0, 0xd9, 0xc5, 0x38, 0x80, 0x8a, 0x4b, 0x47, 0xe0, 0xd9, 0x1f, 0xfa, 0x78, 0x76,
+	0x39, 0x0e, 0x0e, 0x17, 0xf2, 0xd3, 0x80, 0xb6, 0x36, 0x03, 0x68, 0x73, 0xf4, 0xf9, 0xad, 0x38,
+	0x2d, 0x09, 0x88, 0xb6, 0x36, 0x83, 0x68, 0x57, 0x93, 0x75, 0x26, 0x40, 0xda, 0x7a, 0x34, 0xa4,
+	0x1d, 0x8b, 0x38, 0x8b, 0x8f, 0x5d, 0x0c, 0xd3, 0x3e, 0x99, 0x83, 0x69, 0xd7, 0xe3, 0x30, 0x57,
+	0xde, 0xc3, 0xc2, 0xa0, 0xf6, 0xb3, 0x08, 0x50, 0x9b, 0x63, 0xcf, 0xdf, 0x8a, 0xd3, 0xbf, 0x00,
+	0xaa, 0xfd, 0x2c, 0x02, 0xd5, 0x6e, 0x2c, 0xa2, 0x39, 0x11, 0xd6, 0xde, 0x0b, 0xc3, 0xda, 0x68,
+	0x3e, 0x1a, 0x30, 0x89, 0x0b, 0x73, 0x70, 0x6d, 0x32, 0x0f, 0xd7, 0xe6, 0xc0, 0xf3, 0x66, 0x9c,
+	0xd2, 0x25, 0x80, 0x6d, 0x6d, 0x06, 0xd8, 0x5e, 0x4b, 0x36, 0xbf, 0x04, 0x64, 0x7b, 0x2f, 0x8c,
+	0x6c, 0x5f, 0x49, 0x9e, 0x85, 0xb9, 0xd0, 0xf6, 0x83, 0x10, 0xb4, 0x7d, 0x35, 0x39, 0xe8, 0xcc,
+	0xc1, 0xb6, 0x77, 0x82, 0xd8, 0xf6, 0xb5, 0x38, 0x84, 0x5c, 0x2c, 0x4b, 0x14, 0xb8, 0xbd, 0x17,
+	0x06, 0xb7, 0x9b, 0xc9, 0xe3, 0x5a, 0x04, 0xdd, 0x2e, 0x28, 0x45, 0x8e, 0x6b, 0x3f, 0xcc, 0x16,
+	0x41, 0x29, 0xab, 0x6f, 0x41, 0x43, 0x8a, 0xfb, 0x49, 0x83, 0x22, 0x5d, 0xc4, 0x71, 0x2c, 0x47,
+	0xe0, 0xd4, 0xbc, 0xa1, 0xde, 0x86, 0x8a, 0xcf, 0x1a, 0x8f, 0x84, 0x33, 0x50, 0x31, 0x90, 0x0e,
+	0xd4, 0x5f, 0xa6, 0xa0, 0x12, 0x0c, 0xf3, 0x21, 0x8c, 0xb4, 0x24, 0x30, 0xd2, 0x00, 0x3e, 0x9e,
+	0x0e, 0xe3, 0xe3, 0xb7, 0xa0, 0x4c, 0xeb, 0xc7, 0x29, 0xe8, 0x1b, 0xdb, 0x3e, 0xf4, 0x2d, 0xeb,
+	0x1d, 0x51, 0xb3, 0xf1, 0x24, 0x9b, 0x65, 0x49, 0xb6, 0x3e, 0xa9, 0xda, 0x18, 0x19, 0xbd, 0x03,
+	0xab, 0x01, 0x5e, 0xbf, 0x2e, 0xe5, 0x08, 0xb0, 0xe2, 0x73, 0x6f, 0x09, 0x28, 0xf2, 0x9f, 0x53,
+	0xd0, 0x98, 0xc9, 0x31, 0x91, 0xf0, 0x76, 0xea, 0xe5, 0xc1, 0xdb, 0xe9, 0x17, 0x81, 0xb7, 0x83,
+	0xd5, 0x76, 0x26, 0x8c, 0xab, 0xfe, 0x6f, 0x0a, 0xaa, 0xa1, 0x6c, 0x47, 0x17, 0xa2, 0x67, 0xf5,
+	0x89, 0x40, 0x3a, 0xd9, 0x33, 0xdd, 0x33, 0x0d, 0xad, 0x81, 0xc0, 0x33, 0xe9, 0x23, 0xe5, 0xf2,
+	0xb3, 0x78, 0x49, 0x64, 0x68, 0x1f, 0x24, 0xe5, 0x5b, 0x10, 0xde, 0xa0, 0xb2, 0xcf, 0x09, 0xcf,
+	0xb6, 0x15, 0x8d, 0x3e, 0xa2, 0x35, 0x61, 0x7c, 0x62, 0x2b, 0xc1, 0x1b, 0xe8, 0x3e, 0x94, 0xd8,
+	0x0d, 0x00, 0xdd, 0xb2, 0xdd, 0x66, 0x31, 0x72, 0xef, 0xc5, 0x6f, 0x02, 0x6c, 0x1c, 0x52, 0xb6,
+	0x03, 0xdb, 0xd5, 0x8a, 0xb6, 0x78, 0x0a, 0x14, 0x52, 0xa5, 0xd0, 0xf6, 0xe7, 0x15, 0x28, 0xd1,
+	0x01, 0xb8, 0x36, 0xee, 0x11, 0xb6, 0xd1, 0x28, 0x69, 0x13, 0x82, 0x8a, 0x01, 0xcd, 0x46, 0x00,
+	0xf4, 0x08, 0xf2, 0xe4, 0x9c, 0x98, 0x1e, 0xdf, 0x23, 0x96, 0xef, 0x5e, 0x8f, 0xae, 0x1b, 0x89,
+	0xe9, 0x6d, 0x37, 0xe9, 0x54, 0xff, 0xf7, 0x6f, 0x6e, 0x29, 0x5c, 0xe0, 0x6d, 0x6b, 0x64, 0x78,
+	0x64, 0x64, 0x7b, 0x97, 0x9a, 0x50, 0xa1, 0xfe, 0x59, 0x1a, 0xea, 0xb2, 0x0f, 0x09, 0xd3, 0x47,
+	0xcd, 0xb0, 0x34, 0xff, 0x74, 0xe0, 0x88, 0x60, 0x76, 0xd6, 0x5f, 0x05, 0x18, 0x60, 0x57, 0xff,
+	0x14, 0x9b, 0x1e, 0xe9, 0x8b, 0x69, 0x2e, 0x0d, 0xb0, 0xfb, 0x31, 0x23, 0x84, 0x47, 0x5b, 0x9c,
+	0x1a, 0x6d, 0x00, 0x97, 0x2e, 0x05, 0x71, 0x69, 0xd4, 0x82, 0xa2, 0xed, 0x18, 0x96, 0x63, 0x78,
+	0x97, 0x6c, 0x8a, 0x32, 0x9a, 0xdf, 0xa6, 0x4b, 0x65, 0x5a, 0x66, 0x8f, 0xb0, 0x42, 0x23, 0xab,
+	0xf1, 0x06, 0xf5, 0x4b, 0x9b, 0x98, 0x7d, 0xc3, 0x1c, 0xb0, 0x62, 0xa1, 0xa8, 0xc9, 0xe6, 0xc3,
+	0x6c, 0x31, 0xab, 0xe4, 0xfc, 0x23, 0x33, 0x1e, 0x5a, 0xca, 0x4a, 0x45, 0xfd, 0x2c, 0x0d, 0x8d,
+	0x99, 0x10, 0xf9, 0x02, 0x13, 0x11, 0x65, 0x7e, 0x37, 0x23, 0x26, 0x27, 0x40, 0xa1, 0xe3, 0xa4,
+	0xad, 0xb1, 0x4b, 0xfa, 0xe2, 0xd8, 0xc6, 0x6f, 0x07, 0xd6, 0xbc, 0xf0, 0xc2, 0x6b, 0x1e, 0xbf,
+	0x0c, 0xea, 0x5f, 0xb3, 0x13, 0xb7, 0x70, 0x98, 0x47, 0xc7, 0x41, 0x64, 0x64, 0xcc, 0xfc, 0x58,
+	0x9a, 0xdf, 0x12, 0x3e, 0xaf, 0x9c, 0x87, 0xc9, 0x2e, 0xfa, 0x21, 0x5c, 0x9b, 0x8a, 0x4a, 0xbe,
+	0xf6, 0xf4, 0xfc, 0x02, 0x76, 0x3a, 0x36, 0x5d, 0x09, 0xc7, 0x26, 0xa9, 0x7c, 0x32, 0x69, 0x99,
+	0x17, 0x77, 0x94, 0x7b, 0x50, 0x93, 0xb3, 0x22, 0x00, 0x94, 0xd7, 0xa1, 0xea, 0x10, 0x0f, 0x1b,
+	0xa6, 0x1e, 0xc2, 0x81, 0x2a, 0x9c, 0x28, 0x8e, 0xda, 0x8e, 0xe0, 0x4a, 0x64, 0xa1, 0x8b, 0xbe,
+	0x03, 0xa5, 0x49, 0x99, 0x9c, 0x9a, 0xbf, 0x01, 0x94, 0x12, 0xda, 0x84, 0x5d, 0xfd, 0xa7, 0x14,
+	0x5c, 0x89, 0x2c, 0x75, 0x51, 0x07, 0xf2, 0x7c, 0x07, 0xcd, 0x6c, 0xb6, 0x76, 0xf7, 0xdb, 0x0b,
+	0x57, 0xc9, 0x1b, 0x7c, 0x87, 0xad, 0x09, 0x79, 0xf5, 0xc7, 0x90, 0xe7, 0x14, 0x54, 0x86, 0xc2,
+	0xd3, 0xfd, 0x47, 0xfb, 0x07, 0x1f, 0xef, 0x2b, 0x2b, 0x08, 0x20, 0xbf, 0xb5, 0xb3, 0xd3, 0x3e,
+	0xec, 0x2a, 0x29, 0x54, 0x82, 0xdc, 0xd6, 0xf6, 0x81, 0xd6, 0x55, 0xd2, 0x94, 0xac, 0xb5, 0x1f,
+	0xb6, 0x77, 0xba, 0x4a, 0x06, 0x35, 0xa0, 0xca, 0x9f, 0xf5, 0x07, 0x07, 0xda, 0x93, 0xad, 0xae,
+	0x92, 0x0d, 0x90, 0x8e, 0xda, 0xfb, 0xbb, 0x6d, 0x4d, 0xc9, 0xa9, 0xbf, 0x07, 0xd7, 0xe5, 0x77,
+	0xcc, 0x9e, 0x98, 0xf9, 0x07, 0x57, 0xa9, 0xc0, 0xc1, 0x95, 0xfa, 0xb7, 0x69, 0x68, 0x49, 0x99,
+	0x88, 0x33, 0xb0, 0x27, 0x53, 0x63, 0xbf, 0xb7, 0x5c, 0x99, 0x3d, 0x35, 0x01, 0x14, 0xbe, 0x71,
+	0xc8, 0x29, 0xf1, 0x7a, 0x67, 0xbc, 0x78, 0xe7, 0x39, 0xae, 0xaa, 0x55, 0x05, 0x95, 0x09, 0xb9,
+	0x9c, 0xed, 0x27, 0xa4, 0xe7, 0xe9, 0x3c, 0x5c, 0x71, 0x63, 0x2b, 0x69, 0x55, 0x4e, 0x3d, 0xe2,
+	0x44, 0xf5, 0x93, 0xa5, 0xa6, 0xb3, 0x04, 0x39, 0xad, 0xdd, 0xd5, 0xfe, 0x50, 0xc9, 0x20, 0x04,
+	0x35, 0xf6, 0xa8, 0x1f, 0xed, 0x6f, 0x1d, 0x1e, 0x75, 0x0e, 0xe8, 0x74, 0xae, 0x42, 0x5d, 0x4e,
+	0xa7, 0x24, 0xe6, 0xd4, 0x2f, 0xd2, 0x70, 0x6d, 0x4e, 0x91, 0x8f, 0xee, 0x03, 0x78, 0x17, 0xba,
+	0x43, 0x7a, 0x96, 0xd3, 0x8f, 0xb5, 0xb6, 0xee, 0x85, 0xc6, 0x98, 0xb4, 0x92, 0x27, 0x9e, 0xdc,
+	0x98, 0x53, 0x4f, 0xf4, 0x91, 0xd0, 0x4b, 0x07, 0x26, 0xbd, 0x6c, 0x3d, 0xfa, 0x70, 0x8f, 0xf4,
+	0xa8, 0x6e, 0x36, 0xc3, 0x25, 0x4f, 0x3c, 0xb9, 0xe8, 0x30, 0x2a, 0xae, 0x2c, 0x7e, 0x54, 0x1e,
+	0x11, 0x51, 0x7e, 0x34, 0x3f, 0xa2, 0xe4, 0x96, 0x28, 0x77, 0xa2, 0x43, 0x8a, 0xfa, 0x0f, 0x99,
+	0xe0, 0x24, 0x87, 0x37, 0x37, 0x1a, 0xe4, 0x5d, 0x0f, 0x7b, 0x63, 0x57, 0xd8, 0xdf, 0x77, 0x96,
+	0xd8, 0x2c, 0x6d, 0xc8, 0x87, 0x23, 0xa6, 0x41, 0x13, 0x9a, 0xbe, 0x9e, 0xfb, 0xc0, 0xdc, 0xdf,
+	0x83, 0x5a, 0x78, 0x96, 0xe6, 0xbb, 0xd2, 0x24, 0x1c, 0xa5, 0xd5, 0xfb, 0x93, 0x22, 0x2a, 0x70,
+	0x92, 0x30, 0x8b, 0xd2, 0xa7, 0xa2, 0x50, 0xfa, 0xbf, 0x4f, 0xc1, 0x8d, 0x98, 0x5d, 0x23, 0xea,
+	0x4e, 0xad, 0xf9, 0x87, 0x4b, 0x6e, 0x3b, 0x37, 0x38, 0x2d, 0xbc, 0xea, 0xea, 0xbb, 0x50, 0x09,
+	0xd2, 0x17, 0x1b, 0xe7, 0xff, 0xa4, 0xe1, 0x4a, 0xe4, 0x06, 0xf4, 0xa5, 0x16, 0x8c, 0x53, 0x66,
+	0x97, 0x5e, 0xde, 0xec, 0x22, 0x4b, 0x89, 0xcc, 0x8b, 0x97, 0x12, 0x31, 0xc6, 0x97, 0x7d, 0x61,
+	0xe3, 0x0b, 0x39, 0x62, 0x2e, 0xbc, 0x45, 0x59, 0x03, 0x14, 0xcc, 0x64, 0x02, 0x0d, 0xfd, 0x11,
+	0x40, 0x00, 0xf9, 0x5d, 0x83, 0x9c, 0x63, 0x8d, 0xcd, 0x3e, 0x33, 0x93, 0x9c, 0xc6, 0x1b, 0xf4,
+	0xe2, 0x2a, 0x35, 0x37, 0x39, 0x8d, 0x91, 0x11, 0x99, 0x9a, 0x4b, 0x00, 0x52, 0xe6, 0x02, 0xea,
+	0x27, 0x50, 0x0b, 0x23, 0xce, 0x2f, 0xbd, 0x87, 0x21, 0xa0, 0xd9, 0x1b, 0x19, 0x73, 0x7a, 0xf9,
+	0x5e, 0xb8, 0x97, 0x6f, 0xc4, 0x5d, 0xef, 0x88, 0xee, 0xed, 0x8f, 0x21, 0xc7, 0x0c, 0x90, 0x16,
+	0xce, 0xec, 0x4a, 0x90, 0xd8, 0x66, 0xd3, 0x67, 0xf4, 0x09, 0x00, 0xf6, 0x3c, 0xc7, 0x38, 0x19,
+	0x4f, 0xfa, 0x50, 0xe7, 0xda, 0xf0, 0x96, 0x64, 0xdd, 0x7e, 0x45, 0x18, 0xf3, 0xda, 0x44, 0x3a,
+	0x60, 0xd0, 0x01, 0x9d, 0xea, 0x3e, 0xd4, 0xc2, 0xb2, 0x72, 0x57, 0x98, 0x8a, 0xd8, 0x15, 0xa6,
+	0x83, 0xbb, 0x42, 0x7f, 0x4f, 0x99, 0xe1, 0x57, 0x9f, 0x58, 0x43, 0xfd, 0x93, 0x34, 0x54, 0x82,
+	0xf6, 0xff, 0x92, 0x77, 0x12, 0x09, 0xdb, 0xac, 0xeb, 0x33, 0x1b, 0x89, 0xc2, 0x00, 0xbb, 0x4f,
+	0x7f, 0xc7, 0xfb, 0x88, 0xcf, 0x52, 0x50, 0xf4, 0xc7, 0x3f, 0xe7, 0x20, 0x61, 0x32, 0x7d, 0xe9,
+	0xe0, 0xbd, 0x25, 0x7e, 0x78, 0x91, 0xf1, 0x0f, 0x2f, 0xbe, 0xeb, 0x57, 0x76, 0x31, 0xa0, 0x7c,
+	0x70, 0xbe, 0xe5, 0x59, 0x8e, 0xa8, 0x65, 0xff, 0x46, 0x7c, 0x0a, 0xad, 0x67, 0xd0, 0x1f, 0x40,
+	0x1e, 0xf7, 0xfc, 0x63, 0x89, 0x5a, 0x34, 0x5c, 0x26, 0xb9, 0x37, 0xba, 0x17, 0x5b, 0x8c, 0x59,
+	0x13, 0x42, 0xe2, 0xdb, 0xd2, 0xf2, 0xdb, 0xd4, 0x8f, 0xa0, 0x28, 0x79, 0xc2, 0x71, 0xba, 0x06,
+	0xf0, 0x74, 0xff, 0xc9, 0xc1, 0xee, 0xde, 0x83, 0xbd, 0xf6, 0xae, 0x28, 0xef, 0x76, 0x77, 0xdb,
+	0xbb, 0x4a, 0x9a, 0xf2, 0x69, 0xed, 0x27, 0x07, 0xc7, 0xed, 0x5d, 0x25, 0xa3, 0xee, 0x40, 0x59,
+	0x9e, 0x9b, 0x51, 0x58, 0xe5, 0x06, 0x94, 0x46, 0x38, 0x7c, 0xcf, 0xaa, 0x38, 0xc2, 0xe2, 0x96,
+	0xd5, 0x35, 0x28, 0xd0, 0x97, 0x03, 0xec, 0xca, 0xc3, 0xee, 0x11, 0xbe, 0xf8, 0x3e, 0x76, 0xd5,
+	0xbf, 0x4c, 0x43, 0x7d, 0x2a, 0x92, 0xa1, 0x7b, 0x90, 0xe3, 0x78, 0x5e, 0xcc, 0x6f, 0x00, 0x81,
+	0x9e, 0x35, 0xce, 0x4d, 0xef, 0xc4, 0xcb, 0xd3, 0xc5, 0x39, 0x7b, 0x30, 0x1e, 0x38, 0xe5, 0xc9,
+	0x94, 0x90, 0xf6, 0x85, 0xe8, 0x3d, 0x59, 0x3f, 0x38, 0xc7, 0xde, 0xa0, 0xf4, 0x23, 0xbb, 0x50,
+	0x31, 0x11, 0x43, 0x1f, 0x4e, 0x90, 0xb5, 0x6c, 0xe4, 0x41, 0x84, 0xd0, 0xc0, 0x79, 0x84, 0xbc,
+	0x14, 0x51, 0xef, 0x43, 0xc9, 0xd7, 0x4d, 0xc1, 0x00, 0x79, 0xd8, 0x9b, 0x12, 0x81, 0x9b, 0x37,
+	0xd9, 0xb5, 0x45, 0xeb, 0x53, 0x71, 0x0f, 0x2e, 0xa3, 0xf1, 0x86, 0x3a, 0x80, 0xfa, 0x54, 0xca,
+	0x41, 0xdf, 0x85, 0x82, 0x3d, 0x3e, 0xd1, 0x65, 0x40, 0x98, 0x9d, 0x4b, 0x09, 0xfc, 0x8c, 0x4f,
+	0x86, 0x46, 0xef, 0x11, 0xb9, 0x94, 0xf6, 0x67, 0x8f, 0x4f, 0x1e, 0xf1, 0xd0, 0xc1, 0x3b, 0x4a,
+	0x07, 0x3b, 0xba, 0x84, 0xa2, 0x0c, 0x86, 0x68, 0x2b, 0x38, 0x67, 0xbc, 0x8f, 0x57, 0x63, 0x93,
+	0xa1, 0xe8, 0x21, 0x30, 0x65, 0x77, 0xa0, 0xe1, 0x1a, 0x03, 0x53, 0x5e, 0x12, 0xe0, 0x4b, 0xcf,
+	0x8f, 0xf9, 0xea, 0xfc, 0xc5, 0x63, 0x89, 0x14, 0xd2, 0xb2, 0x46, 0x99, 0x0e, 0xc8, 0xbf, 0xe3,
+	0x6f, 0x88, 0xa8, 0xc0, 0x32, 0x51, 0x15, 0xd8, 0x5f, 0xa4, 0xa1, 0x1c, 0xb8, 0x77, 0x80, 0x7e,
+	0x3f, 0x90, 0x20, 0x6a, 0xd1, 0x15, 0x43, 0x80, 0x7d, 0x72, 0x6f, 0x34, 0x3c, 0xbc, 0xf4, 0x6f,
+	0x35, 0xbc, 0x79, 0x57, 0x3e, 0xe4, 0x25, 0x86, 0xec, 0xd2, 0x97, 0x18, 0xde, 0x06, 0xc4, 0x8e,
+	0xdf, 0xe9, 0x21, 0x86, 0x61, 0x0e, 0x74, 0x6e, 0x26, 0x3c, 0xa2, 0x2b, 0xec, 0xcd, 0x31, 0x7b,
+	0x71, 0xc8, 0x2c, 0xe6, 0xcf, 0xd3, 0x50, 0x94, 0x6e, 0xf7, 0xff, 0x7a, 0x22, 0xfe, 0x34, 0x05,
+	0x45, 0x1f, 0xf3, 0x58, 0xf6, 0x7a, 0xed, 0x55, 0xc8, 0x8b, 0x0d, 0x3d, 0xbf, 0x5f, 0x2b, 0x5a,
+	0x91, 0xd7, 0x56, 0x5a, 0x50, 0x1c, 0x11, 0x0f, 0xb3, 0x3c, 0xcd, 0xcb, 0x3e, 0xbf, 0x7d, 0xe7,
+	0x03, 0x28, 0x07, 0x6e, 0x27, 0xd3, 0xd4, 0xbd, 0xdf, 0xfe, 0x58, 0x59, 0x69, 0x15, 0x7e, 0xfe,
+	0x8b, 0xf5, 0xcc, 0x3e, 0xf9, 0x94, 0x46, 0x1e, 0xad, 0xbd, 0xd3, 0x69, 0xef, 0x3c, 0x52, 0x52,
+	0xad, 0xf2, 0xcf, 0x7f, 0xb1, 0x5e, 0xd0, 0x08, 0x3b, 0x68, 0xbd, 0xf3, 0x08, 0xea, 0x53, 0x6b,
+	0x13, 0xce, 0x1d, 0x08, 0x6a, 0xbb, 0x4f, 0x0f, 0x1f, 0xef, 0xed, 0x6c, 0x75, 0xdb, 0xfa, 0xf1,
+	0x41, 0xb7, 0xad, 0xa4, 0xd0, 0x35, 0x58, 0x7d, 0xbc, 0xf7, 0xfd, 0x4e, 0x57, 0xdf, 0x79, 0xbc,
+	0xd7, 0xde, 0xef, 0xea, 0x5b, 0xdd, 0xee, 0xd6, 0xce, 0x23, 0x25, 0x7d, 0xf7, 0xbf, 0x2a, 0x50,
+	0xdf, 0xda, 0xde, 0xd9, 0xa3, 0x90, 0x86, 0xd1, 0xc3, 0x2c, 0x13, 0xed, 0x41, 0x96, 0x9d, 0x78,
+	0x24, 0xfd, 0xfb, 0xd5, 0x4a, 0x3c, 0x52, 0x47, 0x8f, 0x21, 0xc7, 0x8e, 0x44, 0x50, 0xe2, 0xff,
+	0x60, 0xad, 0xe4, 0x63, 0x76, 0xfa, 0x61, 0x2c, 0xd8, 0x24, 0xfd, 0x23, 0xd6, 0x4a, 0x3c, 0x78,
+	0x47, 0x1a, 0x14, 0x24, 0x62, 0xbd, 0xc0, 0x5f, 0x5b, 0xad, 0x45, 0x8e, 0xc2, 0xe9, 0x60, 0xf9,
+	0x29, 0x43, 0xe2, 0x4f, 0x64, 0xad, 0xe4, 0x83, 0x79, 0x74, 0x00, 0x79, 0x81, 0x15, 0x26, 0xff,
+	0x17, 0xd6, 0x5a, 0xe0, 0xa8, 0x1d, 0x3d, 0x83, 0xd2, 0xe4, 0x44, 0x67, 0xa1, 0x3f, 0xe5, 0x5a,
+	0x8b, 0x5d, 0x41, 0x40, 0x7d, 0xa8, 0x86, 0xf1, 0xc9, 0x85, 0x7f, 0x42, 0x6b, 0x2d, 0x7e, 0xba,
+	0x4f, 0x7b, 0x09, 0xe3, 0x95, 0x0b, 0xff, 0x97, 0xd6, 0x5a, 0xfc, 0xbc, 0x1f, 0xd9, 0xd0, 0x98,
+	0x85, 0x14, 0x97, 0xfa, 0x53, 0xad, 0xb5, 0xdc, 0x25, 0x00, 0xe4, 0x02, 0x8a, 0x00, 0x24, 0x97,
+	0xfb, 0x77, 0xad, 0xb5, 0xe4, 0xb5, 0x00, 0xf4, 0x13, 0xa8, 0x4f, 0xe3, 0x7c, 0x4b, 0xfc, 0xce,
+	0xd6, 0x5a, 0xe6, 0x96, 0x00, 0xef, 0x2b, 0x0c, 0x77, 0x2d, 0xf1, 0x87, 0x5b, 0x6b, 0x99, 0x7b,
+	0x03, 0xe8, 0x87, 0x00, 0x41, 0xa0, 0x66, 0xa1, 0x9f, 0xde, 0x5a, 0x0b, 0x5e, 0x22, 0x40, 0xe7,
+	0xb0, 0x1a, 0x85, 0xe3, 0x2c, 0xf9, 0x1b, 0x5c, 0x6b, 0xd9, 0xeb, 0x05, 0xd4, 0xf2, 0xc3, 0xa0,
+	0xcc, 0xc2, 0x7f, 0xc6, 0xb5, 0x16, 0xbf, 0x6a, 0x40, 0xa7, 0x6e, 0x02, 0x41, 0xa0, 0xc5, 0xfe,
+	0x2b, 0x6b, 0x2d, 0x78, 0x42, 0xbf, 0xbd, 0xf7, 0xab, 0x2f, 0x6e, 0xa6, 0x7e, 0xfd, 0xc5, 0xcd,
+	0xd4, 0x7f, 0x7c, 0x71, 0x33, 0xf5, 0xf9, 0x97, 0x37, 0x57, 0x7e, 0xfd, 0xe5, 0xcd, 0x95, 0x7f,
+	0xfb, 0xf2, 0xe6, 0xca, 0x1f, 0x6d, 0x0e, 0x0c, 0xef, 0x6c, 0x7c, 0xb2, 0xd1, 0xb3, 0x46, 0x9b,
+	0xd8, 0x31, 0xde, 0xc1, 0x66, 0xef, 0xcc, 0x72, 0x36, 0x5d, 0x62, 0xbc, 0x13, 0xf9, 0xdf, 0xf5,
+	0x49, 0x9e, 0x55, 0x04, 0xef, 0xfe, 0xdf, 0x00, 0x56, 0x9b, 0xc4, 0x48, 0x97, 0x3d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -8150,6 +8171,21 @@ func (m *ResponseCheckTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Priority != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
 		i--
@@ -10882,6 +10918,12 @@ func (m *ResponseCheckTx) Size() (n int) {
 	if m.Priority != 0 {
 		n += 1 + sovTypes(uint64(m.Priority))
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.Pending {
+		n += 2
+	}
 	return n
 }
 
@@ -17999,6 +18041,45 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14,6 +14,7 @@ import (
 	"github.com/ari-anchor/sei-tendermint/internal/libs/progressbar"
 	"github.com/ari-anchor/sei-tendermint/internal/state"
 	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink"
 	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/kv"
 	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/psql"
 	"github.com/ari-anchor/sei-tendermint/internal/store"
@@ -124,6 +125,15 @@ func loadEventSinks(cfg *tmcfg.Config) ([]indexer.EventSink, error) {
 				return nil, err
 			}
 			eventSinks = append(eventSinks, es)
+		case string(indexer.SQLITE):
+			if cfg.TxIndex.SqlitePath == "" {
+				return nil, errors.New("the sqlite path cannot be empty")
+			}
+			es, err := sink.NewSQLiteEventSink(cfg.TxIndex.SqliteFile(), cfg.ChainID())
+			if err != nil {
+				return nil, err
+			}
+			eventSinks = append(eventSinks, es)
 		default:
 			return nil, errors.New("unsupported event sink type")
 		}
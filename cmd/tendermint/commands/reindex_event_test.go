@@ -14,6 +14,7 @@ import (
 	abcitypes "github.com/ari-anchor/sei-tendermint/abci/types"
 	"github.com/ari-anchor/sei-tendermint/config"
 	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink"
 	"github.com/ari-anchor/sei-tendermint/internal/state/mocks"
 	"github.com/ari-anchor/sei-tendermint/libs/log"
 	"github.com/ari-anchor/sei-tendermint/types"
@@ -93,11 +94,12 @@ func TestLoadEventSink(t *testing.T) {
 		{[]string{"PSQL"}, "", true},         // true because empty connect url
 		{[]string{"PSQL"}, "wrongUrl", true}, // true because wrong connect url
 		// skip to test PSQL connect with correct url
+		{[]string{"SQLITE"}, "", !sink.SQLiteAvailable},
 		{[]string{"UnsupportedSinkType"}, "wrongUrl", true},
 	}
 
 	for _, tc := range testCases {
-		cfg := config.TestConfig()
+		cfg := config.TestConfig().SetRoot(t.TempDir())
 		cfg.TxIndex.Indexer = tc.sinks
 		cfg.TxIndex.PsqlConn = tc.connURL
 		_, err := loadEventSinks(cfg)
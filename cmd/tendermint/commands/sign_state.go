@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ari-anchor/sei-tendermint/config"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	"github.com/ari-anchor/sei-tendermint/node"
+	"github.com/ari-anchor/sei-tendermint/privval"
+)
+
+// MakeExportSignStateCommand constructs a command to export the last sign
+// state of the private validator.
+func MakeExportSignStateCommand(conf *config.Config, logger log.Logger) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "export-sign-state",
+		Short: "Export the last sign state of the private validator",
+		Long: `Export the last height, round and step signed by the private validator
+configured for this node, along with the last signature, in the sign state
+interchange format documented in the privval package. The state can then be
+imported into another private validator backend with import-sign-state.
+
+The node must be stopped. If a remote signer is configured, the command waits
+for it to connect, or connects to it.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			pv, err := node.NewPrivValidator(ctx, conf, logger)
+			if err != nil {
+				return fmt.Errorf("can't load private validator: %w", err)
+			}
+
+			ic, err := privval.ExportSignStateInterchange(ctx, pv)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(ic, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal sign state: %w", err)
+			}
+
+			if output == "" {
+				fmt.Println(string(bz))
+				return nil
+			}
+			return os.WriteFile(output, bz, 0600)
+		},
+	}
+	cmd.Flags().StringVar(&output, "output", "", "file to write the sign state to, instead of stdout")
+
+	return cmd
+}
+
+// MakeImportSignStateCommand constructs a command to import a last sign state
+// into the private validator.
+func MakeImportSignStateCommand(conf *config.Config, logger log.Logger) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-sign-state [file]",
+		Short: "Import a last sign state into the private validator",
+		Long: `Import a last sign state, exported with export-sign-state, into the
+private validator configured for this node. The import is refused if the
+private validator holds another key, or if it already signed at a later
+height, round or step than the imported state, or a different message at the
+same ones.
+
+The node must be stopped. If a remote signer is configured, the command waits
+for it to connect, or connects to it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var ic privval.SignStateInterchange
+			if err := json.Unmarshal(bz, &ic); err != nil {
+				return fmt.Errorf("error reading sign state from %v: %w", args[0], err)
+			}
+
+			ctx := cmd.Context()
+			pv, err := node.NewPrivValidator(ctx, conf, logger)
+			if err != nil {
+				return fmt.Errorf("can't load private validator: %w", err)
+			}
+			if err := privval.ImportSignStateInterchange(ctx, pv, ic); err != nil {
+				return fmt.Errorf("can't import sign state: %w", err)
+			}
+
+			logger.Info("Imported sign state",
+				"height", ic.LastSignState.Height,
+				"round", ic.LastSignState.Round,
+				"step", ic.LastSignState.Step)
+			return nil
+		},
+	}
+}
@@ -39,6 +39,8 @@ func main() {
 		commands.MakeResetCommand(conf, logger),
 		commands.MakeUnsafeResetAllCommand(conf, logger),
 		commands.MakeShowValidatorCommand(conf, logger),
+		commands.MakeExportSignStateCommand(conf, logger),
+		commands.MakeImportSignStateCommand(conf, logger),
 		commands.MakeTestnetFilesCommand(conf, logger),
 		commands.MakeShowNodeIDCommand(conf),
 		commands.GenNodeKeyCmd,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -125,6 +126,7 @@ func (cfg *Config) SetRoot(root string) *Config {
 	cfg.Mempool.RootDir = root
 	cfg.Consensus.RootDir = root
 	cfg.PrivValidator.RootDir = root
+	cfg.TxIndex.RootDir = root
 	return cfg
 }
 
@@ -134,6 +136,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.BaseConfig.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := cfg.PrivValidator.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [priv-validator] section: %w", err)
+	}
 	if err := cfg.RPC.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [rpc] section: %w", err)
 	}
@@ -152,6 +157,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.SelfRemediation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [self-remediation] section: %w", err)
 	}
+	if err := cfg.DBSync.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [db-sync] section: %w", err)
+	}
 	return nil
 }
 
@@ -364,6 +372,25 @@ type PrivValidatorConfig struct {
 
 	// Path Root Certificate Authority used to sign both client and server certificates
 	RootCA string `mapstructure:"root-ca-file"`
+
+	// TCP or UNIX socket addresses for Tendermint to listen on for
+	// connections from several external PrivValidator processes holding the
+	// same key, for threshold signing. Exclusive with ListenAddr.
+	ThresholdListenAddrs []string `mapstructure:"threshold-laddrs"`
+
+	// Number of the external PrivValidator processes that must agree on a
+	// signature, which must be a majority of ThresholdListenAddrs.
+	Threshold int `mapstructure:"threshold"`
+
+	// HTTP address of a Consul agent, like http://127.0.0.1:8500, whose KV
+	// store holds the last sign state under StateKVKey instead of the state
+	// file, so that validators sharing the key, like a validator and its
+	// standby, never both sign at the same height, round and step. The ACL
+	// token, if any, is read from the CONSUL_HTTP_TOKEN environment variable.
+	StateKVAddr string `mapstructure:"state-kv-addr"`
+
+	// Key of the last sign state in the KV store at StateKVAddr.
+	StateKVKey string `mapstructure:"state-kv-key"`
 }
 
 // DefaultBaseConfig returns a default private validator configuration
@@ -400,6 +427,29 @@ func (cfg *PrivValidatorConfig) StateFile() string {
 	return rootify(cfg.State, cfg.RootDir)
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *PrivValidatorConfig) ValidateBasic() error {
+	if cfg.StateKVAddr != "" {
+		if u, err := url.Parse(cfg.StateKVAddr); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("state-kv-addr must be an http or https URL, got %q", cfg.StateKVAddr)
+		}
+		if cfg.StateKVKey == "" {
+			return errors.New("state-kv-key must be set along with state-kv-addr")
+		}
+	}
+	if len(cfg.ThresholdListenAddrs) == 0 {
+		return nil
+	}
+	if cfg.ListenAddr != "" {
+		return errors.New("laddr and threshold-laddrs cannot be both set")
+	}
+	if n := len(cfg.ThresholdListenAddrs); cfg.Threshold <= n/2 || cfg.Threshold > n {
+		return fmt.Errorf("threshold must be a majority of the %d threshold-laddrs, got %d", n, cfg.Threshold)
+	}
+	return nil
+}
+
 func (cfg *PrivValidatorConfig) AreSecurityOptionsPresent() bool {
 	switch {
 	case cfg.RootCA == "":
@@ -483,6 +533,27 @@ type RPCConfig struct {
 	// up to 2000, choose a value > 2000.
 	EventLogMaxItems int `mapstructure:"event-log-max-items"`
 
+	// If true, the event log is also written to a database in the data
+	// directory. Its events then survive restarts of the node, and remain
+	// available to the /events method after they leave the window, for up to
+	// EventLogRetention and EventLogMaxBytes.
+	EventLogPersist bool `mapstructure:"event-log-persist"`
+
+	// How long before the latest event a persistent event log retains events
+	// on disk. If 0, events are retained for EventLogWindowSize; otherwise
+	// this must not be shorter than EventLogWindowSize.
+	EventLogRetention time.Duration `mapstructure:"event-log-retention"`
+
+	// The maximum total size in bytes of the events a persistent event log
+	// retains on disk. If 0, no upper limit is set. Otherwise, the oldest
+	// events in excess of this size will be discarded.
+	EventLogMaxBytes int64 `mapstructure:"event-log-max-bytes"`
+
+	// TCP or UNIX socket address for the gRPC server to listen on, which
+	// streams the events of the event log to clients. If empty (the default),
+	// the gRPC server is disabled. The event log must be enabled.
+	GRPCListenAddress string `mapstructure:"grpc-laddr"`
+
 	// How long to wait for a tx to be committed during /broadcast_tx_commit
 	// WARNING: Using a value larger than 10s will result in increasing the
 	// global HTTP write timeout, which applies to all connections and endpoints.
@@ -534,6 +605,10 @@ func DefaultRPCConfig() *RPCConfig {
 		ExperimentalDisableWebsocket: false, // compatible with TM v0.35 and earlier
 		EventLogWindowSize:           30 * time.Second,
 		EventLogMaxItems:             0,
+		EventLogPersist:              false,
+		EventLogRetention:            0,
+		EventLogMaxBytes:             0,
+		GRPCListenAddress:            "",
 
 		TimeoutBroadcastTxCommit: 10 * time.Second,
 
@@ -571,6 +646,18 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.EventLogMaxItems < 0 {
 		return errors.New("event-log-max-items must not be negative")
 	}
+	if cfg.EventLogRetention < 0 {
+		return errors.New("event-log-retention must not be negative")
+	}
+	if cfg.EventLogRetention > 0 && cfg.EventLogRetention < cfg.EventLogWindowSize {
+		return errors.New("event-log-retention must not be shorter than event-log-window-size")
+	}
+	if cfg.EventLogMaxBytes < 0 {
+		return errors.New("event-log-max-bytes must not be negative")
+	}
+	if cfg.GRPCListenAddress != "" && cfg.EventLogWindowSize == 0 {
+		return errors.New("grpc-laddr requires the event log (event-log-window-size > 0)")
+	}
 	if cfg.TimeoutBroadcastTxCommit < 0 {
 		return errors.New("timeout-broadcast-tx-commit can't be negative")
 	}
@@ -791,6 +878,32 @@ type MempoolConfig struct {
 	// blacklist the peer.
 	CheckTxErrorBlacklistEnabled bool `mapstructure:"check-tx-error-blacklist-enabled"`
 	CheckTxErrorThreshold        int  `mapstructure:"check-tx-error-threshold"`
+
+	// ReplaceByFeeBump, if non-zero, allows a transaction to replace the
+	// transaction in the mempool with the same sender and nonce, if its
+	// priority is higher by at least this percentage. If zero, transactions
+	// conflicting with an existing transaction of the sender are rejected,
+	// which is the default.
+	ReplaceByFeeBump int64 `mapstructure:"replace-by-fee-bump"`
+
+	// MaxTxsPerSender and MaxTxsBytesPerSender, if non-zero, limit the number
+	// and the total size of the transactions of a single sender, as defined
+	// by the application, in the mempool.
+	MaxTxsPerSender      int   `mapstructure:"max-txs-per-sender"`
+	MaxTxsBytesPerSender int64 `mapstructure:"max-txs-bytes-per-sender"`
+
+	// MaxTxsPerPeer and MaxTxsBytesPerPeer, if non-zero, limit the number and
+	// the total size of the transactions admitted to the mempool from a single
+	// peer. Transactions submitted through RPC are not limited.
+	MaxTxsPerPeer      int   `mapstructure:"max-txs-per-peer"`
+	MaxTxsBytesPerPeer int64 `mapstructure:"max-txs-bytes-per-peer"`
+
+	// Journal, if true, records the transactions admitted to the mempool in
+	// the mempool database, and restores them through CheckTx when the node
+	// restarts. The TTLDuration and TTLNumBlocks limits apply to the restored
+	// transactions as of their original admission. The journal is written
+	// once per block, so a crash loses the transactions admitted since.
+	Journal bool `mapstructure:"journal"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Tendermint mempool.
@@ -808,6 +921,12 @@ func DefaultMempoolConfig() *MempoolConfig {
 		TxNotifyThreshold:            0,
 		CheckTxErrorBlacklistEnabled: false,
 		CheckTxErrorThreshold:        0,
+		ReplaceByFeeBump:             0,
+		MaxTxsPerSender:              0,
+		MaxTxsBytesPerSender:         0,
+		MaxTxsPerPeer:                0,
+		MaxTxsBytesPerPeer:           0,
+		Journal:                      false,
 	}
 }
 
@@ -845,6 +964,21 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.CheckTxErrorThreshold < 0 {
 		return errors.New("check-tx-error-threshold can't be negative")
 	}
+	if cfg.ReplaceByFeeBump < 0 {
+		return errors.New("replace-by-fee-bump can't be negative")
+	}
+	if cfg.MaxTxsPerSender < 0 {
+		return errors.New("max-txs-per-sender can't be negative")
+	}
+	if cfg.MaxTxsBytesPerSender < 0 {
+		return errors.New("max-txs-bytes-per-sender can't be negative")
+	}
+	if cfg.MaxTxsPerPeer < 0 {
+		return errors.New("max-txs-per-peer can't be negative")
+	}
+	if cfg.MaxTxsBytesPerPeer < 0 {
+		return errors.New("max-txs-bytes-per-peer can't be negative")
+	}
 
 	return nil
 }
@@ -901,6 +1035,19 @@ type StateSyncConfig struct {
 
 	// The number of concurrent chunk and block fetchers to run (default: 4).
 	Fetchers int32 `mapstructure:"fetchers"`
+
+	// The maximum number of snapshot chunks loaded from the application at the
+	// same time to serve peers (default: 4).
+	ChunkServeConcurrency int `mapstructure:"chunk-serve-concurrency"`
+
+	// The maximum number of chunk requests of a single peer waiting to be
+	// served. Further requests of the peer are dropped (default: 16).
+	ChunkServeQueueSize int `mapstructure:"chunk-serve-queue-size"`
+
+	// The number of snapshot and chunk requests per second each peer is
+	// allowed, in bursts of up to chunk-serve-queue-size requests. Requests
+	// beyond that are dropped. 0 disables the limit (default: 0).
+	ChunkServeRate float64 `mapstructure:"chunk-serve-rate"`
 }
 
 func (cfg *StateSyncConfig) TrustHashBytes() []byte {
@@ -915,12 +1062,15 @@ func (cfg *StateSyncConfig) TrustHashBytes() []byte {
 // DefaultStateSyncConfig returns a default configuration for the state sync service
 func DefaultStateSyncConfig() *StateSyncConfig {
 	return &StateSyncConfig{
-		TrustPeriod:         168 * time.Hour,
-		DiscoveryTime:       15 * time.Second,
-		ChunkRequestTimeout: 15 * time.Second,
-		Fetchers:            4,
-		BackfillBlocks:      0,
-		BackfillDuration:    0 * time.Second,
+		TrustPeriod:           168 * time.Hour,
+		DiscoveryTime:         15 * time.Second,
+		ChunkRequestTimeout:   15 * time.Second,
+		Fetchers:              4,
+		BackfillBlocks:        0,
+		BackfillDuration:      0 * time.Second,
+		ChunkServeConcurrency: 4,
+		ChunkServeQueueSize:   16,
+		ChunkServeRate:        0,
 	}
 }
 
@@ -931,6 +1081,17 @@ func TestStateSyncConfig() *StateSyncConfig {
 
 // ValidateBasic performs basic validation.
 func (cfg *StateSyncConfig) ValidateBasic() error {
+	// Snapshots are served to peers even if state sync is disabled.
+	if cfg.ChunkServeConcurrency <= 0 {
+		return errors.New("chunk-serve-concurrency must be positive")
+	}
+	if cfg.ChunkServeQueueSize <= 0 {
+		return errors.New("chunk-serve-queue-size must be positive")
+	}
+	if cfg.ChunkServeRate < 0 {
+		return errors.New("chunk-serve-rate must not be negative")
+	}
+
 	if !cfg.Enable {
 		return nil
 	}
@@ -1187,6 +1348,8 @@ func (cfg *ConsensusConfig) DeprecatedFieldWarning() error {
 // TxIndexConfig defines the configuration for the transaction indexer,
 // including composite keys to index.
 type TxIndexConfig struct {
+	RootDir string `mapstructure:"home"`
+
 	// The backend database list to back the indexer.
 	// If list contains `null`, meaning no indexer service will be used.
 	//
@@ -1194,21 +1357,36 @@ type TxIndexConfig struct {
 	//   1) "null" (default) - no indexer services.
 	//   2) "kv" - a simple indexer backed by key-value storage (see DBBackend)
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the indexer services backed by an embedded SQLite file.
+	//      It requires a binary built with the sqlite build tag (make build
+	//      TENDERMINT_BUILD_OPTIONS=sqlite), which requires cgo.
 	Indexer []string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// Path to the SQLite database file used by the sqlite indexer.
+	// A relative path is resolved against the home directory.
+	SqlitePath string `mapstructure:"sqlite-path"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
 func DefaultTxIndexConfig() *TxIndexConfig {
-	return &TxIndexConfig{Indexer: []string{"kv"}}
+	return &TxIndexConfig{
+		Indexer:    []string{"kv"},
+		SqlitePath: filepath.Join(defaultDataDir, "tx_index.sqlite"),
+	}
 }
 
 // TestTxIndexConfig returns a default configuration for the transaction indexer.
 func TestTxIndexConfig() *TxIndexConfig {
-	return &TxIndexConfig{Indexer: []string{"kv"}}
+	return DefaultTxIndexConfig()
+}
+
+// SqliteFile returns the full path to the SQLite database file.
+func (cfg *TxIndexConfig) SqliteFile() string {
+	return rootify(cfg.SqlitePath, cfg.RootDir)
 }
 
 //-----------------------------------------------------------------------------
@@ -1265,12 +1443,19 @@ type DBSyncConfig struct {
 	// application DB. Note that it will NOT automatically detect whether
 	// the application DB is good-to-go or not upon start, and will always
 	// perform the import, so if the import is complete, this flag should
-	// be turned off the next time the chain restarts.
+	// be turned off the next time the chain restarts. An import that was
+	// interrupted resumes from the file chunks already on disk.
 	Enable bool `mapstructure:"db-sync-enable"`
-	// This is NOT currently used but reserved for future implementation
-	// of snapshotting logics that don't require chain halts.
-	SnapshotInterval     int           `mapstructure:"snapshot-interval"`
-	SnapshotDirectory    string        `mapstructure:"snapshot-directory"`
+	// The node takes a snapshot into SnapshotDirectory every SnapshotInterval
+	// blocks while it is running. The application must run in process and
+	// implement dbsync.SnapshotCoordinator, so that its DB is held consistent
+	// while it is copied, or the node fails to start. 0 disables periodic
+	// snapshots.
+	SnapshotInterval  int    `mapstructure:"snapshot-interval"`
+	SnapshotDirectory string `mapstructure:"snapshot-directory"`
+	// The number of most recent snapshots to keep in SnapshotDirectory when
+	// taking periodic snapshots. 0 keeps all snapshots.
+	SnapshotKeepRecent   int           `mapstructure:"snapshot-keep-recent"`
 	SnapshotWorkerCount  int           `mapstructure:"snapshot-worker-count"`
 	TimeoutInSeconds     int           `mapstructure:"timeout-in-seconds"`
 	NoFileSleepInSeconds int           `mapstructure:"no-file-sleep-in-seconds"`
@@ -1286,6 +1471,7 @@ func DefaultDBSyncConfig() *DBSyncConfig {
 		Enable:               false,
 		SnapshotInterval:     0,
 		SnapshotDirectory:    "",
+		SnapshotKeepRecent:   2,
 		SnapshotWorkerCount:  16,
 		TimeoutInSeconds:     1200,
 		NoFileSleepInSeconds: 1,
@@ -1297,6 +1483,20 @@ func DefaultDBSyncConfig() *DBSyncConfig {
 	}
 }
 
+// ValidateBasic performs basic validation.
+func (cfg *DBSyncConfig) ValidateBasic() error {
+	if cfg.SnapshotInterval < 0 {
+		return errors.New("snapshot-interval can't be negative")
+	}
+	if cfg.SnapshotKeepRecent < 0 {
+		return errors.New("snapshot-keep-recent can't be negative")
+	}
+	if cfg.SnapshotInterval > 0 && cfg.SnapshotWorkerCount <= 0 {
+		return errors.New("snapshot-worker-count must be positive when snapshot-interval is set")
+	}
+	return nil
+}
+
 func (cfg *DBSyncConfig) TrustHashBytes() []byte {
 	// validated in ValidateBasic, so we can safely panic here
 	bytes, err := hex.DecodeString(cfg.TrustHash)
@@ -58,6 +58,35 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	assert.Error(t, cfg.ValidateBasic())
 }
 
+func TestPrivValidatorConfigValidateBasic(t *testing.T) {
+	cfg := DefaultPrivValidatorConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.ThresholdListenAddrs = []string{"tcp://127.0.0.1:1", "tcp://127.0.0.1:2", "tcp://127.0.0.1:3"}
+	cfg.Threshold = 2
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// the threshold must be a majority
+	cfg.Threshold = 1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Threshold = 4
+	assert.Error(t, cfg.ValidateBasic())
+
+	// laddr cannot be set along with threshold-laddrs
+	cfg.Threshold = 3
+	cfg.ListenAddr = "tcp://127.0.0.1:4"
+	assert.Error(t, cfg.ValidateBasic())
+
+	// the state KV store needs an HTTP address and a key
+	cfg = DefaultPrivValidatorConfig()
+	cfg.StateKVAddr = "http://127.0.0.1:8500"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.StateKVKey = "validator/state"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.StateKVAddr = "127.0.0.1:8500"
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestRPCConfigValidateBasic(t *testing.T) {
 	cfg := TestRPCConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -87,6 +116,11 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"ReplaceByFeeBump",
+		"MaxTxsPerSender",
+		"MaxTxsBytesPerSender",
+		"MaxTxsPerPeer",
+		"MaxTxsBytesPerPeer",
 	}
 
 	for _, fieldName := range fieldsToTest {
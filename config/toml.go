@@ -175,6 +175,27 @@ client-key-file = "{{ js .PrivValidator.ClientKey }}"
 # Path to the Root Certificate Authority used to sign both client and server certificates
 root-ca-file = "{{ js .PrivValidator.RootCA }}"
 
+# TCP or UNIX socket addresses for Tendermint to listen on for connections
+# from several external PrivValidator processes holding the same key.
+# Votes and proposals are only signed once "threshold" of them agree on the
+# signature, and never below the height, round and step last signed, which
+# are tracked in the state-file. Cannot be used along with laddr.
+threshold-laddrs = [{{ range .PrivValidator.ThresholdListenAddrs }}{{ printf "%q, " . }}{{end}}]
+
+# Number of the external PrivValidator processes that must agree on a
+# signature, which must be a majority of threshold-laddrs
+threshold = {{ .PrivValidator.Threshold }}
+
+# HTTP address of a Consul agent, like "http://127.0.0.1:8500", whose KV store
+# holds the last sign state under state-kv-key instead of the state-file, so
+# that validators sharing the key, like a validator and its standby, never both
+# sign at the same height, round and step. The key-file must exist. The ACL
+# token, if any, is read from the CONSUL_HTTP_TOKEN environment variable.
+state-kv-addr = "{{ .PrivValidator.StateKVAddr }}"
+
+# Key of the last sign state in the KV store at state-kv-addr
+state-kv-key = "{{ .PrivValidator.StateKVKey }}"
+
 
 #######################################################################
 ###                 Advanced Configuration Options                  ###
@@ -247,6 +268,28 @@ event-log-window-size = "{{ .RPC.EventLogWindowSize }}"
 # up to 2000, choose a value > 2000.
 event-log-max-items = {{ .RPC.EventLogMaxItems }}
 
+# If true, the event log is also written to a database in the data
+# directory. Its events then survive restarts of the node, and remain
+# available to the /events method after they leave the window, for up to
+# event-log-retention and event-log-max-bytes.
+event-log-persist = {{ .RPC.EventLogPersist }}
+
+# How long before the latest event a persistent event log retains events
+# on disk. If 0, events are retained for event-log-window-size; otherwise
+# this must not be shorter than event-log-window-size.
+event-log-retention = "{{ .RPC.EventLogRetention }}"
+
+# The maximum total size in bytes of the events a persistent event log
+# retains on disk. If 0, no upper limit is set. Otherwise, the oldest
+# events in excess of this size will be discarded.
+event-log-max-bytes = {{ .RPC.EventLogMaxBytes }}
+
+# TCP or UNIX socket address for the gRPC server to listen on, which
+# streams the events of the event log matching a query to clients.
+# If empty (the default), the gRPC server is disabled. The event log
+# must be enabled (event-log-window-size > 0).
+grpc-laddr = "{{ .RPC.GRPCListenAddress }}"
+
 # How long to wait for a tx to be committed during /broadcast_tx_commit.
 # WARNING: Using a value larger than 10s will result in increasing the
 # global HTTP write timeout, which applies to all connections and endpoints.
@@ -405,6 +448,36 @@ check-tx-error-blacklist-enabled = {{ .Mempool.CheckTxErrorBlacklistEnabled }}
 
 check-tx-error-threshold = {{ .Mempool.CheckTxErrorThreshold }}
 
+# replace-by-fee-bump, if non-zero, allows a transaction to replace the
+# transaction in the mempool with the same sender and nonce, if its priority
+# is higher by at least this percentage. If zero, a transaction conflicting
+# with an existing transaction of the same sender is rejected.
+#
+# Replacement is disabled by default, so a node keeps the transaction it saw
+# first unless its operator opts in, e.g. with a bump of 10.
+replace-by-fee-bump = {{ .Mempool.ReplaceByFeeBump }}
+
+# max-txs-per-sender and max-txs-bytes-per-sender, if non-zero, limit the
+# number and the total size of the transactions of a single sender, as defined
+# by the application, in the mempool. A sender over its quota can only replace
+# its own transactions of lower priority.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+max-txs-bytes-per-sender = {{ .Mempool.MaxTxsBytesPerSender }}
+
+# max-txs-per-peer and max-txs-bytes-per-peer, if non-zero, limit the number
+# and the total size of the transactions admitted to the mempool from a single
+# peer. A peer over its quota can only replace the transactions it sent of
+# lower priority. Transactions submitted through RPC are not limited.
+max-txs-per-peer = {{ .Mempool.MaxTxsPerPeer }}
+max-txs-bytes-per-peer = {{ .Mempool.MaxTxsBytesPerPeer }}
+
+# journal, if true, records the transactions admitted to the mempool in the
+# mempool database, and restores them through CheckTx when the node restarts.
+# The ttl-duration and ttl-num-blocks limits apply to the restored transactions
+# as of their original admission. The journal is written once per block, so a
+# crash loses the transactions admitted since.
+journal = {{ .Mempool.Journal }}
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -449,6 +522,19 @@ chunk-request-timeout = "{{ .StateSync.ChunkRequestTimeout }}"
 # The number of concurrent chunk and block fetchers to run (default: 4).
 fetchers = "{{ .StateSync.Fetchers }}"
 
+# The maximum number of snapshot chunks loaded from the application at the same
+# time to serve peers (default: 4).
+chunk-serve-concurrency = {{ .StateSync.ChunkServeConcurrency }}
+
+# The maximum number of chunk requests of a single peer waiting to be served.
+# Further requests of the peer are dropped (default: 16).
+chunk-serve-queue-size = {{ .StateSync.ChunkServeQueueSize }}
+
+# The number of snapshot and chunk requests per second each peer is allowed, in
+# bursts of up to chunk-serve-queue-size requests. Requests beyond that are
+# dropped. 0 disables the limit (default: 0).
+chunk-serve-rate = {{ .StateSync.ChunkServeRate }}
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -532,13 +618,20 @@ unsafe-commit-timeout-override = "{{ .Consensus.UnsafeCommitTimeoutOverride }}"
 #   1) "null" (default) - no indexer services.
 #   2) "kv" - a simple indexer backed by key-value storage (see DBBackend)
 #   3) "psql" - the indexer services backed by PostgreSQL.
-# When "kv" or "psql" is chosen "tx.height" and "tx.hash" will always be indexed.
+#   4) "sqlite" - the indexer services backed by an embedded SQLite file.
+#      It requires a binary built with the sqlite build tag (make build
+#      TENDERMINT_BUILD_OPTIONS=sqlite), which requires cgo.
+# When "kv", "psql" or "sqlite" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = [{{ range $i, $e := .TxIndex.Indexer }}{{if $i}}, {{end}}{{ printf "%q" $e}}{{end}}]
 
 # The PostgreSQL connection configuration, the connection format:
 #   postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 psql-conn = "{{ .TxIndex.PsqlConn }}"
 
+# Path to the SQLite database file used by the sqlite indexer.
+# A relative path is resolved against the home directory.
+sqlite-path = "{{ js .TxIndex.SqlitePath }}"
+
 #######################################################
 ###       Instrumentation Configuration Options     ###
 #######################################################
@@ -588,6 +681,7 @@ restart-cooldown-seconds = {{ .SelfRemediation.RestartCooldownSeconds }}
 db-sync-enable = "{{ .DBSync.Enable }}"
 snapshot-interval = "{{ .DBSync.SnapshotInterval }}"
 snapshot-directory = "{{ .DBSync.SnapshotDirectory }}"
+snapshot-keep-recent = "{{ .DBSync.SnapshotKeepRecent }}"
 snapshot-worker-count = "{{ .DBSync.SnapshotWorkerCount }}"
 timeout-in-seconds = "{{ .DBSync.TimeoutInSeconds }}"
 no-file-sleep-in-seconds = "{{ .DBSync.NoFileSleepInSeconds }}"
@@ -26,6 +26,9 @@ to its pool via AddBlock.
 
 Internally, v0 runs a poolRoutine that constantly checks for what blocks it needs
 and requests them. The poolRoutine is also responsible for taking blocks from the
-pool, saving and executing each block.
+pool, saving and executing each block. While a block is executed, the commits
+of the next blocks in the pool are verified in the background against the
+validators they are expected to be signed by, and the results are used once
+these blocks are executed with matching validators.
 */
 package blocksync
@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ari-anchor/sei-tendermint/internal/consensus"
 	"github.com/ari-anchor/sei-tendermint/internal/libs/flowrate"
 	"github.com/ari-anchor/sei-tendermint/libs/log"
 	"github.com/ari-anchor/sei-tendermint/libs/service"
@@ -44,6 +45,22 @@ const (
 
 	// Maximum difference between current and new block's height.
 	maxDiffBetweenCurrentAndReceivedBlockHeight = 100
+
+	// Weight of a new sample in the moving averages of the latency and
+	// throughput of a peer.
+	peerStatsAlpha = 0.2
+
+	// Latency assumed for peers with no samples while no peer has any.
+	defaultPeerLatency = time.Second
+
+	// A request is hedged, that is also sent to a second peer, if the first
+	// peer did not respond within hedgeLatencyFactor times its average
+	// latency, and at least minHedgeTimeout. Only the requests for the
+	// hedgeWindow heights above pool.height are hedged, since these are the
+	// ones holding back the sync.
+	hedgeLatencyFactor = 3
+	minHedgeTimeout    = 500 * time.Millisecond
+	hedgeWindow        = maxPendingRequestsPerPeer
 )
 
 var peerTimeout = 15 * time.Second // not const so we can override with tests
@@ -57,6 +74,12 @@ var peerTimeout = 15 * time.Second // not const so we can override with tests
 	Requests are continuously made for blocks of higher heights until
 	the limit is reached. If most of the requests have no available peers, and we
 	are not at peer limits, we can probably switch to consensus reactor
+
+	Each request goes to the peer expected to respond first, given the moving
+	average of its latency and the number of requests it has pending, so fast
+	peers are assigned more requests. If a peer is slow to respond to one of
+	the lowest requests, the request is sent to a second peer as well, and the
+	first block received is used.
 */
 
 // BlockRequest stores a block request identified by the block Height and the
@@ -86,6 +109,7 @@ type BlockPool struct {
 
 	requestsCh chan<- BlockRequest
 	errorsCh   chan<- peerError
+	metrics    *consensus.Metrics
 
 	startHeight               int64
 	lastHundredBlockTimeStamp time.Time
@@ -99,6 +123,7 @@ func NewBlockPool(
 	start int64,
 	requestsCh chan<- BlockRequest,
 	errorsCh chan<- peerError,
+	metrics *consensus.Metrics,
 ) *BlockPool {
 
 	bp := &BlockPool{
@@ -110,6 +135,7 @@ func NewBlockPool(
 		numPending:   0,
 		requestsCh:   requestsCh,
 		errorsCh:     errorsCh,
+		metrics:      metrics,
 		lastSyncRate: 0,
 	}
 	bp.BaseService = *service.NewBaseService(logger, "BlockPool", bp)
@@ -221,6 +247,27 @@ func (pool *BlockPool) PeekTwoBlocks() (first, second *types.Block, firstExtComm
 	return
 }
 
+// PeekBlocks returns up to n consecutive blocks starting at pool.height,
+// stopping before the first block that was not received yet.
+func (pool *BlockPool) PeekBlocks(n int) []*types.Block {
+	pool.mtx.RLock()
+	defer pool.mtx.RUnlock()
+
+	blocks := make([]*types.Block, 0, n)
+	for height := pool.height; len(blocks) < n; height++ {
+		r := pool.requesters[height]
+		if r == nil {
+			break
+		}
+		block := r.getBlock()
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
 // PopRequest pops the first block at pool.height.
 // It must have been validated by the second Commit from PeekTwoBlocks.
 // TODO(thane): (?) and its corresponding ExtendedCommit.
@@ -296,12 +343,22 @@ func (pool *BlockPool) AddBlock(peerID types.NodeID, block *types.Block, extComm
 		return fmt.Errorf("peer sent us a block we didn't expect (peer: %s, current height: %d, block height: %d)", peerID, pool.height, block.Height)
 	}
 
-	if requester.setBlock(block, extCommit, peerID) {
+	if ok, latency, hedgePeerID := requester.setBlock(block, extCommit, peerID); ok {
 		atomic.AddInt32(&pool.numPending, -1)
 		peer := pool.peers[peerID]
 		if peer != nil {
 			peer.decrPending(blockSize)
+			peer.addSample(blockSize, latency)
+		}
+		// The other peer the request was sent to is no longer expected to
+		// respond.
+		if peer := pool.peers[hedgePeerID]; hedgePeerID != "" && peer != nil {
+			peer.cancelPending()
 		}
+	} else if requester.requestedFrom(peerID) {
+		// The block was already received from the other peer the request was
+		// sent to.
+		return nil
 	} else {
 		err := errors.New("requester is different or block already exists")
 		pool.sendError(err, peerID)
@@ -354,6 +411,40 @@ func (pool *BlockPool) SetPeerRange(peerID types.NodeID, base int64, height int6
 	}
 }
 
+// PeerStats are the statistics of the block requests made to a peer.
+type PeerStats struct {
+	// Height is the height reported by the peer.
+	Height int64
+	// NumPending is the number of requests awaiting a response.
+	NumPending int32
+	// NumReceived is the number of blocks received from the peer.
+	NumReceived int64
+	// Latency is the moving average of the time the peer takes to respond to
+	// a request.
+	Latency time.Duration
+	// Throughput is the moving average of the rate in bytes per second at
+	// which the peer sends blocks.
+	Throughput float64
+}
+
+// PeerStats returns the statistics of each peer.
+func (pool *BlockPool) PeerStats() map[types.NodeID]PeerStats {
+	pool.mtx.RLock()
+	defer pool.mtx.RUnlock()
+
+	stats := make(map[types.NodeID]PeerStats, len(pool.peers))
+	for id, peer := range pool.peers {
+		stats[id] = PeerStats{
+			Height:      peer.height,
+			NumPending:  peer.numPending,
+			NumReceived: peer.numSamples,
+			Latency:     peer.avgLatency,
+			Throughput:  peer.avgThroughput,
+		}
+	}
+	return stats
+}
+
 // RemovePeer removes the peer with peerID from the pool. If there's no peer
 // with peerID, function is a no-op.
 func (pool *BlockPool) RemovePeer(peerID types.NodeID) {
@@ -365,7 +456,7 @@ func (pool *BlockPool) RemovePeer(peerID types.NodeID) {
 
 func (pool *BlockPool) removePeer(peerID types.NodeID) {
 	for _, requester := range pool.requesters {
-		if requester.getPeerID() == peerID {
+		if requester.getPeerID() == peerID || requester.getHedgePeerID() == peerID {
 			requester.redo(peerID)
 		}
 	}
@@ -378,6 +469,11 @@ func (pool *BlockPool) removePeer(peerID types.NodeID) {
 
 		delete(pool.peers, peerID)
 
+		// The gauges cannot be deleted, so zero them to stop reporting the
+		// statistics of a peer that is gone.
+		pool.metrics.BlockSyncPeerLatency.With("peer_id", string(peerID)).Set(0)
+		pool.metrics.BlockSyncPeerThroughput.With("peer_id", string(peerID)).Set(0)
+
 		// Find a new peer with the biggest height and update maxPeerHeight if the
 		// peer's height was the biggest.
 		if peer.height == pool.maxPeerHeight {
@@ -397,27 +493,80 @@ func (pool *BlockPool) updateMaxPeerHeight() {
 	pool.maxPeerHeight = max
 }
 
-// Pick an available peer with the given height available.
+// Pick the available peer with the given height available that is expected to
+// respond first, other than the excluded one. Peers with no samples are
+// assumed to be as fast as the fastest peer, so that they get measured.
 // If no peers are available, returns nil.
-func (pool *BlockPool) pickIncrAvailablePeer(height int64) *bpPeer {
+func (pool *BlockPool) pickIncrAvailablePeer(height int64, exclude types.NodeID) *bpPeer {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
 
+	var (
+		best     *bpPeer
+		bestCost time.Duration
+	)
+	minLatency := pool.minPeerLatency()
 	for _, peer := range pool.peers {
 		if peer.didTimeout {
 			pool.removePeer(peer.id)
 			continue
 		}
-		if peer.numPending >= maxPendingRequestsPerPeer {
+		if peer.id == exclude || peer.numPending >= maxPendingRequestsPerPeer {
 			continue
 		}
 		if height < peer.base || height > peer.height {
 			continue
 		}
-		peer.incrPending()
-		return peer
+
+		latency := peer.avgLatency
+		if peer.numSamples == 0 {
+			latency = minLatency
+		}
+		cost := latency * time.Duration(peer.numPending+1)
+		if best == nil || cost < bestCost {
+			best, bestCost = peer, cost
+		}
 	}
-	return nil
+	if best != nil {
+		best.incrPending()
+	}
+	return best
+}
+
+// minPeerLatency returns the lowest average latency of the peers with
+// samples, or defaultPeerLatency if there are none.
+func (pool *BlockPool) minPeerLatency() time.Duration {
+	var min time.Duration
+	for _, peer := range pool.peers {
+		if peer.numSamples > 0 && (min == 0 || peer.avgLatency < min) {
+			min = peer.avgLatency
+		}
+	}
+	if min == 0 {
+		return defaultPeerLatency
+	}
+	return min
+}
+
+// hedgeTimeout returns how long to wait for the peer to respond to the
+// request for the block at height before hedging it, and false if the
+// request should not be hedged.
+func (pool *BlockPool) hedgeTimeout(height int64, peerID types.NodeID) (time.Duration, bool) {
+	pool.mtx.RLock()
+	defer pool.mtx.RUnlock()
+
+	if height >= pool.height+hedgeWindow {
+		return 0, false
+	}
+	peer := pool.peers[peerID]
+	if peer == nil {
+		return 0, false
+	}
+	timeout := hedgeLatencyFactor * peer.avgLatency
+	if timeout < minHedgeTimeout {
+		timeout = minHedgeTimeout
+	}
+	return timeout, true
 }
 
 func (pool *BlockPool) makeNextRequester(ctx context.Context) {
@@ -440,6 +589,16 @@ func (pool *BlockPool) makeNextRequester(ctx context.Context) {
 	}
 }
 
+// cancelPending decrements the number of pending requests of the peer for a
+// request that was not sent.
+func (pool *BlockPool) cancelPending(peerID types.NodeID) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	if peer := pool.peers[peerID]; peer != nil {
+		peer.cancelPending()
+	}
+}
+
 func (pool *BlockPool) requestersLen() int64 {
 	return int64(len(pool.requesters))
 }
@@ -504,6 +663,12 @@ type bpPeer struct {
 	id          types.NodeID
 	recvMonitor *flowrate.Monitor
 
+	// moving averages of the time to respond to a request and of the rate
+	// at which blocks are received, over numSamples blocks.
+	avgLatency    time.Duration
+	avgThroughput float64
+	numSamples    int64
+
 	timeout *time.Timer
 	startAt time.Time
 
@@ -542,6 +707,35 @@ func (peer *bpPeer) decrPending(recvSize int) {
 	}
 }
 
+// cancelPending decrements the number of pending requests of the peer for a
+// request it is no longer expected to respond to.
+func (peer *bpPeer) cancelPending() {
+	peer.numPending--
+	if peer.numPending == 0 {
+		peer.timeout.Stop()
+	}
+}
+
+// addSample updates the moving averages with a block of recvSize bytes
+// received latency after it was requested.
+func (peer *bpPeer) addSample(recvSize int, latency time.Duration) {
+	if latency <= 0 {
+		latency = time.Microsecond
+	}
+	throughput := float64(recvSize) / latency.Seconds()
+	if peer.numSamples == 0 {
+		peer.avgLatency = latency
+		peer.avgThroughput = throughput
+	} else {
+		peer.avgLatency = time.Duration((1-peerStatsAlpha)*float64(peer.avgLatency) + peerStatsAlpha*float64(latency))
+		peer.avgThroughput = (1-peerStatsAlpha)*peer.avgThroughput + peerStatsAlpha*throughput
+	}
+	peer.numSamples++
+
+	peer.pool.metrics.BlockSyncPeerLatency.With("peer_id", string(peer.id)).Set(peer.avgLatency.Seconds())
+	peer.pool.metrics.BlockSyncPeerThroughput.With("peer_id", string(peer.id)).Set(peer.avgThroughput)
+}
+
 func (peer *bpPeer) onTimeout() {
 	peer.pool.mtx.Lock()
 	defer peer.pool.mtx.Unlock()
@@ -562,10 +756,15 @@ type bpRequester struct {
 	gotBlockCh chan struct{}
 	redoCh     chan types.NodeID // redo may send multitime, add peerId to identify repeat
 
-	mtx       sync.Mutex
-	peerID    types.NodeID
-	block     *types.Block
-	extCommit *types.ExtendedCommit
+	mtx         sync.Mutex
+	peerID      types.NodeID
+	requestedAt time.Time
+	// hedgePeerID is the second peer the request was sent to, if any. Once
+	// the block is received, it is the peer that did not send it.
+	hedgePeerID      types.NodeID
+	hedgeRequestedAt time.Time
+	block            *types.Block
+	extCommit        *types.ExtendedCommit
 }
 
 func newBPRequester(logger log.Logger, pool *BlockPool, height int64) *bpRequester {
@@ -590,13 +789,25 @@ func (bpr *bpRequester) OnStart(ctx context.Context) error {
 
 func (*bpRequester) OnStop() {}
 
-// Returns true if the peer matches and block doesn't already exist.
-func (bpr *bpRequester) setBlock(block *types.Block, extCommit *types.ExtendedCommit, peerID types.NodeID) bool {
+// Returns true if the peer matches and block doesn't already exist, along
+// with the time the peer took to respond, and the other peer the request was
+// sent to, if any.
+func (bpr *bpRequester) setBlock(
+	block *types.Block,
+	extCommit *types.ExtendedCommit,
+	peerID types.NodeID,
+) (bool, time.Duration, types.NodeID) {
 	bpr.mtx.Lock()
-	if bpr.block != nil || bpr.peerID != peerID {
+	if bpr.block != nil || peerID == "" || (bpr.peerID != peerID && bpr.hedgePeerID != peerID) {
 		bpr.mtx.Unlock()
-		return false
+		return false, 0, ""
+	}
+	if bpr.hedgePeerID == peerID {
+		bpr.peerID, bpr.hedgePeerID = bpr.hedgePeerID, bpr.peerID
+		bpr.requestedAt, bpr.hedgeRequestedAt = bpr.hedgeRequestedAt, bpr.requestedAt
 	}
+	latency := time.Since(bpr.requestedAt)
+	hedgePeerID := bpr.hedgePeerID
 	bpr.block = block
 	if extCommit != nil {
 		bpr.extCommit = extCommit
@@ -607,7 +818,14 @@ func (bpr *bpRequester) setBlock(block *types.Block, extCommit *types.ExtendedCo
 	case bpr.gotBlockCh <- struct{}{}:
 	default:
 	}
-	return true
+	return true, latency, hedgePeerID
+}
+
+// requestedFrom reports whether the block was requested from the peer.
+func (bpr *bpRequester) requestedFrom(peerID types.NodeID) bool {
+	bpr.mtx.Lock()
+	defer bpr.mtx.Unlock()
+	return peerID != "" && (bpr.peerID == peerID || bpr.hedgePeerID == peerID)
 }
 
 func (bpr *bpRequester) getBlock() *types.Block {
@@ -628,6 +846,12 @@ func (bpr *bpRequester) getPeerID() types.NodeID {
 	return bpr.peerID
 }
 
+func (bpr *bpRequester) getHedgePeerID() types.NodeID {
+	bpr.mtx.Lock()
+	defer bpr.mtx.Unlock()
+	return bpr.hedgePeerID
+}
+
 // This is called from the requestRoutine, upon redo().
 func (bpr *bpRequester) reset() {
 	bpr.mtx.Lock()
@@ -638,10 +862,63 @@ func (bpr *bpRequester) reset() {
 	}
 
 	bpr.peerID = ""
+	bpr.hedgePeerID = ""
 	bpr.block = nil
 	bpr.extCommit = nil
 }
 
+// setPeer records that the block was requested from the peer.
+func (bpr *bpRequester) setPeer(peerID types.NodeID) {
+	bpr.mtx.Lock()
+	defer bpr.mtx.Unlock()
+	bpr.peerID = peerID
+	bpr.requestedAt = time.Now()
+}
+
+// setHedgePeer records that the block was also requested from the peer, and
+// reports whether it still had to be.
+func (bpr *bpRequester) setHedgePeer(peerID types.NodeID) bool {
+	bpr.mtx.Lock()
+	defer bpr.mtx.Unlock()
+	if bpr.block != nil || bpr.hedgePeerID != "" {
+		return false
+	}
+	bpr.hedgePeerID = peerID
+	bpr.hedgeRequestedAt = time.Now()
+	return true
+}
+
+// needsHedge reports whether the block was neither received nor requested
+// from a second peer yet.
+func (bpr *bpRequester) needsHedge() bool {
+	bpr.mtx.Lock()
+	defer bpr.mtx.Unlock()
+	return bpr.block == nil && bpr.hedgePeerID == ""
+}
+
+// removePeer handles the removal of the peer, and reports whether the block
+// must be requested again. If the block was not received yet and the request
+// was also sent to another peer, that peer remains responsible for it.
+func (bpr *bpRequester) removePeer(peerID types.NodeID) bool {
+	bpr.mtx.Lock()
+	defer bpr.mtx.Unlock()
+
+	switch {
+	case peerID == "":
+		return false
+	case peerID == bpr.hedgePeerID:
+		bpr.hedgePeerID = ""
+		return false
+	case peerID != bpr.peerID:
+		return false
+	case bpr.block == nil && bpr.hedgePeerID != "":
+		bpr.peerID, bpr.requestedAt = bpr.hedgePeerID, bpr.hedgeRequestedAt
+		bpr.hedgePeerID = ""
+		return false
+	}
+	return true
+}
+
 // Tells bpRequester to pick another peer and try again.
 // NOTE: Nonblocking, and does nothing if another redo
 // was already requested.
@@ -668,7 +945,7 @@ OUTER_LOOP:
 				return
 			}
 
-			peer = bpr.pool.pickIncrAvailablePeer(bpr.height)
+			peer = bpr.pool.pickIncrAvailablePeer(bpr.height, "")
 			if peer == nil {
 				// This is preferable to using a timer because the request
 				// interval is so small. Larger request intervals may
@@ -678,29 +955,63 @@ OUTER_LOOP:
 			}
 			break PICK_PEER_LOOP
 		}
-		bpr.mtx.Lock()
-		bpr.peerID = peer.id
-		bpr.mtx.Unlock()
+		bpr.setPeer(peer.id)
 
 		// Send request and wait.
 		bpr.pool.sendRequest(bpr.height, peer.id)
+
+		// Hedge the request if the peer is slow to respond.
+		hedgeTimer := time.NewTimer(0)
+		if !hedgeTimer.Stop() {
+			<-hedgeTimer.C
+		}
+		if timeout, ok := bpr.pool.hedgeTimeout(bpr.height, peer.id); ok {
+			hedgeTimer.Reset(timeout)
+		}
 	WAIT_LOOP:
 		for {
 			select {
 			case <-ctx.Done():
+				hedgeTimer.Stop()
 				return
 			case peerID := <-bpr.redoCh:
-				if peerID == bpr.peerID {
+				if bpr.removePeer(peerID) {
+					hedgeTimer.Stop()
 					bpr.reset()
 					continue OUTER_LOOP
 				} else {
 					continue WAIT_LOOP
 				}
+			case <-hedgeTimer.C:
+				bpr.hedge(ctx, hedgeTimer)
+				continue WAIT_LOOP
 			case <-bpr.gotBlockCh:
 				// We got a block!
 				// Continue the for-loop and wait til Quit.
+				hedgeTimer.Stop()
 				continue WAIT_LOOP
 			}
 		}
 	}
 }
+
+// hedge sends the request to a second peer, unless the block was received in
+// the meantime. If no other peer is available, the timer is reset to try
+// again later.
+func (bpr *bpRequester) hedge(ctx context.Context, timer *time.Timer) {
+	if !bpr.needsHedge() || !bpr.pool.IsRunning() || ctx.Err() != nil {
+		return
+	}
+	peer := bpr.pool.pickIncrAvailablePeer(bpr.height, bpr.getPeerID())
+	if peer == nil {
+		timer.Reset(minHedgeTimeout)
+		return
+	}
+	if !bpr.setHedgePeer(peer.id) {
+		bpr.pool.cancelPending(peer.id)
+		return
+	}
+	bpr.logger.Debug("hedging block request", "height", bpr.height, "peer", peer.id)
+	bpr.pool.metrics.BlockSyncHedgedRequests.Add(1)
+	bpr.pool.sendRequest(bpr.height, peer.id)
+}
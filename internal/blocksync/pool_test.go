@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ari-anchor/sei-tendermint/internal/consensus"
 	"github.com/ari-anchor/sei-tendermint/libs/log"
 	tmrand "github.com/ari-anchor/sei-tendermint/libs/rand"
 	"github.com/ari-anchor/sei-tendermint/types"
@@ -89,7 +90,7 @@ func TestBlockPoolBasic(t *testing.T) {
 	peers := makePeers(10, start+1, 1000)
 	errorsCh := make(chan peerError, 1000)
 	requestsCh := make(chan BlockRequest, 1000)
-	pool := NewBlockPool(log.NewNopLogger(), start, requestsCh, errorsCh)
+	pool := NewBlockPool(log.NewNopLogger(), start, requestsCh, errorsCh, consensus.NopMetrics())
 
 	if err := pool.Start(ctx); err != nil {
 		t.Error(err)
@@ -147,7 +148,7 @@ func TestBlockPoolTimeout(t *testing.T) {
 	peers := makePeers(10, start+1, 1000)
 	errorsCh := make(chan peerError, 1000)
 	requestsCh := make(chan BlockRequest, 1000)
-	pool := NewBlockPool(logger, start, requestsCh, errorsCh)
+	pool := NewBlockPool(logger, start, requestsCh, errorsCh, consensus.NopMetrics())
 	err := pool.Start(ctx)
 	if err != nil {
 		t.Error(err)
@@ -210,7 +211,7 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 	requestsCh := make(chan BlockRequest)
 	errorsCh := make(chan peerError)
 
-	pool := NewBlockPool(log.NewNopLogger(), 1, requestsCh, errorsCh)
+	pool := NewBlockPool(log.NewNopLogger(), 1, requestsCh, errorsCh, consensus.NopMetrics())
 	err := pool.Start(ctx)
 	require.NoError(t, err)
 	t.Cleanup(func() { cancel(); pool.Wait() })
@@ -235,3 +236,90 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 
 	assert.EqualValues(t, 0, pool.MaxPeerHeight())
 }
+
+func TestBlockPoolPicksFastPeers(t *testing.T) {
+	pool := NewBlockPool(log.NewNopLogger(), 1, make(chan BlockRequest), make(chan peerError), consensus.NopMetrics())
+
+	fast, slow := types.NodeID("fast"), types.NodeID("slow")
+	pool.SetPeerRange(fast, 1, 100)
+	pool.SetPeerRange(slow, 1, 100)
+	pool.peers[fast].addSample(1000, 10*time.Millisecond)
+	pool.peers[slow].addSample(1000, 100*time.Millisecond)
+	t.Cleanup(func() {
+		for _, peer := range pool.peers {
+			if peer.timeout != nil {
+				peer.timeout.Stop()
+			}
+		}
+	})
+
+	// The fast peer is assigned requests until it is expected to respond
+	// later than the slow one.
+	picks := make(map[types.NodeID]int)
+	for i := 0; i < 12; i++ {
+		peer := pool.pickIncrAvailablePeer(1, "")
+		require.NotNil(t, peer)
+		picks[peer.id]++
+	}
+	assert.Equal(t, 11, picks[fast])
+	assert.Equal(t, 1, picks[slow])
+
+	// The excluded peer is never picked.
+	peer := pool.pickIncrAvailablePeer(1, slow)
+	require.NotNil(t, peer)
+	assert.Equal(t, fast, peer.id)
+
+	stats := pool.PeerStats()
+	assert.EqualValues(t, 12, stats[fast].NumPending)
+	assert.EqualValues(t, 1, stats[fast].NumReceived)
+	assert.Equal(t, 10*time.Millisecond, stats[fast].Latency)
+	assert.InDelta(t, 100000, stats[fast].Throughput, 1)
+}
+
+func TestBlockPoolHedgesSlowRequests(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	requestsCh := make(chan BlockRequest)
+	errorsCh := make(chan peerError, 10)
+	pool := NewBlockPool(log.NewNopLogger(), 1, requestsCh, errorsCh, consensus.NopMetrics())
+	require.NoError(t, pool.Start(ctx))
+	t.Cleanup(func() { cancel(); pool.Wait() })
+
+	pool.SetPeerRange("a", 1, 1)
+	pool.SetPeerRange("b", 1, 1)
+
+	// The first peer does not respond, so the request is sent to the other
+	// one as well.
+	var first, second BlockRequest
+	select {
+	case first = <-requestsCh:
+	case <-time.After(time.Second):
+		t.Fatal("no request was made")
+	}
+	select {
+	case second = <-requestsCh:
+	case <-time.After(3 * minHedgeTimeout):
+		t.Fatal("the request was not hedged")
+	}
+	assert.EqualValues(t, 1, first.Height)
+	assert.EqualValues(t, 1, second.Height)
+	assert.NotEqual(t, first.PeerID, second.PeerID)
+
+	// The first block received is used, and the late one is ignored.
+	block := &types.Block{Header: types.Header{Height: 1}}
+	require.NoError(t, pool.AddBlock(second.PeerID, block, nil, 100))
+	require.NoError(t, pool.AddBlock(first.PeerID, &types.Block{Header: types.Header{Height: 1}}, nil, 100))
+	got, _, _ := pool.PeekTwoBlocks()
+	assert.Same(t, block, got)
+	assert.Empty(t, errorsCh)
+
+	stats := pool.PeerStats()
+	assert.EqualValues(t, 0, stats[first.PeerID].NumPending)
+	assert.EqualValues(t, 0, stats[first.PeerID].NumReceived)
+	assert.EqualValues(t, 0, stats[second.PeerID].NumPending)
+	assert.EqualValues(t, 1, stats[second.PeerID].NumReceived)
+
+	// The peer that sent the block is responsible for it.
+	assert.Equal(t, second.PeerID, pool.RedoRequest(1))
+}
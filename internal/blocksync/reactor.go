@@ -159,7 +159,7 @@ func (r *Reactor) OnStart(ctx context.Context) error {
 
 	requestsCh := make(chan BlockRequest, maxTotalRequesters)
 	errorsCh := make(chan peerError, maxPeerErrBuffer) // NOTE: The capacity should be larger than the peer count.
-	r.pool = NewBlockPool(r.logger, startHeight, requestsCh, errorsCh)
+	r.pool = NewBlockPool(r.logger, startHeight, requestsCh, errorsCh, r.metrics)
 	r.requestsCh = requestsCh
 	r.errorsCh = errorsCh
 
@@ -480,8 +480,8 @@ func (r *Reactor) poolRoutine(ctx context.Context, stateSynced bool, blockSyncCh
 
 		blocksSynced = uint64(0)
 
-		chainID = r.initialState.ChainID
-		state   = r.initialState
+		state    = r.initialState
+		verifier = newCommitVerifier(r.initialState.ChainID)
 
 		lastHundred = time.Now()
 		lastRate    = 0.0
@@ -601,18 +601,9 @@ func (r *Reactor) poolRoutine(ctx context.Context, stateSynced bool, blockSyncCh
 			// try again quickly next loop
 			didProcessCh <- struct{}{}
 
-			firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
-			if err != nil {
-				r.logger.Error("failed to make ",
-					"height", first.Height,
-					"err", err.Error())
-				return
-			}
-
-			var (
-				firstPartSetHeader = firstParts.Header()
-				firstID            = types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
-			)
+			// Verify the commits of the next blocks while this one is
+			// applied.
+			verifier.schedule(state, r.pool.PeekBlocks(verifyAhead+2))
 
 			// Finally, verify the first block using the second's commit.
 			//
@@ -620,7 +611,19 @@ func (r *Reactor) poolRoutine(ctx context.Context, stateSynced bool, blockSyncCh
 			// first.Hash() doesn't verify the tx contents, so MakePartSet() is
 			// currently necessary.
 			// TODO(sergio): Should we also validate against the extended commit?
-			err = state.Validators.VerifyCommitLight(chainID, firstID, first.Height, second.LastCommit)
+			verification := verifier.verify(state.Validators, first, second.LastCommit)
+			if verification.partsErr != nil {
+				r.logger.Error("failed to make ",
+					"height", first.Height,
+					"err", verification.partsErr.Error())
+				return
+			}
+
+			var (
+				firstParts = verification.parts
+				firstID    = verification.blockID
+				err        = verification.err
+			)
 
 			if err == nil {
 				// validate the block before we persist it
@@ -705,6 +708,12 @@ func (r *Reactor) GetMaxPeerBlockHeight() int64 {
 	return r.pool.MaxPeerHeight()
 }
 
+// GetPeerStats returns the statistics of the block requests made to each
+// peer.
+func (r *Reactor) GetPeerStats() map[types.NodeID]PeerStats {
+	return r.pool.PeerStats()
+}
+
 func (r *Reactor) GetTotalSyncedTime() time.Duration {
 	if !r.blockSync.IsSet() || r.syncStartTime.IsZero() {
 		return time.Duration(0)
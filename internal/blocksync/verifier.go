@@ -0,0 +1,138 @@
+package blocksync
+
+import (
+	"bytes"
+	"sync"
+
+	sm "github.com/ari-anchor/sei-tendermint/internal/state"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// verifyAhead is the maximum number of blocks past the one being applied
+// whose commits are verified concurrently with its application.
+const verifyAhead = 8
+
+// blockVerification is the verification of a block with the LastCommit of
+// the next block, against a validator set. Its results are set once done is
+// closed.
+type blockVerification struct {
+	block    *types.Block
+	commit   *types.Commit
+	valsHash []byte
+
+	parts    *types.PartSet
+	partsErr error
+	blockID  types.BlockID
+	err      error
+
+	done chan struct{}
+}
+
+func newBlockVerification(block *types.Block, commit *types.Commit) *blockVerification {
+	return &blockVerification{block: block, commit: commit, done: make(chan struct{})}
+}
+
+// run makes the part set of the block, and verifies the commit of its block
+// ID against the validators.
+func (bv *blockVerification) run(chainID string, vals *types.ValidatorSet) {
+	defer close(bv.done)
+
+	bv.valsHash = vals.Hash()
+	bv.parts, bv.partsErr = bv.block.MakePartSet(types.BlockPartSizeBytes)
+	if bv.partsErr != nil {
+		return
+	}
+	bv.blockID = types.BlockID{Hash: bv.block.Hash(), PartSetHeader: bv.parts.Header()}
+	bv.err = vals.VerifyCommitLight(chainID, bv.blockID, bv.block.Height, bv.commit)
+}
+
+// commitVerifier pipelines the verification of the blocks of the pool: while
+// a block is being applied, the commits of the next blocks are verified in
+// the background. The validators of these blocks are not known until the
+// blocks before them are applied, so each block is speculatively verified
+// against the validators of the state that hash to its ValidatorsHash, and
+// the verification is only used if the validators of the state match when
+// the block is applied. Otherwise, the block is verified again.
+type commitVerifier struct {
+	chainID string
+
+	mtx           sync.Mutex
+	verifications map[int64]*blockVerification
+}
+
+func newCommitVerifier(chainID string) *commitVerifier {
+	return &commitVerifier{
+		chainID:       chainID,
+		verifications: make(map[int64]*blockVerification),
+	}
+}
+
+// schedule starts verifying in the background each of the given consecutive
+// blocks, except the first one, which is about to be verified, and the last
+// one, with the LastCommit of the block after it. Blocks that are already
+// being verified are skipped.
+func (cv *commitVerifier) schedule(state sm.State, blocks []*types.Block) {
+	cv.mtx.Lock()
+	defer cv.mtx.Unlock()
+
+	// The validator sets are copied, since verifying a commit may set their
+	// proposer.
+	var vals, nextVals *types.ValidatorSet
+	for i := 1; i < len(blocks)-1; i++ {
+		block, commit := blocks[i], blocks[i+1].LastCommit
+		if bv, ok := cv.verifications[block.Height]; ok && bv.block == block && bv.commit == commit {
+			continue
+		}
+
+		var candidate *types.ValidatorSet
+		switch {
+		case bytes.Equal(block.ValidatorsHash, state.NextValidators.Hash()):
+			if nextVals == nil {
+				nextVals = copyValidatorSet(state.NextValidators)
+			}
+			candidate = nextVals
+		case bytes.Equal(block.ValidatorsHash, state.Validators.Hash()):
+			if vals == nil {
+				vals = copyValidatorSet(state.Validators)
+			}
+			candidate = vals
+		default:
+			continue
+		}
+
+		bv := newBlockVerification(block, commit)
+		cv.verifications[block.Height] = bv
+		go bv.run(cv.chainID, candidate)
+	}
+}
+
+// verify returns the verification of the block with the commit against the
+// validators. It uses the background verification of the block if there is a
+// matching one, waiting for it to complete, and verifies the block otherwise.
+// The verifications of the block and of the blocks below it are discarded.
+func (cv *commitVerifier) verify(vals *types.ValidatorSet, block *types.Block, commit *types.Commit) *blockVerification {
+	cv.mtx.Lock()
+	bv := cv.verifications[block.Height]
+	for height := range cv.verifications {
+		if height <= block.Height {
+			delete(cv.verifications, height)
+		}
+	}
+	cv.mtx.Unlock()
+
+	if bv != nil && bv.block == block && bv.commit == commit {
+		<-bv.done
+		if bytes.Equal(bv.valsHash, vals.Hash()) {
+			return bv
+		}
+	}
+	bv = newBlockVerification(block, commit)
+	bv.run(cv.chainID, vals)
+	return bv
+}
+
+func copyValidatorSet(vals *types.ValidatorSet) *types.ValidatorSet {
+	vals = vals.Copy()
+	vals.GetProposer()
+	return vals
+}
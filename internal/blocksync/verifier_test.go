@@ -0,0 +1,101 @@
+package blocksync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sm "github.com/ari-anchor/sei-tendermint/internal/state"
+	"github.com/ari-anchor/sei-tendermint/internal/test/factory"
+	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// makeVerifierChain returns n consecutive blocks from height 1, each
+// carrying the commit of the previous one, signed by the validators.
+func makeVerifierChain(
+	ctx context.Context,
+	t *testing.T,
+	chainID string,
+	vals *types.ValidatorSet,
+	privVals []types.PrivValidator,
+	n int,
+) []*types.Block {
+	t.Helper()
+
+	blocks := make([]*types.Block, 0, n)
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= int64(n); height++ {
+		block := types.MakeBlock(height, factory.MakeNTxs(height, 2), lastCommit, nil)
+		block.ChainID = chainID
+		block.ValidatorsHash = vals.Hash()
+		block.NextValidatorsHash = vals.Hash()
+		blocks = append(blocks, block)
+
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		voteSet := types.NewExtendedVoteSet(chainID, height, 0, tmproto.PrecommitType, vals)
+		extCommit, err := factory.MakeExtendedCommit(ctx, blockID, height, 0, voteSet, privVals, time.Now())
+		require.NoError(t, err)
+		lastCommit = extCommit.ToCommit()
+	}
+	return blocks
+}
+
+func TestCommitVerifier(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const chainID = "test-chain"
+	vals, privVals := factory.ValidatorSet(ctx, t, 4, 10)
+	otherVals, _ := factory.ValidatorSet(ctx, t, 4, 10)
+	blocks := makeVerifierChain(ctx, t, chainID, vals, privVals, verifyAhead+2)
+	state := sm.State{
+		ChainID:        chainID,
+		Validators:     vals,
+		NextValidators: vals,
+	}
+
+	cv := newCommitVerifier(chainID)
+	cv.schedule(state, blocks)
+	require.Len(t, cv.verifications, len(blocks)-2)
+
+	// The background verification is used if the validators match.
+	bv := cv.verify(vals, blocks[1], blocks[2].LastCommit)
+	require.NoError(t, bv.err)
+	require.NoError(t, bv.partsErr)
+	assert.Equal(t, blocks[1].Hash(), bv.blockID.Hash)
+	assert.Equal(t, blocks[2].LastCommit, bv.commit)
+	assert.NotContains(t, cv.verifications, blocks[1].Height)
+
+	// The block is verified again if the validators differ.
+	bv = cv.verify(otherVals, blocks[2], blocks[3].LastCommit)
+	assert.Error(t, bv.err)
+	assert.NotContains(t, cv.verifications, blocks[2].Height)
+
+	// The block is verified again if the commit differs.
+	commit := *blocks[4].LastCommit
+	commit.Signatures = append([]types.CommitSig(nil), commit.Signatures...)
+	for i := range commit.Signatures {
+		commit.Signatures[i].Signature = make([]byte, len(commit.Signatures[i].Signature))
+	}
+	bv = cv.verify(vals, blocks[3], &commit)
+	assert.Error(t, bv.err)
+
+	// Verifying a block discards the verifications below it.
+	bv = cv.verify(vals, blocks[len(blocks)-2], blocks[len(blocks)-1].LastCommit)
+	require.NoError(t, bv.err)
+	assert.Empty(t, cv.verifications)
+
+	// Blocks signed by unknown validators are not scheduled.
+	cv.schedule(sm.State{
+		ChainID:        chainID,
+		Validators:     otherVals,
+		NextValidators: otherVals,
+	}, blocks)
+	assert.Empty(t, cv.verifications)
+}
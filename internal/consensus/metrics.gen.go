@@ -132,6 +132,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "state_syncing",
 			Help:      "Whether or not a node is state syncing. 1 if yes, 0 if no.",
 		}, labels).With(labelsAndValues...),
+		BlockSyncPeerLatency: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_sync_peer_latency",
+			Help:      "Moving average of the time in seconds a peer takes to respond to a block sync request.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		BlockSyncPeerThroughput: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_sync_peer_throughput",
+			Help:      "Moving average of the rate in bytes per second at which a peer sends blocks during block sync.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		BlockSyncHedgedRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_sync_hedged_requests",
+			Help:      "Number of block sync requests sent to a second peer because the first one was slow to respond.",
+		}, labels).With(labelsAndValues...),
 		BlockParts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -184,6 +202,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "missing_txs",
 			Help:      "Number of missing txs when a proposal is received",
 		}, append(labels, "proposer_address")).With(labelsAndValues...),
+		ProposalTxsRequested: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "proposal_txs_requested",
+			Help:      "Number of missing proposal txs requested from peers.",
+		}, labels).With(labelsAndValues...),
+		ProposalTxsReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "proposal_txs_received",
+			Help:      "Number of requested proposal txs received from peers, separated by whether the tx matched a key in the current proposal or not.",
+		}, append(labels, "matches_current")).With(labelsAndValues...),
 		QuorumPrevoteDelay: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -294,6 +324,9 @@ func NopMetrics() *Metrics {
 		CommittedHeight:               discard.NewGauge(),
 		BlockSyncing:                  discard.NewGauge(),
 		StateSyncing:                  discard.NewGauge(),
+		BlockSyncPeerLatency:          discard.NewGauge(),
+		BlockSyncPeerThroughput:       discard.NewGauge(),
+		BlockSyncHedgedRequests:       discard.NewCounter(),
 		BlockParts:                    discard.NewCounter(),
 		StepDuration:                  discard.NewHistogram(),
 		BlockGossipReceiveLatency:     discard.NewHistogram(),
@@ -302,6 +335,8 @@ func NopMetrics() *Metrics {
 		ProposalTxs:                   discard.NewGauge(),
 		ProposalMissingTxs:            discard.NewGauge(),
 		MissingTxs:                    discard.NewGauge(),
+		ProposalTxsRequested:          discard.NewCounter(),
+		ProposalTxsReceived:           discard.NewCounter(),
 		QuorumPrevoteDelay:            discard.NewGauge(),
 		FullPrevoteDelay:              discard.NewGauge(),
 		ProposalTimestampDifference:   discard.NewHistogram(),
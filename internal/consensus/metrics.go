@@ -65,6 +65,15 @@ type Metrics struct {
 	BlockSyncing metrics.Gauge
 	// Whether or not a node is state syncing. 1 if yes, 0 if no.
 	StateSyncing metrics.Gauge
+	// Moving average of the time in seconds a peer takes to respond to a
+	// block sync request.
+	BlockSyncPeerLatency metrics.Gauge `metrics_labels:"peer_id"`
+	// Moving average of the rate in bytes per second at which a peer sends
+	// blocks during block sync.
+	BlockSyncPeerThroughput metrics.Gauge `metrics_labels:"peer_id"`
+	// Number of block sync requests sent to a second peer because the first
+	// one was slow to respond.
+	BlockSyncHedgedRequests metrics.Counter
 
 	// Number of block parts transmitted by each peer.
 	BlockParts metrics.Counter `metrics_labels:"peer_id"`
@@ -94,6 +103,13 @@ type Metrics struct {
 	//Number of missing txs when a proposal is received
 	MissingTxs metrics.Gauge `metrics_labels:"proposer_address"`
 
+	// Number of missing proposal txs requested from peers.
+	ProposalTxsRequested metrics.Counter
+
+	// Number of requested proposal txs received from peers, separated by
+	// whether the tx matched a key in the current proposal or not.
+	ProposalTxsReceived metrics.Counter `metrics_labels:"matches_current"`
+
 	// QuroumPrevoteMessageDelay is the interval in seconds between the proposal
 	// timestamp and the timestamp of the earliest prevote that achieved a quorum
 	// during the prevote step.
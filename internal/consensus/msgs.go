@@ -32,6 +32,8 @@ func init() {
 	jsontypes.MustRegister(&HasVoteMessage{})
 	jsontypes.MustRegister(&VoteSetMaj23Message{})
 	jsontypes.MustRegister(&VoteSetBitsMessage{})
+	jsontypes.MustRegister(&TxRequestMessage{})
+	jsontypes.MustRegister(&TxResponseMessage{})
 }
 
 // NewRoundStepMessage is sent for every step taken in the ConsensusState.
@@ -216,6 +218,11 @@ func (m *BlockPartMessage) String() string {
 // VoteMessage is sent when voting for a proposal (or lack thereof).
 type VoteMessage struct {
 	Vote *types.Vote
+
+	// sigVerified is set by the reactor once it verified the signatures of
+	// the vote. It is not encoded, so votes replayed from the WAL are
+	// verified again.
+	sigVerified bool
 }
 
 func (*VoteMessage) TypeTag() string { return "tendermint/Vote" }
@@ -332,6 +339,67 @@ func (m *VoteSetBitsMessage) String() string {
 	return fmt.Sprintf("[VSB %v/%02d/%v %v %v]", m.Height, m.Round, m.Type, m.BlockID, m.Votes)
 }
 
+// TxRequestMessage is sent to request the transactions of a key-only proposal
+// which are missing from the local mempool.
+type TxRequestMessage struct {
+	Height int64 `json:",string"`
+	Round  int32
+	TxKeys []types.TxKey
+}
+
+func (*TxRequestMessage) TypeTag() string { return "tendermint/TxRequest" }
+
+// ValidateBasic performs basic validation.
+func (m *TxRequestMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if len(m.TxKeys) == 0 {
+		return errors.New("empty TxKeys")
+	}
+	if len(m.TxKeys) > maxTxKeysPerRequest {
+		return fmt.Errorf("too many TxKeys: %d, max: %d", len(m.TxKeys), maxTxKeysPerRequest)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *TxRequestMessage) String() string {
+	return fmt.Sprintf("[TxRequest H:%v R:%v N:%v]", m.Height, m.Round, len(m.TxKeys))
+}
+
+// TxResponseMessage is sent in reply to a TxRequestMessage and carries the
+// requested transactions the peer was able to find.
+type TxResponseMessage struct {
+	Height int64 `json:",string"`
+	Round  int32
+	Txs    types.Txs
+}
+
+func (*TxResponseMessage) TypeTag() string { return "tendermint/TxResponse" }
+
+// ValidateBasic performs basic validation.
+func (m *TxResponseMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if len(m.Txs) > maxTxKeysPerRequest {
+		return fmt.Errorf("too many Txs: %d, max: %d", len(m.Txs), maxTxKeysPerRequest)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *TxResponseMessage) String() string {
+	return fmt.Sprintf("[TxResponse H:%v R:%v N:%v]", m.Height, m.Round, len(m.Txs))
+}
+
 // MsgToProto takes a consensus message type and returns the proto defined
 // consensus message.
 //
@@ -455,6 +523,30 @@ func MsgToProto(msg Message) (*tmcons.Message, error) {
 		pb = tmcons.Message{
 			Sum: vsb,
 		}
+	case *TxRequestMessage:
+		txKeys := make([]*tmproto.TxKey, len(msg.TxKeys))
+		for i := range msg.TxKeys {
+			txKeys[i] = msg.TxKeys[i].ToProto()
+		}
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_TxRequest{
+				TxRequest: &tmcons.TxRequest{
+					Height: msg.Height,
+					Round:  msg.Round,
+					TxKeys: txKeys,
+				},
+			},
+		}
+	case *TxResponseMessage:
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_TxResponse{
+				TxResponse: &tmcons.TxResponse{
+					Height: msg.Height,
+					Round:  msg.Round,
+					Txs:    msg.Txs.ToSliceOfBytes(),
+				},
+			},
+		}
 
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
@@ -580,6 +672,29 @@ func MsgFromProto(msg *tmcons.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *tmcons.Message_TxRequest:
+		txKeys := make([]types.TxKey, len(msg.TxRequest.TxKeys))
+		for i, pbKey := range msg.TxRequest.TxKeys {
+			if pbKey == nil || len(pbKey.TxKey) != len(txKeys[i]) {
+				return nil, fmt.Errorf("invalid tx key at index %d", i)
+			}
+			copy(txKeys[i][:], pbKey.TxKey)
+		}
+		pb = &TxRequestMessage{
+			Height: msg.TxRequest.Height,
+			Round:  msg.TxRequest.Round,
+			TxKeys: txKeys,
+		}
+	case *tmcons.Message_TxResponse:
+		txs := make(types.Txs, len(msg.TxResponse.Txs))
+		for i, tx := range msg.TxResponse.Txs {
+			txs[i] = tx
+		}
+		pb = &TxResponseMessage{
+			Height: msg.TxResponse.Height,
+			Round:  msg.TxResponse.Round,
+			Txs:    txs,
+		}
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
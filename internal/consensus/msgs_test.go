@@ -86,6 +86,9 @@ func TestMsgToProto(t *testing.T) {
 	require.NoError(t, err)
 	pbVote := vote.ToProto()
 
+	tx := types.Tx("test")
+	txKey := tx.Key()
+
 	testsCases := []struct {
 		testName string
 		msg      Message
@@ -202,6 +205,32 @@ func TestMsgToProto(t *testing.T) {
 				},
 			},
 		}, false},
+		{"successful TxRequestMessage", &TxRequestMessage{
+			Height: 1,
+			Round:  1,
+			TxKeys: []types.TxKey{txKey},
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_TxRequest{
+				TxRequest: &tmcons.TxRequest{
+					Height: 1,
+					Round:  1,
+					TxKeys: []*tmproto.TxKey{txKey.ToProto()},
+				},
+			},
+		}, false},
+		{"successful TxResponseMessage", &TxResponseMessage{
+			Height: 1,
+			Round:  1,
+			Txs:    types.Txs{tx},
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_TxResponse{
+				TxResponse: &tmcons.TxResponse{
+					Height: 1,
+					Round:  1,
+					Txs:    [][]byte{tx},
+				},
+			},
+		}, false},
 		{"failure", nil, &tmcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
@@ -749,3 +778,48 @@ func TestHasVoteMessageValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestTxRequestMessageValidateBasic(t *testing.T) {
+	txKey := types.Tx("test").Key()
+	testCases := []struct {
+		testName      string
+		messageHeight int64
+		messageRound  int32
+		messageTxKeys []types.TxKey
+		expectErr     bool
+	}{
+		{"Valid Message", 1, 0, []types.TxKey{txKey}, false},
+		{"Negative Height", -1, 0, []types.TxKey{txKey}, true},
+		{"Negative Round", 1, -1, []types.TxKey{txKey}, true},
+		{"Empty TxKeys", 1, 0, nil, true},
+		{"Too Many TxKeys", 1, 0, make([]types.TxKey, maxTxKeysPerRequest+1), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			message := TxRequestMessage{
+				Height: tc.messageHeight,
+				Round:  tc.messageRound,
+				TxKeys: tc.messageTxKeys,
+			}
+
+			assert.Equal(t, tc.expectErr, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestTxRequestMessageFromProtoInvalidKey(t *testing.T) {
+	pb := &tmcons.Message{
+		Sum: &tmcons.Message_TxRequest{
+			TxRequest: &tmcons.TxRequest{
+				Height: 1,
+				Round:  1,
+				TxKeys: []*tmproto.TxKey{{TxKey: tmrand.Bytes(64)}},
+			},
+		},
+	}
+
+	_, err := MsgFromProto(pb)
+	require.Error(t, err)
+}
@@ -78,14 +78,34 @@ func GetVoteSetChannelDescriptor() *p2p.ChannelDescriptor {
 	}
 }
 
+func GetTxChannelDescriptor() *p2p.ChannelDescriptor {
+	return &p2p.ChannelDescriptor{
+		ID:                  TxChannel,
+		MessageType:         new(tmcons.Message),
+		Priority:            11,
+		SendQueueCapacity:   64,
+		RecvBufferCapacity:  128,
+		RecvMessageCapacity: maxMsgSize,
+		Name:                "tx",
+	}
+}
+
 const (
 	StateChannel       = p2p.ChannelID(0x20)
 	DataChannel        = p2p.ChannelID(0x21)
 	VoteChannel        = p2p.ChannelID(0x22)
 	VoteSetBitsChannel = p2p.ChannelID(0x23)
+	TxChannel          = p2p.ChannelID(0x24)
 
 	maxMsgSize = 4194304 // 4MB; NOTE: keep larger than types.PartSet sizes.
 
+	// maxTxKeysPerRequest is the maximum number of tx keys in a TxRequest and
+	// txs in a TxResponse.
+	maxTxKeysPerRequest = 10000
+	// maxTxResponseSize is the maximum total size of the txs in a single
+	// TxResponse, leaving room for the message envelope.
+	maxTxResponseSize = maxMsgSize - 1024
+
 	blocksToContributeToBecomeGoodPeer = 10000
 	votesToContributeToBecomeGoodPeer  = 10000
 
@@ -135,6 +155,8 @@ type Reactor struct {
 	peerEvents p2p.PeerEventSubscriber
 
 	channels *channelBundle
+
+	voteVerifier *voteVerifier
 }
 
 // NewReactor returns a reference to a new consensus reactor, which implements
@@ -161,6 +183,7 @@ func NewReactor(
 		readySignal: make(chan struct{}),
 		channels:    &channelBundle{},
 	}
+	r.voteVerifier = newVoteVerifier(logger, cs)
 	r.BaseService = *service.NewBaseService(logger, "Consensus", r)
 
 	if !r.waitSync {
@@ -175,6 +198,7 @@ type channelBundle struct {
 	data   *p2p.Channel
 	vote   *p2p.Channel
 	votSet *p2p.Channel
+	tx     *p2p.Channel
 }
 
 func (r *Reactor) SetStateChannel(ch *p2p.Channel) {
@@ -193,6 +217,10 @@ func (r *Reactor) SetVoteSetChannel(ch *p2p.Channel) {
 	r.channels.votSet = ch
 }
 
+func (r *Reactor) SetTxChannel(ch *p2p.Channel) {
+	r.channels.tx = ch
+}
+
 // OnStart starts separate go routines for each p2p Channel and listens for
 // envelopes on each. In addition, it also listens for peer updates and handles
 // messages on that p2p channel accordingly. The caller must be sure to execute
@@ -223,7 +251,10 @@ func (r *Reactor) OnStart(ctx context.Context) error {
 	go r.processStateCh(ctx, *r.channels)
 	go r.processDataCh(ctx, *r.channels)
 	go r.processVoteCh(ctx, *r.channels)
+	go r.voteVerifier.run(ctx, r.channels.vote)
 	go r.processVoteSetBitsCh(ctx, *r.channels)
+	go r.processTxCh(ctx, *r.channels)
+	go r.requestTxsRoutine(ctx, r.channels.tx)
 	go r.processPeerUpdates(ctx, peerUpdates, *r.channels)
 
 	return nil
@@ -1187,12 +1218,9 @@ func (r *Reactor) handleVoteMessage(ctx context.Context, envelope *p2p.Envelope,
 			return err
 		}
 
-		select {
-		case r.state.peerMsgQueue <- msgInfo{vMsg, envelope.From, tmtime.Now()}:
-			return nil
-		case <-ctx.Done():
-			return ctx.Err()
-		}
+		// The signatures of the vote are verified before it is passed on to
+		// the consensus state.
+		return r.voteVerifier.enqueue(ctx, msgInfo{vMsg, envelope.From, tmtime.Now()})
 	default:
 		return fmt.Errorf("received unknown message on VoteChannel: %T", msg)
 	}
@@ -1250,6 +1278,157 @@ func (r *Reactor) handleVoteSetBitsMessage(ctx context.Context, envelope *p2p.En
 	return nil
 }
 
+// handleTxMessage handles envelopes sent from peers on the TxChannel. Requests
+// are answered with the txs we have either in the proposal block or in the
+// mempool, and responses are passed on to the consensus state. If we fail to
+// find the peer state for the envelope sender, we perform a no-op and return.
+func (r *Reactor) handleTxMessage(ctx context.Context, envelope *p2p.Envelope, msgI Message, txCh *p2p.Channel) error {
+	logger := r.logger.With("peer", envelope.From, "ch_id", "TxChannel")
+
+	ps, ok := r.GetPeerState(envelope.From)
+	if !ok || ps == nil {
+		r.logger.Debug("failed to find peer state")
+		return nil
+	}
+
+	if r.WaitSync() {
+		logger.Debug("ignoring message received during sync", "msg", msgI)
+		return nil
+	}
+
+	switch msg := envelope.Message.(type) {
+	case *tmcons.TxRequest:
+		return r.respondToTxRequest(ctx, envelope.From, msgI.(*TxRequestMessage), txCh)
+
+	case *tmcons.TxResponse:
+		trMsg := msgI.(*TxResponseMessage)
+		if len(trMsg.Txs) == 0 {
+			return nil
+		}
+
+		select {
+		case r.state.peerMsgQueue <- msgInfo{trMsg, envelope.From, tmtime.Now()}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+	default:
+		return fmt.Errorf("received unknown message on TxChannel: %T", msg)
+	}
+}
+
+// respondToTxRequest looks up the requested txs, first in the proposal block
+// of the requested height (if we have it) and then in the mempool, and sends
+// the ones found back to the requesting peer, split into as many TxResponse
+// messages as needed to stay within the message size limit.
+func (r *Reactor) respondToTxRequest(ctx context.Context, peerID types.NodeID, msg *TxRequestMessage, txCh *p2p.Channel) error {
+	found := make(map[types.TxKey]types.Tx, len(msg.TxKeys))
+
+	rs := r.getRoundState()
+	if rs.Height == msg.Height && rs.ProposalBlock != nil {
+		for _, tx := range rs.ProposalBlock.Txs {
+			found[tx.Key()] = tx
+		}
+	}
+
+	var mempoolTxKeys []types.TxKey
+	for _, txKey := range msg.TxKeys {
+		if _, ok := found[txKey]; !ok {
+			mempoolTxKeys = append(mempoolTxKeys, txKey)
+		}
+	}
+	for _, tx := range r.state.blockExec.GetTxsForKeys(mempoolTxKeys) {
+		found[tx.Key()] = tx
+	}
+
+	var (
+		txs  types.Txs
+		size int
+	)
+	for _, txKey := range msg.TxKeys {
+		tx, ok := found[txKey]
+		if !ok || len(tx) > maxTxResponseSize {
+			continue
+		}
+		if size+len(tx) > maxTxResponseSize {
+			if err := r.sendTxResponse(ctx, peerID, msg, txs, txCh); err != nil {
+				return err
+			}
+			txs, size = nil, 0
+		}
+		txs = append(txs, tx)
+		size += len(tx)
+	}
+
+	// Always respond, even if empty, so the requester knows we have nothing.
+	return r.sendTxResponse(ctx, peerID, msg, txs, txCh)
+}
+
+func (r *Reactor) sendTxResponse(ctx context.Context, peerID types.NodeID, msg *TxRequestMessage, txs types.Txs, txCh *p2p.Channel) error {
+	return txCh.Send(ctx, p2p.Envelope{
+		To: peerID,
+		Message: &tmcons.TxResponse{
+			Height: msg.Height,
+			Round:  msg.Round,
+			Txs:    txs.ToSliceOfBytes(),
+		},
+	})
+}
+
+// requestTxsRoutine sends the requests for missing proposal txs produced by
+// the consensus state. Each request goes to the peer which sent us the
+// proposal, as well as every other peer known to have the proposal.
+func (r *Reactor) requestTxsRoutine(ctx context.Context, txCh *p2p.Channel) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case mi := <-r.state.txRequestQueue:
+			msg := mi.Msg.(*TxRequestMessage)
+			txKeys := make([]*tmproto.TxKey, len(msg.TxKeys))
+			for i := range msg.TxKeys {
+				txKeys[i] = msg.TxKeys[i].ToProto()
+			}
+
+			for _, peerID := range r.peersWithProposal(msg.Height, msg.Round, mi.PeerID) {
+				if err := txCh.Send(ctx, p2p.Envelope{
+					To: peerID,
+					Message: &tmcons.TxRequest{
+						Height: msg.Height,
+						Round:  msg.Round,
+						TxKeys: txKeys,
+					},
+				}); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// peersWithProposal returns the given peer (if known) followed by all other
+// peers that have the proposal for the given height and round.
+func (r *Reactor) peersWithProposal(height int64, round int32, first types.NodeID) []types.NodeID {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	peers := make([]types.NodeID, 0, len(r.peers))
+	if _, ok := r.peers[first]; ok {
+		peers = append(peers, first)
+	}
+	for peerID, ps := range r.peers {
+		if peerID == first {
+			continue
+		}
+		prs := ps.GetRoundState()
+		if prs.Height == height && prs.Round == round && prs.Proposal {
+			peers = append(peers, peerID)
+		}
+	}
+	return peers
+}
+
 // handleMessage handles an Envelope sent from a peer on a specific p2p Channel.
 // It will handle errors and any possible panics gracefully. A caller can handle
 // any error returned by sending a PeerError on the respective channel.
@@ -1299,6 +1478,8 @@ func (r *Reactor) handleMessage(ctx context.Context, envelope *p2p.Envelope, cha
 		err = r.handleVoteMessage(ctx, envelope, msgI)
 	case VoteSetBitsChannel:
 		err = r.handleVoteSetBitsMessage(ctx, envelope, msgI)
+	case TxChannel:
+		err = r.handleTxMessage(ctx, envelope, msgI, chans.tx)
 	default:
 		err = fmt.Errorf("unknown channel ID (%d) for envelope (%v)", envelope.ChannelID, envelope)
 	}
@@ -1395,6 +1576,30 @@ func (r *Reactor) processVoteSetBitsCh(ctx context.Context, chans channelBundle)
 	}
 }
 
+// processTxCh initiates a blocking process where we listen for and handle
+// envelopes on the TxChannel. Any error encountered during message execution
+// will result in a PeerError being sent on the TxChannel. When the reactor is
+// stopped, we will catch the signal and close the p2p Channel gracefully.
+func (r *Reactor) processTxCh(ctx context.Context, chans channelBundle) {
+	iter := chans.tx.Receive(ctx)
+	for iter.Next(ctx) {
+		envelope := iter.Envelope()
+		if err := r.handleMessage(ctx, envelope, chans); err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return
+			}
+
+			r.logger.Error("failed to process message", "ch_id", envelope.ChannelID, "envelope", envelope, "err", err)
+			if serr := chans.tx.SendError(ctx, p2p.PeerError{
+				NodeID: envelope.From,
+				Err:    err,
+			}); serr != nil {
+				return
+			}
+		}
+	}
+}
+
 // processPeerUpdates initiates a blocking process where we listen for and handle
 // PeerUpdate messages. When the reactor is stopped, we will catch the signal and
 // close the p2p PeerUpdatesCh gracefully.
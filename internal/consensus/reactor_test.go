@@ -51,6 +51,7 @@ type reactorTestSuite struct {
 	dataChannels        map[types.NodeID]*p2p.Channel
 	voteChannels        map[types.NodeID]*p2p.Channel
 	voteSetBitsChannels map[types.NodeID]*p2p.Channel
+	txChannels          map[types.NodeID]*p2p.Channel
 }
 
 func chDesc(chID p2p.ChannelID, size int) *p2p.ChannelDescriptor {
@@ -82,6 +83,7 @@ func setup(
 	rts.dataChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc(DataChannel, size))
 	rts.voteChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc(VoteChannel, size))
 	rts.voteSetBitsChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc(VoteSetBitsChannel, size))
+	rts.txChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc(TxChannel, size))
 
 	ctx, cancel := context.WithCancel(ctx)
 	t.Cleanup(cancel)
@@ -103,6 +105,7 @@ func setup(
 		reactor.SetDataChannel(rts.dataChannels[nodeID])
 		reactor.SetVoteChannel(rts.voteChannels[nodeID])
 		reactor.SetVoteSetChannel(rts.voteSetBitsChannels[nodeID])
+		reactor.SetTxChannel(rts.txChannels[nodeID])
 
 		blocksSub, err := state.eventBus.SubscribeWithArgs(ctx, tmpubsub.SubscribeArgs{
 			ClientID: testSubscriber,
@@ -24,6 +24,14 @@ func (m emptyMempool) GetTxsForKeys(txKeys []types.TxKey) types.Txs {
 	return types.Txs{}
 }
 
+func (m emptyMempool) GetTxEntries(filter mempool.TxFilter) []mempool.TxEntry {
+	return nil
+}
+
+func (m emptyMempool) GetTxEntry(txKey types.TxKey) (mempool.TxEntry, bool) {
+	return mempool.TxEntry{}, false
+}
+
 var _ mempool.Mempool = emptyMempool{}
 
 func (emptyMempool) TxStore() *mempool.TxStore { return nil }
@@ -159,6 +159,14 @@ type State struct {
 	// so statistics can be computed by reactor
 	statsMsgQueue chan msgInfo
 
+	// requests for txs missing from a key-only proposal are written on this
+	// channel so they can be sent to peers by the reactor
+	txRequestQueue chan msgInfo
+
+	// txs of the current height's key-only proposals that were fetched from
+	// peers because they were missing from the mempool
+	fetchedTxs map[types.TxKey]types.Tx
+
 	// we use eventBus to trigger msg broadcasts in the reactor,
 	// and to notify external subscribers, eg. through a websocket
 	eventBus *eventbus.EventBus
@@ -228,6 +236,8 @@ func NewState(
 		internalMsgQueue: make(chan msgInfo, msgQueueSize),
 		timeoutTicker:    NewTimeoutTicker(logger),
 		statsMsgQueue:    make(chan msgInfo, msgQueueSize),
+		txRequestQueue:   make(chan msgInfo, msgQueueSize),
+		fetchedTxs:       make(map[types.TxKey]types.Tx),
 		doWALCatchup:     true,
 		wal:              nilWAL{},
 		evpool:           evpool,
@@ -357,6 +367,8 @@ func (cs *State) SetPrivValidator(ctx context.Context, priv types.PrivValidator)
 			cs.privValidatorType = types.SignerSocketClient
 		case *tmgrpc.SignerClient:
 			cs.privValidatorType = types.SignerGRPCClient
+		case *privval.ThresholdSigner:
+			cs.privValidatorType = types.ThresholdSignerClient
 		case types.MockPV:
 			cs.privValidatorType = types.MockSignerClient
 		case *types.ErroringMockPV:
@@ -573,14 +585,14 @@ func (cs *State) AddVote(ctx context.Context, vote *types.Vote, peerID types.Nod
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case cs.internalMsgQueue <- msgInfo{&VoteMessage{vote}, "", tmtime.Now()}:
+		case cs.internalMsgQueue <- msgInfo{&VoteMessage{Vote: vote}, "", tmtime.Now()}:
 			return nil
 		}
 	} else {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case cs.peerMsgQueue <- msgInfo{&VoteMessage{vote}, peerID, tmtime.Now()}:
+		case cs.peerMsgQueue <- msgInfo{&VoteMessage{Vote: vote}, peerID, tmtime.Now()}:
 			return nil
 		}
 	}
@@ -864,6 +876,7 @@ func (cs *State) updateToState(state sm.State) {
 	cs.roundState.SetCommitRound(-1)
 	cs.roundState.SetLastValidators(state.LastValidators)
 	cs.roundState.SetTriggeredTimeoutPrecommit(false)
+	cs.fetchedTxs = make(map[types.TxKey]types.Tx)
 
 	cs.state = state
 
@@ -1049,16 +1062,34 @@ func (cs *State) handleMsg(ctx context.Context, mi msgInfo, fsyncUponCompletion
 		// once proposal is set, we can receive block parts
 		err = cs.setProposal(msg.Proposal, mi.ReceiveTime)
 		// See if we can try creating the proposal block if keys exist
-		if err != nil && cs.config.GossipTransactionKeyOnly && cs.privValidatorPubKey != nil {
+		if err == nil && cs.config.GossipTransactionKeyOnly && cs.privValidatorPubKey != nil {
 			isProposer := cs.isProposer(cs.privValidatorPubKey.Address())
-			if !isProposer && cs.roundState.ProposalBlock() == nil {
+			if !isProposer && cs.roundState.ProposalBlock() == nil && cs.roundState.Proposal() == msg.Proposal {
 				created := cs.tryCreateProposalBlock(spanCtx, msg.Proposal.Height, msg.Proposal.Round, msg.Proposal.Header, msg.Proposal.LastCommit, msg.Proposal.Evidence, msg.Proposal.ProposerAddress)
 				if created {
 					cs.fsyncAndCompleteProposal(ctx, fsyncUponCompletion, msg.Proposal.Height, span)
+				} else {
+					cs.requestMissingTxs(ctx, msg.Proposal.Height, msg.Proposal.Round, peerID)
 				}
 			}
 		}
 
+	case *TxResponseMessage:
+		spanCtx, span := cs.tracer.Start(cs.getTracingCtx(ctx), "cs.state.handleTxResponseMsg")
+		span.SetAttributes(attribute.Int("round", int(msg.Round)))
+		defer span.End()
+
+		// if all txs of the proposal are now available, build the block
+		// without waiting for the block parts
+		added, err = cs.addFetchedTxs(msg, peerID)
+		if added && cs.roundState.ProposalBlock() == nil {
+			proposal := cs.roundState.Proposal()
+			created := cs.tryCreateProposalBlock(spanCtx, proposal.Height, proposal.Round, proposal.Header, proposal.LastCommit, proposal.Evidence, proposal.ProposerAddress)
+			if created {
+				cs.fsyncAndCompleteProposal(ctx, fsyncUponCompletion, proposal.Height, span)
+			}
+		}
+
 	case *BlockPartMessage:
 		// If we have already created block parts, we can exit early if block part matches
 		if cs.config.GossipTransactionKeyOnly && cs.roundState.Proposal() != nil && cs.roundState.ProposalBlockParts() != nil {
@@ -1117,7 +1148,7 @@ func (cs *State) handleMsg(ctx context.Context, mi msgInfo, fsyncUponCompletion
 
 		// attempt to add the vote and dupeout the validator if its a duplicate signature
 		// if the vote gives us a 2/3-any or 2/3-one, we transition
-		added, err = cs.tryAddVote(ctx, msg.Vote, peerID, span)
+		added, err = cs.tryAddVote(ctx, msg.Vote, msg.sigVerified, peerID, span)
 		if added {
 			select {
 			case cs.statsMsgQueue <- mi:
@@ -2492,15 +2523,19 @@ func (cs *State) tryCreateProposalBlock(ctx context.Context, height int64, round
 
 // Build a proposal block from mempool txs. If cs.config.GossipTransactionKeyOnly=true
 // proposals only contain txKeys so we rebuild the block using mempool txs
+// and txs fetched from peers
 func (cs *State) buildProposalBlock(height int64, header types.Header, lastCommit *types.Commit, evidence []types.Evidence, proposerAddress types.Address, txKeys []types.TxKey) *types.Block {
-	missingTxs := cs.blockExec.GetMissingTxs(txKeys)
+	missingTxs := cs.getMissingProposalTxs(txKeys)
 	if len(missingTxs) > 0 {
 		cs.metrics.ProposalMissingTxs.Set(float64(len(missingTxs)))
-		cs.logger.Debug("Missing txs when trying to build block", "missing_txs", cs.blockExec.GetMissingTxs(txKeys))
+		cs.logger.Debug("Missing txs when trying to build block", "missing_txs", missingTxs)
+		return nil
+	}
+	txs := cs.getProposalTxs(txKeys)
+	if txs == nil {
 		return nil
 	}
-	txs := cs.blockExec.GetTxsForKeys(txKeys)
-	block := cs.state.MakeBlock(height, cs.blockExec.GetTxsForKeys(txKeys), lastCommit, evidence, proposerAddress)
+	block := cs.state.MakeBlock(height, txs, lastCommit, evidence, proposerAddress)
 	block.Version = header.Version
 	block.Data.Txs = txs
 	block.DataHash = block.Data.Hash(true)
@@ -2509,6 +2544,119 @@ func (cs *State) buildProposalBlock(height int64, header types.Header, lastCommi
 	return block
 }
 
+// getMissingProposalTxs returns the keys of the given proposal txs that are
+// neither in the mempool nor fetched from peers.
+func (cs *State) getMissingProposalTxs(txKeys []types.TxKey) []types.TxKey {
+	var missingTxKeys []types.TxKey
+	for _, txKey := range cs.blockExec.GetMissingTxs(txKeys) {
+		if _, ok := cs.fetchedTxs[txKey]; !ok {
+			missingTxKeys = append(missingTxKeys, txKey)
+		}
+	}
+	return missingTxKeys
+}
+
+// getProposalTxs returns the txs for the given keys in order, preferring txs
+// fetched from peers over the mempool. It returns nil if any tx is no longer
+// available, e.g. because it was evicted from the mempool in the meantime.
+func (cs *State) getProposalTxs(txKeys []types.TxKey) types.Txs {
+	var mempoolTxKeys []types.TxKey
+	for _, txKey := range txKeys {
+		if _, ok := cs.fetchedTxs[txKey]; !ok {
+			mempoolTxKeys = append(mempoolTxKeys, txKey)
+		}
+	}
+	mempoolTxs := cs.blockExec.GetTxsForKeys(mempoolTxKeys)
+	if len(mempoolTxs) != len(mempoolTxKeys) {
+		return nil
+	}
+
+	txs := make(types.Txs, 0, len(txKeys))
+	for _, txKey := range txKeys {
+		if tx, ok := cs.fetchedTxs[txKey]; ok {
+			txs = append(txs, tx)
+			continue
+		}
+		txs = append(txs, mempoolTxs[0])
+		mempoolTxs = mempoolTxs[1:]
+	}
+	return txs
+}
+
+// requestMissingTxs asks peers for the txs of the current key-only proposal
+// which are missing locally. The peer which sent us the proposal is asked
+// first by the reactor; other peers that have the proposal are asked as well.
+func (cs *State) requestMissingTxs(ctx context.Context, height int64, round int32, peerID types.NodeID) {
+	if cs.replayMode || cs.roundState.Proposal() == nil || cs.roundState.Height() != height {
+		return
+	}
+	missingTxs := cs.getMissingProposalTxs(cs.roundState.Proposal().TxKeys)
+	for len(missingTxs) > 0 {
+		n := len(missingTxs)
+		if n > maxTxKeysPerRequest {
+			n = maxTxKeysPerRequest
+		}
+		msg := &TxRequestMessage{Height: height, Round: round, TxKeys: missingTxs[:n]}
+		missingTxs = missingTxs[n:]
+
+		select {
+		case cs.txRequestQueue <- msgInfo{Msg: msg, PeerID: peerID, ReceiveTime: tmtime.Now()}:
+			cs.metrics.ProposalTxsRequested.Add(float64(n))
+		case <-ctx.Done():
+			return
+		default:
+			cs.logger.Debug("tx request queue is full; dropping request", "height", height, "round", round, "num_txs", n)
+		}
+	}
+}
+
+// addFetchedTxs stores the txs of a TxResponseMessage which belong to the
+// current proposal. It returns true if at least one previously missing tx was
+// added, and an error if the peer sent txs that were not part of the proposal.
+func (cs *State) addFetchedTxs(msg *TxResponseMessage, peerID types.NodeID) (bool, error) {
+	// Txs are keyed by content, so a round mismatch is OK as long as the txs
+	// belong to the proposal we are currently trying to build.
+	if cs.roundState.Height() != msg.Height || cs.roundState.Proposal() == nil {
+		cs.metrics.ProposalTxsReceived.With("matches_current", "false").Add(float64(len(msg.Txs)))
+		return false, nil
+	}
+
+	txKeys := make(map[types.TxKey]struct{}, len(cs.roundState.Proposal().TxKeys))
+	for _, txKey := range cs.roundState.Proposal().TxKeys {
+		txKeys[txKey] = struct{}{}
+	}
+
+	var (
+		added      bool
+		unexpected int
+	)
+	for _, tx := range msg.Txs {
+		txKey := tx.Key()
+		if _, ok := txKeys[txKey]; !ok {
+			unexpected++
+			continue
+		}
+		if _, ok := cs.fetchedTxs[txKey]; ok {
+			continue
+		}
+		cs.fetchedTxs[txKey] = tx
+		added = true
+	}
+	cs.metrics.ProposalTxsReceived.With("matches_current", "true").Add(float64(len(msg.Txs) - unexpected))
+	if unexpected == 0 {
+		return added, nil
+	}
+
+	cs.metrics.ProposalTxsReceived.With("matches_current", "false").Add(float64(unexpected))
+	// A response for an earlier round may legitimately refer to a different
+	// proposal, but a response for the current round must only contain txs
+	// of the current proposal.
+	if msg.Round == cs.roundState.Round() {
+		return added, fmt.Errorf("peer %s sent %d txs which are not part of the proposal", peerID, unexpected)
+	}
+	return added, nil
+}
+
 func (cs *State) handleCompleteProposal(ctx context.Context, height int64, handleBlockPartSpan otrace.Span) {
 	// Update Valid* if we can.
 	prevotes := cs.roundState.Votes().Prevotes(cs.roundState.Round())
@@ -2548,8 +2696,14 @@ func (cs *State) handleCompleteProposal(ctx context.Context, height int64, handl
 }
 
 // Attempt to add the vote. if its a duplicate signature, dupeout the validator
-func (cs *State) tryAddVote(ctx context.Context, vote *types.Vote, peerID types.NodeID, handleVoteMsgSpan otrace.Span) (bool, error) {
-	added, err := cs.addVote(ctx, vote, peerID, handleVoteMsgSpan)
+func (cs *State) tryAddVote(
+	ctx context.Context,
+	vote *types.Vote,
+	sigVerified bool,
+	peerID types.NodeID,
+	handleVoteMsgSpan otrace.Span,
+) (bool, error) {
+	added, err := cs.addVote(ctx, vote, sigVerified, peerID, handleVoteMsgSpan)
 	if err != nil {
 		// If the vote height is off, we'll just ignore it,
 		// But if it's a conflicting sig, add it to the cs.evpool.
@@ -2599,6 +2753,7 @@ func (cs *State) tryAddVote(ctx context.Context, vote *types.Vote, peerID types.
 func (cs *State) addVote(
 	ctx context.Context,
 	vote *types.Vote,
+	sigVerified bool,
 	peerID types.NodeID,
 	handleVoteMsgSpan otrace.Span,
 ) (added bool, err error) {
@@ -2622,7 +2777,11 @@ func (cs *State) addVote(
 			return
 		}
 
-		added, err = cs.roundState.LastCommit().AddVote(vote)
+		if sigVerified {
+			added, err = cs.roundState.LastCommit().AddPreVerifiedVote(vote)
+		} else {
+			added, err = cs.roundState.LastCommit().AddVote(vote)
+		}
 		if !added {
 			return
 		}
@@ -2671,9 +2830,11 @@ func (cs *State) addVote(
 			// consensus reactor when the vote was received.
 			// Here, we verify the signature of the vote extension included in the vote
 			// message.
-			_, val := cs.state.Validators.GetByIndex(vote.ValidatorIndex)
-			if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
-				return false, err
+			if !sigVerified {
+				_, val := cs.state.Validators.GetByIndex(vote.ValidatorIndex)
+				if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
+					return false, err
+				}
 			}
 
 			err := cs.blockExec.VerifyVoteExtension(ctx, vote)
@@ -2695,7 +2856,11 @@ func (cs *State) addVote(
 	}
 
 	height := cs.roundState.Height()
-	added, err = cs.roundState.Votes().AddVote(vote, peerID)
+	if sigVerified {
+		added, err = cs.roundState.Votes().AddPreVerifiedVote(vote, peerID)
+	} else {
+		added, err = cs.roundState.Votes().AddVote(vote, peerID)
+	}
 	if !added {
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
 		return
@@ -2901,7 +3066,7 @@ func (cs *State) signAddVote(
 		// The signer will sign the extension, make sure to remove the data on the way out
 		vote.StripExtension()
 	}
-	cs.sendInternalMessage(ctx, msgInfo{&VoteMessage{vote}, "", tmtime.Now()})
+	cs.sendInternalMessage(ctx, msgInfo{&VoteMessage{Vote: vote}, "", tmtime.Now()})
 	cs.logger.Info("signed and pushed vote", "height", cs.roundState.Height(), "round", cs.roundState.Round(), "vote", vote)
 	return vote
 }
@@ -2941,7 +2941,7 @@ func TestStateOutputVoteStats(t *testing.T) {
 
 	vote := signVote(ctx, t, vss[1], tmproto.PrecommitType, config.ChainID(), blockID)
 
-	voteMessage := &VoteMessage{vote}
+	voteMessage := &VoteMessage{Vote: vote}
 	cs.handleMsg(ctx, msgInfo{voteMessage, peerID, tmtime.Now()}, false)
 
 	statsMessage := <-cs.statsMsgQueue
@@ -2949,13 +2949,13 @@ func TestStateOutputVoteStats(t *testing.T) {
 	require.Equal(t, peerID, statsMessage.PeerID, "")
 
 	// sending the same part from different peer
-	cs.handleMsg(ctx, msgInfo{&VoteMessage{vote}, "peer2", tmtime.Now()}, false)
+	cs.handleMsg(ctx, msgInfo{&VoteMessage{Vote: vote}, "peer2", tmtime.Now()}, false)
 
 	// sending the vote for the bigger height
 	incrementHeight(vss[1])
 	vote = signVote(ctx, t, vss[1], tmproto.PrecommitType, config.ChainID(), blockID)
 
-	cs.handleMsg(ctx, msgInfo{&VoteMessage{vote}, peerID, tmtime.Now()}, false)
+	cs.handleMsg(ctx, msgInfo{&VoteMessage{Vote: vote}, peerID, tmtime.Now()}, false)
 
 	select {
 	case <-cs.statsMsgQueue:
@@ -3147,3 +3147,43 @@ func signAddPrecommitWithExtension(ctx context.Context,
 	require.NoError(t, err, "failed to sign vote")
 	addVotes(cs, v)
 }
+
+func TestStateAddFetchedTxs(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs1, _ := makeState(ctx, t, makeStateArgs{config: config, validators: 1})
+	height, round := cs1.roundState.Height(), cs1.roundState.Round()
+
+	txs := types.Txs{types.Tx("tx1"), types.Tx("tx2"), types.Tx("tx3")}
+	cs1.roundState.SetProposal(&types.Proposal{
+		Height: height,
+		Round:  round,
+		TxKeys: []types.TxKey{txs[0].Key(), txs[1].Key(), txs[2].Key()},
+	})
+	require.Len(t, cs1.getMissingProposalTxs(cs1.roundState.Proposal().TxKeys), 3)
+	require.Nil(t, cs1.getProposalTxs(cs1.roundState.Proposal().TxKeys))
+
+	// txs for another height are ignored
+	added, err := cs1.addFetchedTxs(&TxResponseMessage{Height: height + 1, Round: round, Txs: txs}, "peer")
+	require.NoError(t, err)
+	require.False(t, added)
+
+	// txs which are not part of the proposal are rejected
+	added, err = cs1.addFetchedTxs(&TxResponseMessage{Height: height, Round: round, Txs: types.Txs{txs[2], types.Tx("other")}}, "peer")
+	require.Error(t, err)
+	require.True(t, added)
+	require.Len(t, cs1.getMissingProposalTxs(cs1.roundState.Proposal().TxKeys), 2)
+
+	added, err = cs1.addFetchedTxs(&TxResponseMessage{Height: height, Round: round, Txs: types.Txs{txs[1], txs[0]}}, "peer")
+	require.NoError(t, err)
+	require.True(t, added)
+	require.Empty(t, cs1.getMissingProposalTxs(cs1.roundState.Proposal().TxKeys))
+	require.Equal(t, txs, cs1.getProposalTxs(cs1.roundState.Proposal().TxKeys))
+
+	// re-sending known txs adds nothing new
+	added, err = cs1.addFetchedTxs(&TxResponseMessage{Height: height, Round: round, Txs: types.Txs{txs[0]}}, "peer")
+	require.NoError(t, err)
+	require.False(t, added)
+}
@@ -134,6 +134,16 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 // Duplicate votes return added=false, err=nil.
 // By convention, peerID is "" if origin is self.
 func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID types.NodeID) (added bool, err error) {
+	return hvs.addVote(vote, peerID, false)
+}
+
+// AddPreVerifiedVote is like AddVote, for a vote whose signatures were
+// already verified. See VoteSet.AddPreVerifiedVote.
+func (hvs *HeightVoteSet) AddPreVerifiedVote(vote *types.Vote, peerID types.NodeID) (added bool, err error) {
+	return hvs.addVote(vote, peerID, true)
+}
+
+func (hvs *HeightVoteSet) addVote(vote *types.Vote, peerID types.NodeID, preVerified bool) (added bool, err error) {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
 	if !types.IsVoteTypeValid(vote.Type) {
@@ -151,7 +161,11 @@ func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID types.NodeID) (added
 			return
 		}
 	}
-	added, err = voteSet.AddVote(vote)
+	if preVerified {
+		added, err = voteSet.AddPreVerifiedVote(vote)
+	} else {
+		added, err = voteSet.AddVote(vote)
+	}
 	return
 }
 
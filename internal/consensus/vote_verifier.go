@@ -0,0 +1,220 @@
+package consensus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ari-anchor/sei-tendermint/crypto"
+	"github.com/ari-anchor/sei-tendermint/crypto/batch"
+	"github.com/ari-anchor/sei-tendermint/internal/p2p"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+const (
+	// maxVoteBatchSize is the maximum number of votes whose signatures are
+	// verified in a single batch.
+	maxVoteBatchSize = 1000
+
+	// voteBatchVerifyThreshold is the minimum number of votes of a height and
+	// round for their signatures to be verified in a batch.
+	voteBatchVerifyThreshold = 2
+)
+
+// voteVerifier verifies the signatures of the votes received from peers off
+// the goroutine of the consensus state. The votes queued while a batch is
+// being verified make up the next batch, in which the votes of each height
+// and round are verified together. If a batch fails, its votes are verified
+// one by one to find the invalid ones, which are dropped and reported as
+// errors of the peers that sent them. The other votes are passed on to the
+// consensus state in the order they were received, marked as verified.
+type voteVerifier struct {
+	logger log.Logger
+	state  *State
+	queue  chan msgInfo
+}
+
+func newVoteVerifier(logger log.Logger, state *State) *voteVerifier {
+	return &voteVerifier{
+		logger: logger,
+		state:  state,
+		queue:  make(chan msgInfo, maxVoteBatchSize),
+	}
+}
+
+// enqueue queues a vote message received from a peer for verification.
+func (vv *voteVerifier) enqueue(ctx context.Context, mi msgInfo) error {
+	select {
+	case vv.queue <- mi:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run verifies the queued votes in batches until the context is canceled,
+// reporting the peers that sent invalid votes on voteCh.
+func (vv *voteVerifier) run(ctx context.Context, voteCh *p2p.Channel) {
+	msgs := make([]msgInfo, 0, maxVoteBatchSize)
+	for {
+		msgs = msgs[:0]
+		select {
+		case mi := <-vv.queue:
+			msgs = append(msgs, mi)
+		case <-ctx.Done():
+			return
+		}
+	drain:
+		for len(msgs) < maxVoteBatchSize {
+			select {
+			case mi := <-vv.queue:
+				msgs = append(msgs, mi)
+			default:
+				break drain
+			}
+		}
+
+		errs := vv.verify(msgs)
+		for i, mi := range msgs {
+			if errs[i] != nil {
+				vv.logger.Debug("dropping vote with invalid signature", "peer", mi.PeerID, "err", errs[i])
+				if err := voteCh.SendError(ctx, p2p.PeerError{
+					NodeID: mi.PeerID,
+					Err:    errs[i],
+				}); err != nil {
+					return
+				}
+				continue
+			}
+
+			select {
+			case vv.state.peerMsgQueue <- mi:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// pendingVote is a vote of a batch, along with the public key of its
+// validator.
+type pendingVote struct {
+	idx    int
+	vote   *types.Vote
+	pubKey crypto.PubKey
+	// extension is whether the extension signature must be verified.
+	extension bool
+}
+
+// verify verifies the signatures of the votes of the messages, and returns
+// the error of each invalid vote. The messages of the valid votes are marked
+// as verified. The votes that cannot be verified, since they are not of the
+// current or last height, or not signed by a validator, are left for the
+// consensus state to handle.
+func (vv *voteVerifier) verify(msgs []msgInfo) []error {
+	vv.state.mtx.RLock()
+	chainID := vv.state.state.ChainID
+	params := vv.state.state.ConsensusParams
+	height := vv.state.roundState.Height()
+	vals := vv.state.roundState.Validators()
+	lastVals := vv.state.roundState.LastValidators()
+	vv.state.mtx.RUnlock()
+
+	type heightRound struct {
+		height int64
+		round  int32
+	}
+	var (
+		errs   = make([]error, len(msgs))
+		keys   []heightRound
+		groups = make(map[heightRound][]pendingVote)
+	)
+	for i, mi := range msgs {
+		vote := mi.Msg.(*VoteMessage).Vote
+
+		var valSet *types.ValidatorSet
+		switch vote.Height {
+		case height:
+			valSet = vals
+		case height - 1:
+			valSet = lastVals
+		}
+		if valSet == nil {
+			continue
+		}
+		addr, val := valSet.GetByIndex(vote.ValidatorIndex)
+		if val == nil || !bytes.Equal(addr, vote.ValidatorAddress) {
+			continue
+		}
+
+		key := heightRound{vote.Height, vote.Round}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], pendingVote{
+			idx:    i,
+			vote:   vote,
+			pubKey: val.PubKey,
+			extension: params.ABCI.VoteExtensionsEnabled(vote.Height) &&
+				vote.Type == tmproto.PrecommitType && !vote.BlockID.IsNil(),
+		})
+	}
+
+	for _, key := range keys {
+		group := groups[key]
+		if !verifyVoteBatch(chainID, group) {
+			for _, pv := range group {
+				errs[pv.idx] = verifyVote(chainID, pv)
+			}
+		}
+		for _, pv := range group {
+			if errs[pv.idx] == nil {
+				msgs[pv.idx].Msg = &VoteMessage{Vote: pv.vote, sigVerified: true}
+			}
+		}
+	}
+	return errs
+}
+
+// verifyVoteBatch verifies the signatures of the votes in a batch, and
+// reports whether they are all valid. It returns false if they could not be
+// batched.
+func verifyVoteBatch(chainID string, votes []pendingVote) bool {
+	if len(votes) < voteBatchVerifyThreshold {
+		return false
+	}
+	bv, ok := batch.CreateBatchVerifier(votes[0].pubKey)
+	if !ok {
+		return false
+	}
+
+	for _, pv := range votes {
+		v := pv.vote.ToProto()
+		if err := bv.Add(pv.pubKey, types.VoteSignBytes(chainID, v), pv.vote.Signature); err != nil {
+			return false
+		}
+		if pv.extension {
+			if err := bv.Add(pv.pubKey, types.VoteExtensionSignBytes(chainID, v), pv.vote.ExtensionSignature); err != nil {
+				return false
+			}
+		}
+	}
+	ok, _ = bv.Verify()
+	return ok
+}
+
+// verifyVote verifies the signatures of a single vote.
+func verifyVote(chainID string, pv pendingVote) error {
+	if pv.extension {
+		if err := pv.vote.VerifyVoteAndExtension(chainID, pv.pubKey); err != nil {
+			return fmt.Errorf("invalid signature of vote %v: %w", pv.vote, err)
+		}
+		return nil
+	}
+	if err := pv.vote.Verify(chainID, pv.pubKey); err != nil {
+		return fmt.Errorf("invalid signature of vote %v: %w", pv.vote, err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ari-anchor/sei-tendermint/crypto"
+	"github.com/ari-anchor/sei-tendermint/internal/test/factory"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	tmrand "github.com/ari-anchor/sei-tendermint/libs/rand"
+	tmtime "github.com/ari-anchor/sei-tendermint/libs/time"
+	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+func TestVoteVerifierVerify(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs, vss := makeState(ctx, t, makeStateArgs{config: config, validators: 4})
+	vv := newVoteVerifier(log.NewNopLogger(), cs)
+	chainID := config.ChainID()
+
+	randBytes := tmrand.Bytes(crypto.HashSize)
+	blockID := types.BlockID{
+		Hash:          randBytes,
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: randBytes},
+	}
+	prevotes := signVotes(ctx, t, tmproto.PrevoteType, chainID, blockID, vss[1:]...)
+
+	// A vote with an invalid signature.
+	forged := prevotes[1].Copy()
+	forged.Signature = tmrand.Bytes(len(forged.Signature))
+	// A vote of a future height, which cannot be verified yet.
+	incrementHeight(vss[3])
+	future := signVote(ctx, t, vss[3], tmproto.PrevoteType, chainID, blockID)
+
+	votes := []*types.Vote{prevotes[0], forged, prevotes[2], future}
+	msgs := make([]msgInfo, len(votes))
+	for i, vote := range votes {
+		msgs[i] = msgInfo{&VoteMessage{Vote: vote}, "peer", tmtime.Now()}
+	}
+
+	errs := vv.verify(msgs)
+	require.Len(t, errs, len(msgs))
+	for i, verified := range []bool{true, false, true, false} {
+		assert.Equal(t, verified, msgs[i].Msg.(*VoteMessage).sigVerified, "vote %d", i)
+	}
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[1])
+	assert.NoError(t, errs[2])
+	assert.NoError(t, errs[3])
+}
+
+func BenchmarkVoteVerification(b *testing.B) {
+	for _, n := range []int{100, 1000} {
+		votes, chainID := makeBenchmarkVotes(b, n)
+
+		b.Run(fmt.Sprintf("Individual/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, pv := range votes {
+					if err := verifyVote(chainID, pv); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("Batch/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if !verifyVoteBatch(chainID, votes) {
+					b.Fatal("batch verification failed")
+				}
+			}
+		})
+	}
+}
+
+// makeBenchmarkVotes returns the prevotes of n validators for a block.
+func makeBenchmarkVotes(b *testing.B, n int) ([]pendingVote, string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const chainID = "benchmark-chain"
+	randBytes := tmrand.Bytes(crypto.HashSize)
+	blockID := types.BlockID{
+		Hash:          randBytes,
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: randBytes},
+	}
+
+	votes := make([]pendingVote, n)
+	for i := range votes {
+		val, privVal, err := factory.Validator(ctx, testMinPower)
+		require.NoError(b, err)
+		vote := &types.Vote{
+			Type:             tmproto.PrevoteType,
+			Height:           1,
+			BlockID:          blockID,
+			Timestamp:        tmtime.Now(),
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(i),
+		}
+		v := vote.ToProto()
+		require.NoError(b, privVal.SignVote(ctx, chainID, v))
+		vote.Signature = v.Signature
+		votes[i] = pendingVote{idx: i, vote: vote, pubKey: val.PubKey}
+	}
+	return votes, chainID
+}
@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
+	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -285,6 +289,97 @@ func (wal *BaseWAL) SearchForEndHeight(
 	return nil, false, nil
 }
 
+// LoadWALTail reads the messages of the last n heights from the WAL at
+// walFile and the files rotated out of it, without opening the WAL for
+// writing, so it is safe to use on the WAL of a node that is not running. The
+// messages start with the n-th last EndHeightMessage, followed by all the
+// messages after it, from the oldest to the newest; if the WAL records fewer
+// heights, all its messages are returned.
+//
+// If a file of the WAL is corrupted, LoadWALTail returns the messages of the
+// newer files and those of the corrupted file before the corruption, together
+// with a DataCorruptionError.
+func LoadWALTail(walFile string, n int) ([]*TimedWALMessage, error) {
+	paths, err := walFilePaths(walFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		msgs       []*TimedWALMessage
+		numHeights int
+	)
+	for i := len(paths) - 1; i >= 0; i-- {
+		fileMsgs, readErr := readWALFile(paths[i])
+		if readErr != nil && !IsDataCorruptionError(readErr) {
+			return nil, readErr
+		}
+		for j := len(fileMsgs) - 1; j >= 0; j-- {
+			if _, ok := fileMsgs[j].Msg.(EndHeightMessage); ok {
+				numHeights++
+				if numHeights == n {
+					return append(fileMsgs[j:], msgs...), readErr
+				}
+			}
+		}
+		msgs = append(fileMsgs, msgs...)
+		if readErr != nil {
+			return msgs, readErr
+		}
+	}
+	return msgs, nil
+}
+
+// walFilePaths returns the paths of the files of the WAL at walFile, from the
+// oldest to the newest. The rotated files have a numeric suffix that grows
+// with each rotation, and walFile itself is the newest.
+func walFilePaths(walFile string) ([]string, error) {
+	matches, err := filepath.Glob(walFile + ".*")
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]int)
+	var paths []string
+	for _, path := range matches {
+		index, err := strconv.Atoi(strings.TrimPrefix(path, walFile+"."))
+		if err != nil {
+			continue // not a rotated file, e.g. a backup of a corrupted WAL
+		}
+		indexes[path] = index
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool { return indexes[paths[i]] < indexes[paths[j]] })
+
+	if _, err := os.Stat(walFile); err == nil {
+		paths = append(paths, walFile)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// readWALFile decodes the messages of the WAL file at path, up to its end or
+// to a corruption of its contents.
+func readWALFile(path string) ([]*TimedWALMessage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var msgs []*TimedWALMessage
+	dec := NewWALDecoder(f)
+	for {
+		msg, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return msgs, nil
+		} else if err != nil {
+			return msgs, err
+		}
+		msgs = append(msgs, msg)
+	}
+}
+
 // A WALEncoder writes custom-encoded WAL messages to an output stream.
 //
 // Format: 4 bytes CRC sum + 4 bytes length + arbitrary-length value
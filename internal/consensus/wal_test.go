@@ -169,6 +169,72 @@ func TestWALSearchForEndHeight(t *testing.T) {
 	t.Cleanup(leaktest.Check(t))
 }
 
+func TestLoadWALTail(t *testing.T) {
+	walFile := filepath.Join(t.TempDir(), "wal")
+	now := tmtime.Now()
+	roundState := func(h int64) TimedWALMessage {
+		return TimedWALMessage{Time: now, Msg: tmtypes.EventDataRoundState{Height: h, Round: 0, Step: "RoundStepNewHeight"}}
+	}
+	endHeight := func(h int64) TimedWALMessage {
+		return TimedWALMessage{Time: now, Msg: EndHeightMessage{h}}
+	}
+	writeFile := func(path string, msgs ...TimedWALMessage) {
+		t.Helper()
+		b := new(bytes.Buffer)
+		enc := NewWALEncoder(b)
+		for _, msg := range msgs {
+			msg := msg
+			require.NoError(t, enc.Encode(&msg))
+		}
+		require.NoError(t, os.WriteFile(path, b.Bytes(), 0600))
+	}
+
+	// Heights 1 to 3 are complete, and height 4 is in progress, across two
+	// rotated files and the head.
+	writeFile(walFile+".000", endHeight(0), roundState(1), endHeight(1), roundState(2))
+	writeFile(walFile+".001", endHeight(2), roundState(3), endHeight(3))
+	writeFile(walFile, roundState(4))
+	writeFile(walFile+".CORRUPTED", roundState(5))
+
+	heightsOf := func(msgs []*TimedWALMessage) []int64 {
+		var hs []int64
+		for _, msg := range msgs {
+			switch m := msg.Msg.(type) {
+			case EndHeightMessage:
+				hs = append(hs, m.Height)
+			case tmtypes.EventDataRoundState:
+				hs = append(hs, m.Height)
+			}
+		}
+		return hs
+	}
+	for n, want := range map[int][]int64{
+		1:  {3, 4},
+		2:  {2, 3, 3, 4},
+		3:  {1, 2, 2, 3, 3, 4},
+		10: {0, 1, 1, 2, 2, 3, 3, 4},
+	} {
+		msgs, err := LoadWALTail(walFile, n)
+		require.NoError(t, err)
+		assert.Equal(t, want, heightsOf(msgs), "heights for n = %d", n)
+	}
+
+	// Corrupted data stops reading at the corrupted file.
+	f, err := os.OpenFile(walFile+".001", os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("corrupted"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	msgs, err := LoadWALTail(walFile, 3)
+	assert.True(t, IsDataCorruptionError(err), "unexpected error: %v", err)
+	assert.Equal(t, []int64{2, 3, 3, 4}, heightsOf(msgs))
+
+	msgs, err = LoadWALTail(filepath.Join(t.TempDir(), "wal"), 1)
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+}
+
 func TestWALPeriodicSync(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
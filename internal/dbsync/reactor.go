@@ -1,18 +1,24 @@
 package dbsync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
 	"sync"
 	"time"
 
+	abciclient "github.com/ari-anchor/sei-tendermint/abci/client"
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
 	"github.com/ari-anchor/sei-tendermint/config"
 	"github.com/ari-anchor/sei-tendermint/internal/eventbus"
 	"github.com/ari-anchor/sei-tendermint/internal/p2p"
+	"github.com/ari-anchor/sei-tendermint/internal/proxy"
+	tmpubsub "github.com/ari-anchor/sei-tendermint/internal/pubsub"
 	sm "github.com/ari-anchor/sei-tendermint/internal/state"
 	"github.com/ari-anchor/sei-tendermint/internal/store"
 	"github.com/ari-anchor/sei-tendermint/libs/log"
@@ -39,10 +45,18 @@ const (
 
 	fileMsgSize = int(16e6) // ~16MB
 
+	// maxChunkSize is the largest file chunk that fits in a file message.
+	maxChunkSize = uint64(fileMsgSize - 1024)
+
 	lightBlockMsgSize = int(1e7) // ~1MB
 
 	paramMsgSize = int(1e5) // ~100kb
 
+	// snapshotServeGracePeriod is how long after serving a chunk of a
+	// snapshot the snapshot is considered in use by syncing peers, and is
+	// not pruned.
+	snapshotServeGracePeriod = 10 * time.Minute
+
 	MetadataHeightFilename   = "LATEST_HEIGHT"
 	HeightSubdirectoryPrefix = "snapshot_"
 	MetadataFilename         = "METADATA"
@@ -104,6 +118,7 @@ type Reactor struct {
 	// peers used by the p2p state provider and in reverse sync.
 	dispatcher    *light.Dispatcher
 	peers         *light.PeerList
+	conn          abciclient.Client
 	stateStore    sm.Store
 	blockStore    *store.BlockStore
 	initialHeight int64
@@ -127,12 +142,19 @@ type Reactor struct {
 	mtx sync.RWMutex
 
 	postSyncHook func(context.Context, sm.State) error
+	snapshotHook SnapshotHook
+	baseConfig   config.BaseConfig
+
+	// servedAt is when a chunk of each snapshot was last served to a peer.
+	servedMtx sync.Mutex
+	servedAt  map[uint64]time.Time
 }
 
 func NewReactor(
 	logger log.Logger,
 	config config.DBSyncConfig,
 	baseConfig config.BaseConfig,
+	conn abciclient.Client,
 	peerEvents p2p.PeerEventSubscriber,
 	stateStore sm.Store,
 	blockStore *store.BlockStore,
@@ -141,11 +163,13 @@ func NewReactor(
 	eventBus *eventbus.EventBus,
 	shouldSync bool,
 	postSyncHook func(context.Context, sm.State) error,
+	snapshotHook SnapshotHook,
 ) *Reactor {
 	reactor := &Reactor{
 		logger:        logger,
 		peerEvents:    peerEvents,
 		peers:         light.NewPeerList(),
+		conn:          conn,
 		stateStore:    stateStore,
 		blockStore:    blockStore,
 		initialHeight: initialHeight,
@@ -154,9 +178,12 @@ func NewReactor(
 		eventBus:      eventBus,
 		config:        config,
 		postSyncHook:  postSyncHook,
+		snapshotHook:  snapshotHook,
+		baseConfig:    baseConfig,
 		shouldSync:    shouldSync,
+		servedAt:      map[uint64]time.Time{},
 	}
-	syncer := NewSyncer(logger, config, baseConfig, shouldSync, reactor.requestMetadata, reactor.requestFile, reactor.commitState, reactor.postSync, defaultResetDirFn)
+	syncer := NewSyncer(logger, config, baseConfig, shouldSync, reactor.requestMetadata, reactor.requestFile, reactor.commitState, reactor.verifyApp, reactor.postSync, reactor.reportBadPeer)
 	reactor.syncer = syncer
 
 	reactor.BaseService = *service.NewBaseService(logger, "DBSync", reactor)
@@ -190,6 +217,9 @@ func (r *Reactor) OnStart(ctx context.Context) error {
 	go r.processFileCh(ctx, r.fileChannel)
 	go r.processLightBlockCh(ctx, r.lightBlockChannel)
 	go r.processParamsCh(ctx, r.paramsChannel)
+	if err := r.startSnapshotRoutine(ctx); err != nil {
+		return err
+	}
 	if r.shouldSync {
 		to := light.TrustOptions{
 			Period: r.config.TrustPeriod,
@@ -226,7 +256,7 @@ func (r *Reactor) OnStart(ctx context.Context) error {
 func (r *Reactor) OnStop() {
 	// tell the dispatcher to stop sending any more requests
 	r.dispatcher.Close()
-	// clear up half-populated directories
+	// stop syncing; partially synced files are kept so that the sync can resume
 	r.syncer.Stop()
 }
 
@@ -327,9 +357,19 @@ func (r *Reactor) handleFileRequest(ctx context.Context, req *dstypes.FileReques
 		return
 	}
 
+	if req.Filename != filepath.Base(req.Filename) {
+		err = fmt.Errorf("invalid filename %q", req.Filename)
+		return
+	}
+	if req.Length > maxChunkSize {
+		err = fmt.Errorf("requested chunk of %d bytes exceeds maximum %d", req.Length, maxChunkSize)
+		return
+	}
+
+	r.markServed(req.Height)
 	heightSubdirectory := filepath.Join(r.config.SnapshotDirectory, fmt.Sprintf("%s%d", HeightSubdirectoryPrefix, req.Height))
 	filename := filepath.Join(heightSubdirectory, req.Filename)
-	data, err := os.ReadFile(filename)
+	data, err := readChunk(filename, req.Offset, req.Length)
 	if err != nil {
 		err = fmt.Errorf("cannot read file %s due to %s", filename, err)
 		return
@@ -340,12 +380,52 @@ func (r *Reactor) handleFileRequest(ctx context.Context, req *dstypes.FileReques
 			Height:   req.Height,
 			Filename: req.Filename,
 			Data:     data,
+			Offset:   req.Offset,
 		},
 	})
 	responded = true
 	return
 }
 
+// markServed records that a chunk of the snapshot for height is being served.
+func (r *Reactor) markServed(height uint64) {
+	r.servedMtx.Lock()
+	defer r.servedMtx.Unlock()
+	r.servedAt[height] = time.Now()
+}
+
+// snapshotInUse reports whether peers may still be syncing from the snapshot
+// for height.
+func (r *Reactor) snapshotInUse(height uint64) bool {
+	r.servedMtx.Lock()
+	defer r.servedMtx.Unlock()
+	servedAt, ok := r.servedAt[height]
+	if ok && time.Since(servedAt) > snapshotServeGracePeriod {
+		delete(r.servedAt, height)
+		return false
+	}
+	return ok
+}
+
+// readChunk reads length bytes at offset from the named file, or the whole
+// file if length is zero.
+func readChunk(filename string, offset, length uint64) ([]byte, error) {
+	if length == 0 {
+		return os.ReadFile(filename)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	data := make([]byte, length)
+	n, err := f.ReadAt(data, int64(offset))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
 func (r *Reactor) handleFileMessage(ctx context.Context, envelope *p2p.Envelope) error {
 	switch msg := envelope.Message.(type) {
 	case *dstypes.FileRequest:
@@ -357,7 +437,7 @@ func (r *Reactor) handleFileMessage(ctx context.Context, envelope *p2p.Envelope)
 		if msg.Height == 0 {
 			return nil
 		}
-		r.syncer.PushFile(msg)
+		r.syncer.PushFile(envelope.From, msg)
 
 	default:
 		return fmt.Errorf("received unknown message: %T", msg)
@@ -582,16 +662,28 @@ func (r *Reactor) requestMetadata(ctx context.Context) error {
 	})
 }
 
-func (r *Reactor) requestFile(ctx context.Context, peer types.NodeID, height uint64, filename string) error {
+func (r *Reactor) requestFile(ctx context.Context, peer types.NodeID, height uint64, filename string, offset, length uint64) error {
 	return r.fileChannel.Send(ctx, p2p.Envelope{
 		To: peer,
 		Message: &dstypes.FileRequest{
 			Height:   height,
 			Filename: filename,
+			Offset:   offset,
+			Length:   length,
 		},
 	})
 }
 
+// reportBadPeer reports a peer that served a corrupted file chunk.
+func (r *Reactor) reportBadPeer(ctx context.Context, peer types.NodeID, err error) {
+	if serr := r.fileChannel.SendError(ctx, p2p.PeerError{
+		NodeID: peer,
+		Err:    err,
+	}); serr != nil {
+		r.logger.Error("failed to report bad peer", "peer", peer, "err", serr)
+	}
+}
+
 func (r *Reactor) fetchLightBlock(height uint64) (*types.LightBlock, error) {
 	h := int64(height)
 
@@ -650,6 +742,80 @@ func (r *Reactor) waitForEnoughPeers(ctx context.Context, numPeers int) error {
 	return nil
 }
 
+// startSnapshotRoutine starts taking a snapshot every SnapshotInterval
+// blocks, if periodic snapshots are enabled.
+func (r *Reactor) startSnapshotRoutine(ctx context.Context) error {
+	if r.config.SnapshotInterval <= 0 || r.config.SnapshotDirectory == "" {
+		return nil
+	}
+	if r.snapshotHook == nil {
+		return errors.New("periodic snapshots require an application that coordinates snapshots")
+	}
+
+	const subscriberID = "dbsync-snapshot-subscriber"
+	sub, err := r.eventBus.SubscribeWithArgs(ctx, tmpubsub.SubscribeArgs{
+		ClientID: subscriberID,
+		Query:    types.EventQueryNewBlockHeader,
+		Limit:    1 << 10,
+	})
+	if err != nil {
+		return fmt.Errorf("snapshot subscribe: %w", err)
+	}
+
+	// Snapshots are taken one at a time; a snapshot that falls due while
+	// another is still being taken is skipped.
+	due := make(chan uint64, 1)
+	go func() {
+		// N.B. Use background for unsubscribe, ctx is already terminated.
+		defer r.eventBus.UnsubscribeAll(context.Background(), subscriberID) // nolint:errcheck
+		for {
+			msg, err := sub.Next(ctx)
+			if err != nil {
+				r.logger.Error("snapshot subscription terminated", "err", err)
+				return
+			}
+			height := msg.Data().(types.EventDataNewBlockHeader).Header.Height
+			if height%int64(r.config.SnapshotInterval) != 0 {
+				continue
+			}
+			select {
+			case due <- uint64(height):
+			default:
+				r.logger.Info("skipping snapshot while another is in progress", "height", height)
+			}
+		}
+	}()
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case height := <-due:
+				if err := r.takeSnapshot(ctx); err != nil {
+					r.logger.Error("failed to take snapshot", "due_height", height, "err", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// takeSnapshot takes a snapshot while the application holds its DB still, and
+// then prunes old snapshots that peers are no longer syncing from.
+func (r *Reactor) takeSnapshot(ctx context.Context) error {
+	if err := r.snapshotHook(ctx, func(height uint64) error {
+		start := time.Now()
+		if err := Snapshot(height, r.config, r.baseConfig); err != nil {
+			return err
+		}
+		r.logger.Info("took snapshot", "height", height, "duration", time.Since(start))
+		return nil
+	}); err != nil {
+		return err
+	}
+	return PruneSnapshots(r.config.SnapshotDirectory, r.config.SnapshotKeepRecent, r.snapshotInUse)
+}
+
 func (r *Reactor) commitState(ctx context.Context, height uint64) (sm.State, *types.Commit, error) {
 	appHash, err := r.stateProvider.AppHash(ctx, height)
 	if err != nil {
@@ -670,6 +836,31 @@ func (r *Reactor) commitState(ctx context.Context, height uint64) (sm.State, *ty
 	return state, commit, nil
 }
 
+// verifyApp loads the restored application DB into the application, and
+// checks that the application reports the height and app hash of the light
+// client verified state.
+func (r *Reactor) verifyApp(ctx context.Context, state sm.State) error {
+	if _, err := r.conn.LoadLatest(ctx, &abci.RequestLoadLatest{}); err != nil {
+		return fmt.Errorf("failed to load restored application DB: %w", err)
+	}
+	resp, err := r.conn.Info(ctx, &proxy.RequestInfo)
+	if err != nil {
+		return fmt.Errorf("failed to query ABCI app for appHash: %w", err)
+	}
+
+	if resp.LastBlockHeight != state.LastBlockHeight {
+		return fmt.Errorf("%w: expected height %d, got %d",
+			errVerifyFailed, state.LastBlockHeight, resp.LastBlockHeight)
+	}
+	if !bytes.Equal(resp.LastBlockAppHash, state.AppHash) {
+		return fmt.Errorf("%w: expected app hash %X, got %X",
+			errVerifyFailed, state.AppHash, resp.LastBlockAppHash)
+	}
+
+	r.logger.Info("verified restored application state", "height", state.LastBlockHeight, "appHash", state.AppHash)
+	return nil
+}
+
 func (r *Reactor) postSync(ctx context.Context, state sm.State, commit *types.Commit) error {
 	if err := r.stateStore.Bootstrap(state); err != nil {
 		return err
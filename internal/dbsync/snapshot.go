@@ -1,22 +1,64 @@
 package dbsync
 
 import (
+	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/ari-anchor/sei-tendermint/config"
 	dstypes "github.com/ari-anchor/sei-tendermint/proto/tendermint/dbsync"
 )
 
+// SnapshotChunkSize is the size of the chunks that snapshot files are split
+// into when they are transferred to syncing peers.
+const SnapshotChunkSize = uint64(4e6) // ~4MB
+
+// snapshotTempSuffix marks snapshot directories that are still being written.
+const snapshotTempSuffix = ".tmp"
+
+// SnapshotHook is called when an online snapshot is due. It must stop the
+// application from writing to its DB, call take with the height the DB is
+// committed at, and let the application resume once take returns. It returns
+// the error from take, if any.
+type SnapshotHook func(ctx context.Context, take func(height uint64) error) error
+
+// SnapshotCoordinator is implemented by ABCI clients whose application can
+// hold its DB still while the node takes an online snapshot of it.
+type SnapshotCoordinator interface {
+	CoordinateSnapshot(ctx context.Context, take func(height uint64) error) error
+}
+
+// Snapshot copies the application DB into a snapshot directory for height
+// and advertises it to syncing peers by updating the latest height file. The
+// snapshot is written to a temporary directory first, so a snapshot directory
+// is only ever visible once it is complete. A snapshot directory is never
+// modified once visible, since peers may be syncing from it: if there already
+// is a snapshot for height, it is advertised as is.
 func Snapshot(height uint64, dbsyncConfig config.DBSyncConfig, baseConfig config.BaseConfig) error {
+	final := path.Join(dbsyncConfig.SnapshotDirectory, fmt.Sprintf("%s%d", HeightSubdirectoryPrefix, height))
+	if _, err := os.Stat(path.Join(final, MetadataFilename)); err == nil {
+		return writeLatestHeight(dbsyncConfig.SnapshotDirectory, height)
+	}
+	if err := writeSnapshot(height, final, baseConfig, dbsyncConfig.SnapshotWorkerCount); err != nil {
+		return err
+	}
+	return writeLatestHeight(dbsyncConfig.SnapshotDirectory, height)
+}
+
+// writeSnapshot copies the application DB into final, the snapshot directory
+// for height, through a temporary directory.
+func writeSnapshot(height uint64, final string, baseConfig config.BaseConfig, workerCount int) error {
 	src := path.Join(baseConfig.DBDir(), ApplicationDBSubdirectory)
 	wasmSrc := path.Join(baseConfig.RootDir, WasmDirectory)
-	dst := path.Join(dbsyncConfig.SnapshotDirectory, fmt.Sprintf("%s%d", HeightSubdirectoryPrefix, height))
+	dst := final + snapshotTempSuffix
 	os.RemoveAll(dst)
 	err := os.MkdirAll(dst, os.ModePerm)
 	if err != nil {
@@ -24,6 +66,7 @@ func Snapshot(height uint64, dbsyncConfig config.DBSyncConfig, baseConfig config
 	}
 	var fds []os.FileInfo
 	if fds, err = ioutil.ReadDir(src); err != nil {
+		os.RemoveAll(dst)
 		return err
 	}
 	wasmNames := map[string]struct{}{}
@@ -34,95 +77,175 @@ func Snapshot(height uint64, dbsyncConfig config.DBSyncConfig, baseConfig config
 		}
 	}
 
-	assignments := make([][]os.FileInfo, dbsyncConfig.SnapshotWorkerCount)
+	assignments := make([][]os.FileInfo, workerCount)
 
 	for i, fd := range fds {
-		assignments[i%dbsyncConfig.SnapshotWorkerCount] = append(assignments[i%dbsyncConfig.SnapshotWorkerCount], fd)
+		assignments[i%workerCount] = append(assignments[i%workerCount], fd)
 	}
 
 	metadata := dstypes.MetadataResponse{
 		Height:      height,
 		Filenames:   []string{},
 		Md5Checksum: [][]byte{},
+		ChunkSize:   SnapshotChunkSize,
+		Files:       []*dstypes.FileMetadata{},
 	}
 	metadataMtx := &sync.Mutex{}
+	var copyErr error
 
 	wg := sync.WaitGroup{}
-	for i := 0; i < dbsyncConfig.SnapshotWorkerCount; i++ {
+	for i := 0; i < workerCount; i++ {
 		wg.Add(1)
 		assignment := assignments[i]
 		go func() {
+			defer wg.Done()
 			for _, fd := range assignment {
-				var srcfp, dstfp string
-				if _, ok := wasmNames[fd.Name()]; ok {
-					srcfp = path.Join(wasmSrc, fd.Name())
-					dstfp = path.Join(dst, fd.Name()) + WasmSuffix
-				} else {
-					srcfp = path.Join(src, fd.Name())
-					dstfp = path.Join(dst, fd.Name())
-				}
-
-				var srcfd *os.File
-				var dstfd *os.File
-				if srcfd, err = os.Open(srcfp); err != nil {
-					panic(err)
-				}
-
-				if dstfd, err = os.Create(dstfp); err != nil {
-					srcfd.Close()
-					panic(err)
-				}
-
-				if _, err = io.Copy(dstfd, srcfd); err != nil {
-					srcfd.Close()
-					dstfd.Close()
-					panic(err)
-				}
-
 				filename := fd.Name()
+				srcfp := path.Join(src, fd.Name())
 				if _, ok := wasmNames[fd.Name()]; ok {
 					filename += WasmSuffix
+					srcfp = path.Join(wasmSrc, fd.Name())
 				}
 
-				bz, err := ioutil.ReadFile(path.Join(dst, filename))
-				if err != nil {
-					panic(err)
-				}
+				bz, err := copyFile(srcfp, path.Join(dst, filename))
 				sum := md5.Sum(bz)
 
 				metadataMtx.Lock()
+				if err != nil {
+					if copyErr == nil {
+						copyErr = err
+					}
+					metadataMtx.Unlock()
+					return
+				}
 				metadata.Filenames = append(metadata.Filenames, filename)
 				metadata.Md5Checksum = append(metadata.Md5Checksum, sum[:])
-
+				metadata.Files = append(metadata.Files, &dstypes.FileMetadata{
+					Filename:    filename,
+					Size_:       uint64(len(bz)),
+					ChunkHashes: chunkHashes(bz, SnapshotChunkSize),
+				})
 				metadataMtx.Unlock()
-				srcfd.Close()
-				dstfd.Close()
 			}
-			wg.Done()
 		}()
 	}
 	wg.Wait()
+	if copyErr != nil {
+		os.RemoveAll(dst)
+		return copyErr
+	}
 
 	metadataBz, err := metadata.Marshal()
 	if err != nil {
+		os.RemoveAll(dst)
+		return err
+	}
+	if err := os.WriteFile(path.Join(dst, MetadataFilename), metadataBz, 0644); err != nil {
+		os.RemoveAll(dst)
 		return err
 	}
 
-	metadataFile, err := os.Create(path.Join(dst, MetadataFilename))
-	if err != nil {
+	// A directory left without metadata by an earlier version is incomplete,
+	// and peers could not have synced from it.
+	os.RemoveAll(final)
+	if err := os.Rename(dst, final); err != nil {
+		os.RemoveAll(dst)
 		return err
 	}
-	defer metadataFile.Close()
-	_, err = metadataFile.Write(metadataBz)
-	if err != nil {
+	return nil
+}
+
+// writeLatestHeight advertises the snapshot for height to syncing peers.
+func writeLatestHeight(snapshotDirectory string, height uint64) error {
+	// Write the new height to a temporary file and rename it into place, so
+	// that peers never read a partially written height.
+	heightFilename := path.Join(snapshotDirectory, MetadataHeightFilename)
+	if err := os.WriteFile(heightFilename+snapshotTempSuffix, []byte(fmt.Sprintf("%d", height)), 0644); err != nil {
 		return err
 	}
+	return os.Rename(heightFilename+snapshotTempSuffix, heightFilename)
+}
 
-	heightFile, err := os.Create(path.Join(dbsyncConfig.SnapshotDirectory, MetadataHeightFilename))
+// copyFile copies the file at src to dst and returns its content.
+func copyFile(src, dst string) ([]byte, error) {
+	bz, err := os.ReadFile(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(dst, bz, 0644); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// PruneSnapshots removes all but the keepRecent most recent snapshot
+// directories, along with any left behind by interrupted snapshots. The
+// snapshot advertised in the latest height file is always kept, and so are
+// the snapshots for which inUse, if not nil, reports that peers are still
+// syncing from them. A keepRecent of zero keeps every snapshot.
+func PruneSnapshots(snapshotDirectory string, keepRecent int, inUse func(height uint64) bool) error {
+	entries, err := os.ReadDir(snapshotDirectory)
 	if err != nil {
 		return err
 	}
-	defer heightFile.Close()
-	_, err = heightFile.Write([]byte(fmt.Sprintf("%d", height)))
-	return err
+
+	var latest uint64
+	if bz, err := os.ReadFile(path.Join(snapshotDirectory, MetadataHeightFilename)); err == nil {
+		latest, _ = strconv.ParseUint(string(bz), 10, 64)
+	}
+
+	var heights []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || !strings.HasPrefix(name, HeightSubdirectoryPrefix) {
+			continue
+		}
+		if strings.HasSuffix(name, snapshotTempSuffix) {
+			if err := os.RemoveAll(path.Join(snapshotDirectory, name)); err != nil {
+				return err
+			}
+			continue
+		}
+		height, err := strconv.ParseUint(strings.TrimPrefix(name, HeightSubdirectoryPrefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		heights = append(heights, height)
+	}
+	if keepRecent <= 0 || len(heights) <= keepRecent {
+		return nil
+	}
+
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+	for _, height := range heights[keepRecent:] {
+		if height == latest || (inUse != nil && inUse(height)) {
+			continue
+		}
+		dir := path.Join(snapshotDirectory, fmt.Sprintf("%s%d", HeightSubdirectoryPrefix, height))
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chunkHashes splits data into chunks of chunkSize bytes and returns the
+// SHA-256 hash of each chunk, in offset order.
+func chunkHashes(data []byte, chunkSize uint64) [][]byte {
+	hashes := make([][]byte, 0, numChunks(uint64(len(data)), chunkSize))
+	for offset := uint64(0); offset < uint64(len(data)); offset += chunkSize {
+		end := offset + chunkSize
+		if end > uint64(len(data)) {
+			end = uint64(len(data))
+		}
+		sum := sha256.Sum256(data[offset:end])
+		hashes = append(hashes, sum[:])
+	}
+	return hashes
+}
+
+// numChunks returns the number of chunks of chunkSize bytes needed to hold
+// size bytes.
+func numChunks(size, chunkSize uint64) int {
+	return int((size + chunkSize - 1) / chunkSize)
 }
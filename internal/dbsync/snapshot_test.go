@@ -1,16 +1,23 @@
 package dbsync
 
 import (
+	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ari-anchor/sei-tendermint/config"
+	"github.com/ari-anchor/sei-tendermint/internal/eventbus"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
 	dstypes "github.com/ari-anchor/sei-tendermint/proto/tendermint/dbsync"
+	"github.com/ari-anchor/sei-tendermint/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,10 +62,17 @@ func TestSnapshot(t *testing.T) {
 	require.Nil(t, err)
 	expected := map[string]string{"d1": "abc", "d2": "def", "w_wasm": "ghi"}
 	checksum1, checksum2, checksum3 := md5.Sum([]byte("abc")), md5.Sum([]byte("def")), md5.Sum([]byte("ghi"))
+	sha1, sha2, sha3 := sha256.Sum256([]byte("abc")), sha256.Sum256([]byte("def")), sha256.Sum256([]byte("ghi"))
 	expectedMetadata := dstypes.MetadataResponse{
 		Height:      height,
 		Filenames:   []string{"d1", "d2", "w_wasm"},
 		Md5Checksum: [][]byte{checksum1[:], checksum2[:], checksum3[:]},
+		ChunkSize:   SnapshotChunkSize,
+		Files: []*dstypes.FileMetadata{
+			{Filename: "d1", Size_: 3, ChunkHashes: [][]byte{sha1[:]}},
+			{Filename: "d2", Size_: 3, ChunkHashes: [][]byte{sha2[:]}},
+			{Filename: "w_wasm", Size_: 3, ChunkHashes: [][]byte{sha3[:]}},
+		},
 	}
 	serialized, _ := expectedMetadata.Marshal()
 	expected["METADATA"] = string(serialized)
@@ -77,4 +91,153 @@ func TestSnapshot(t *testing.T) {
 	writtenHeight, err := strconv.ParseUint(string(hbz), 10, 64)
 	require.Nil(t, err)
 	require.Equal(t, height, writtenHeight)
+
+	// assert no in-progress directory or file is left behind
+	_, err = os.Stat(subdir + snapshotTempSuffix)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(path.Join(dbsyncConfig.SnapshotDirectory, MetadataHeightFilename+snapshotTempSuffix))
+	require.True(t, os.IsNotExist(err))
+}
+
+// makeSnapshotSource creates an application DB holding a single file with
+// the given content, and returns the configs to snapshot it with.
+func makeSnapshotSource(t *testing.T, content string) (config.BaseConfig, config.DBSyncConfig) {
+	baseConfig := config.BaseConfig{
+		RootDir: t.TempDir(),
+		DBPath:  "data",
+	}
+	appDBDir := path.Join(baseConfig.DBDir(), ApplicationDBSubdirectory)
+	require.NoError(t, os.MkdirAll(appDBDir, os.ModePerm))
+	require.NoError(t, os.WriteFile(path.Join(appDBDir, "d1"), []byte(content), 0644))
+	dbsyncConfig := config.DBSyncConfig{
+		SnapshotDirectory:   t.TempDir(),
+		SnapshotWorkerCount: 1,
+	}
+	return baseConfig, dbsyncConfig
+}
+
+func TestSnapshotKeepsPublished(t *testing.T) {
+	baseConfig, dbsyncConfig := makeSnapshotSource(t, "abc")
+	require.NoError(t, Snapshot(1000, dbsyncConfig, baseConfig))
+	require.NoError(t, Snapshot(2000, dbsyncConfig, baseConfig))
+
+	// peers may be syncing from a published snapshot, so it is not rewritten
+	require.NoError(t, os.WriteFile(path.Join(baseConfig.DBDir(), ApplicationDBSubdirectory, "d1"), []byte("xyz"), 0644))
+	require.NoError(t, Snapshot(1000, dbsyncConfig, baseConfig))
+	data, err := os.ReadFile(path.Join(dbsyncConfig.SnapshotDirectory, "snapshot_1000", "d1"))
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(data))
+	height, err := os.ReadFile(path.Join(dbsyncConfig.SnapshotDirectory, MetadataHeightFilename))
+	require.NoError(t, err)
+	require.Equal(t, "1000", string(height))
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"snapshot_100", "snapshot_200", "snapshot_300", "snapshot_400", "snapshot_500.tmp"} {
+		require.NoError(t, os.MkdirAll(path.Join(dir, name), os.ModePerm))
+	}
+	// the latest height is never pruned, even if it is not among the newest
+	require.NoError(t, os.WriteFile(path.Join(dir, MetadataHeightFilename), []byte("100"), 0644))
+
+	require.NoError(t, PruneSnapshots(dir, 2, nil))
+	fds, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, fd := range fds {
+		names = append(names, fd.Name())
+	}
+	require.ElementsMatch(t, []string{MetadataHeightFilename, "snapshot_100", "snapshot_300", "snapshot_400"}, names)
+
+	// zero keeps every snapshot
+	require.NoError(t, PruneSnapshots(dir, 0, nil))
+	fds, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, fds, 4)
+
+	// snapshots that peers are syncing from are kept
+	require.NoError(t, PruneSnapshots(dir, 1, func(height uint64) bool { return height == 300 }))
+	fds, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	names = nil
+	for _, fd := range fds {
+		names = append(names, fd.Name())
+	}
+	require.ElementsMatch(t, []string{MetadataHeightFilename, "snapshot_100", "snapshot_300", "snapshot_400"}, names)
+}
+
+func TestSnapshotRoutine(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := log.NewNopLogger()
+	eventBus := eventbus.NewDefault(logger)
+	require.NoError(t, eventBus.Start(ctx))
+
+	baseConfig, dbsyncConfig := makeSnapshotSource(t, "abc")
+	dbsyncConfig.SnapshotInterval = 2
+	dbsyncConfig.SnapshotKeepRecent = 1
+
+	// the application reports the height its DB is committed at
+	var mtx sync.Mutex
+	var committed uint64
+	r := &Reactor{
+		logger:     logger,
+		config:     dbsyncConfig,
+		baseConfig: baseConfig,
+		eventBus:   eventBus,
+		servedAt:   map[uint64]time.Time{},
+		snapshotHook: func(ctx context.Context, take func(height uint64) error) error {
+			mtx.Lock()
+			defer mtx.Unlock()
+			return take(committed)
+		},
+	}
+	require.NoError(t, r.startSnapshotRoutine(ctx))
+
+	snapshotAt := func(height int64) {
+		mtx.Lock()
+		committed = uint64(height)
+		mtx.Unlock()
+		require.NoError(t, eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+		}))
+		require.Eventually(t, func() bool {
+			bz, err := os.ReadFile(path.Join(dbsyncConfig.SnapshotDirectory, MetadataHeightFilename))
+			return err == nil && string(bz) == strconv.FormatInt(height, 10)
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+	exists := func(height int64) bool {
+		_, err := os.Stat(path.Join(dbsyncConfig.SnapshotDirectory, fmt.Sprintf("snapshot_%d", height)))
+		return err == nil
+	}
+
+	snapshotAt(2)
+	// heights between intervals do not take snapshots
+	require.NoError(t, eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 3},
+	}))
+
+	// a snapshot that a peer is syncing from outlives pruning
+	r.markServed(2)
+	snapshotAt(4)
+	require.Eventually(t, func() bool { return exists(4) }, 10*time.Second, 10*time.Millisecond)
+	require.True(t, exists(2))
+	require.False(t, exists(3))
+
+	r.servedMtx.Lock()
+	r.servedAt[2] = time.Now().Add(-snapshotServeGracePeriod - time.Second)
+	r.servedMtx.Unlock()
+	snapshotAt(6)
+	require.Eventually(t, func() bool { return !exists(2) && !exists(4) }, 10*time.Second, 10*time.Millisecond)
+	require.True(t, exists(6))
+}
+
+func TestChunkHashes(t *testing.T) {
+	data := []byte("0123456789")
+	sum1, sum2, sum3 := sha256.Sum256([]byte("0123")), sha256.Sum256([]byte("4567")), sha256.Sum256([]byte("89"))
+	require.Equal(t, [][]byte{sum1[:], sum2[:], sum3[:]}, chunkHashes(data, 4))
+	require.Equal(t, [][]byte{}, chunkHashes(nil, 4))
+	require.Equal(t, 3, numChunks(uint64(len(data)), 4))
+	require.Equal(t, 0, numChunks(0, 4))
 }
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -28,60 +32,111 @@ const WasmDirectory = "wasm/wasm/state/wasm"
 const WasmSuffix = "_wasm"
 const LockFile = "LOCK"
 
+// errBadChunk is returned when a peer serves a chunk whose hash does not match
+// the metadata of the snapshot being synced.
+var errBadChunk = errors.New("received chunk with unexpected hash")
+
+// errVerifyFailed is returned when the restored application state does not
+// match the light client verified state of the snapshot height.
+var errVerifyFailed = errors.New("verification of restored application state failed")
+
+// fileToSync tracks the chunks of a single snapshot file. A file of a legacy
+// snapshot, whose size is unknown, is a single chunk holding the whole file,
+// and its chunk hash is the MD5 checksum of the file.
+type fileToSync struct {
+	filename    string
+	size        uint64
+	chunkHashes [][]byte
+	synced      []bool
+}
+
+// chunkID identifies a chunk by its file and offset.
+type chunkID struct {
+	filename string
+	offset   uint64
+}
+
+// pendingChunk is a chunk that has been requested from a peer and not yet
+// received.
+type pendingChunk struct {
+	peer       types.NodeID
+	completion chan struct{}
+}
+
+// chunkResponse is a chunk received from a peer, waiting to be processed.
+type chunkResponse struct {
+	from types.NodeID
+	file *dstypes.FileResponse
+}
+
 type Syncer struct {
 	mtx    *sync.RWMutex
 	logger log.Logger
 
-	active                 bool
-	heightToSync           uint64
-	peersToSync            []types.NodeID
-	expectedChecksums      map[string][]byte
-	pendingFiles           map[string]struct{}
-	syncedFiles            map[string]struct{}
-	completionSignals      map[string]chan struct{}
+	active         bool
+	heightToSync   uint64
+	peersToSync    []types.NodeID
+	nextPeer       int
+	metadataDigest []byte
+	// chunkSize is zero for a legacy snapshot, whose files are transferred
+	// whole and verified against their MD5 checksums.
+	chunkSize              uint64
+	filesToSync            map[string]*fileToSync
+	numChunks              int
+	numSyncedChunks        int
+	unrequestedChunks      []chunkID
+	pendingChunks          map[chunkID]*pendingChunk
 	metadataSetAt          time.Time
 	timeoutInSeconds       time.Duration
-	fileQueue              []*dstypes.FileResponse
+	chunkQueue             []chunkResponse
 	applicationDBDirectory string
 	wasmStateDirectory     string
 	sleepInSeconds         time.Duration
 	fileWorkerCount        int
 	fileWorkerTimeout      time.Duration
 	fileWorkerCancelFn     context.CancelFunc
+	// resumeDone is closed once the goroutine preparing the files of the
+	// current sync returns. It must be waited for, without holding the lock,
+	// before the files are prepared again.
+	resumeDone <-chan struct{}
+	// verifying is set while the restored application state is verified,
+	// without holding the lock.
+	verifying bool
+	// rejectedDigests are the digests of the snapshots whose restored
+	// application state failed verification.
+	rejectedDigests map[string]struct{}
+	// badPeers are the peers that served bad chunks during the current sync,
+	// which are not used again even if they resend the metadata.
+	badPeers map[types.NodeID]struct{}
 
 	metadataRequestFn func(context.Context) error
-	fileRequestFn     func(context.Context, types.NodeID, uint64, string) error
+	fileRequestFn     func(context.Context, types.NodeID, uint64, string, uint64, uint64) error
 	commitStateFn     func(context.Context, uint64) (sm.State, *types.Commit, error)
+	verifyAppFn       func(context.Context, sm.State) error
 	postSyncFn        func(context.Context, sm.State, *types.Commit) error
-	resetDirFn        func(*Syncer)
+	badPeerFn         func(context.Context, types.NodeID, error)
 
 	state  sm.State
 	commit *types.Commit
 }
 
-func defaultResetDirFn(s *Syncer) {
-	os.RemoveAll(s.applicationDBDirectory)
-	os.MkdirAll(s.applicationDBDirectory, fs.ModePerm)
-	os.RemoveAll(s.wasmStateDirectory)
-	os.MkdirAll(s.wasmStateDirectory, fs.ModePerm)
-}
-
 func NewSyncer(
 	logger log.Logger,
 	dbsyncConfig config.DBSyncConfig,
 	baseConfig config.BaseConfig,
 	enable bool,
 	metadataRequestFn func(context.Context) error,
-	fileRequestFn func(context.Context, types.NodeID, uint64, string) error,
+	fileRequestFn func(context.Context, types.NodeID, uint64, string, uint64, uint64) error,
 	commitStateFn func(context.Context, uint64) (sm.State, *types.Commit, error),
+	verifyAppFn func(context.Context, sm.State) error,
 	postSyncFn func(context.Context, sm.State, *types.Commit) error,
-	resetDirFn func(*Syncer),
+	badPeerFn func(context.Context, types.NodeID, error),
 ) *Syncer {
 	return &Syncer{
 		logger:                 logger,
 		active:                 enable,
 		timeoutInSeconds:       time.Duration(dbsyncConfig.TimeoutInSeconds) * time.Second,
-		fileQueue:              []*dstypes.FileResponse{},
+		chunkQueue:             []chunkResponse{},
 		applicationDBDirectory: path.Join(baseConfig.DBDir(), ApplicationDBSubdirectory),
 		wasmStateDirectory:     path.Join(baseConfig.RootDir, WasmDirectory),
 		sleepInSeconds:         time.Duration(dbsyncConfig.NoFileSleepInSeconds) * time.Second,
@@ -90,8 +145,11 @@ func NewSyncer(
 		metadataRequestFn:      metadataRequestFn,
 		fileRequestFn:          fileRequestFn,
 		commitStateFn:          commitStateFn,
+		verifyAppFn:            verifyAppFn,
+		rejectedDigests:        map[string]struct{}{},
+		badPeers:               map[types.NodeID]struct{}{},
 		postSyncFn:             postSyncFn,
-		resetDirFn:             resetDirFn,
+		badPeerFn:              badPeerFn,
 		mtx:                    &sync.RWMutex{},
 	}
 }
@@ -105,17 +163,30 @@ func (s *Syncer) SetMetadata(ctx context.Context, sender types.NodeID, metadata
 	}
 	s.mtx.RUnlock()
 
-	if len(metadata.Filenames) != len(metadata.Md5Checksum) {
-		s.logger.Error("received bad metadata with inconsistent files and checksums count")
+	files, err := validateMetadata(metadata)
+	if err != nil {
+		s.logger.Error("received bad metadata", "peer", sender, "err", err)
 		return
 	}
+	digest := metadataDigest(metadata)
 
 	timedOut, now := s.isCurrentMetadataTimedOut()
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
+	if _, ok := s.rejectedDigests[string(digest)]; ok {
+		s.logger.Debug("ignoring metadata of rejected snapshot", "peer", sender, "height", metadata.Height)
+		return
+	}
 	if timedOut {
-		if s.fileWorkerCancelFn != nil {
-			s.fileWorkerCancelFn()
+		// The previous sync may still be preparing the same files on disk.
+		if done := s.cancelResume(); done != nil {
+			s.mtx.Unlock()
+			<-done
+			s.mtx.Lock()
+			if s.resumeDone != done || !s.active {
+				// another sync was started or the syncer was stopped meanwhile
+				return
+			}
 		}
 
 		state, commit, err := s.commitStateFn(ctx, metadata.Height)
@@ -126,25 +197,40 @@ func (s *Syncer) SetMetadata(ctx context.Context, sender types.NodeID, metadata
 		s.commit = commit
 		s.metadataSetAt = now
 		s.heightToSync = metadata.Height
-		s.expectedChecksums = map[string][]byte{}
-		s.syncedFiles = map[string]struct{}{}
-		s.pendingFiles = map[string]struct{}{}
-		s.completionSignals = map[string]chan struct{}{}
-		for i, filename := range metadata.Filenames {
-			if filename == LockFile {
-				// ignore lockfile
-				continue
-			}
-			s.expectedChecksums[filename] = metadata.Md5Checksum[i]
+		s.metadataDigest = digest
+		s.chunkSize = metadata.ChunkSize
+		s.filesToSync = files
+		s.numChunks = 0
+		for _, file := range files {
+			s.numChunks += len(file.chunkHashes)
 		}
-		s.fileQueue = []*dstypes.FileResponse{}
+		s.numSyncedChunks = 0
+		s.unrequestedChunks = []chunkID{}
+		s.pendingChunks = map[chunkID]*pendingChunk{}
+		s.chunkQueue = []chunkResponse{}
 		s.peersToSync = []types.NodeID{sender}
-		s.resetDirFn(s)
+		s.badPeers = map[types.NodeID]struct{}{}
+		s.nextPeer = 0
 
 		cancellableCtx, cancel := context.WithCancel(ctx)
 		s.fileWorkerCancelFn = cancel
-		s.requestFiles(cancellableCtx, s.metadataSetAt)
-	} else if metadata.Height == s.heightToSync {
+		done := make(chan struct{})
+		s.resumeDone = done
+		go func(metadataSetAt time.Time) {
+			defer close(done)
+			s.resume(cancellableCtx, metadataSetAt, files, metadata.ChunkSize)
+		}(s.metadataSetAt)
+	} else if metadata.Height == s.heightToSync && bytes.Equal(digest, s.metadataDigest) {
+		// Only peers serving byte-identical snapshot files can share the
+		// download, since chunks are verified against the first metadata.
+		if _, ok := s.badPeers[sender]; ok {
+			return
+		}
+		for _, peer := range s.peersToSync {
+			if peer == sender {
+				return
+			}
+		}
 		s.peersToSync = append(s.peersToSync, sender)
 	}
 }
@@ -153,7 +239,7 @@ func (s *Syncer) Process(ctx context.Context) {
 	for {
 		s.mtx.RLock()
 		if !s.active {
-			s.logger.Info(fmt.Sprintf("sync for height %d with %d files finished!", s.heightToSync, len(s.expectedChecksums)))
+			s.logger.Info(fmt.Sprintf("sync for height %d with %d files finished!", s.heightToSync, len(s.filesToSync)))
 			s.mtx.RUnlock()
 			break
 		}
@@ -165,82 +251,168 @@ func (s *Syncer) Process(ctx context.Context) {
 			time.Sleep(s.sleepInSeconds)
 			continue
 		}
-		file := s.popFile()
-		if file == nil {
+		chunk, ok := s.popChunk()
+		if !ok {
 			s.mtx.RLock()
-			numSynced := len(s.syncedFiles)
-			numTotal := len(s.expectedChecksums)
+			numSynced := s.numSyncedChunks
+			numTotal := s.numChunks
 			s.mtx.RUnlock()
-			s.logger.Info(fmt.Sprintf("no file to sync; sync'ed %d out of %d so far; sleeping for %f seconds", numSynced, numTotal, s.sleepInSeconds.Seconds()))
+			s.logger.Info(fmt.Sprintf("no chunk to sync; sync'ed %d out of %d so far; sleeping for %f seconds", numSynced, numTotal, s.sleepInSeconds.Seconds()))
 			time.Sleep(s.sleepInSeconds)
 			continue
 		}
-		if err := s.processFile(ctx, file); err != nil {
-			s.logger.Error(err.Error())
+		if err := s.processChunk(ctx, chunk); err != nil {
+			s.logger.Error(err.Error(), "peer", chunk.from)
+			if errors.Is(err, errBadChunk) {
+				s.badPeerFn(ctx, chunk.from, err)
+			}
 		}
 	}
 }
 
+// Stop deactivates the syncer, and returns once the files are no longer being
+// prepared. Files that have been partially downloaded are left on disk, so
+// that the sync resumes from them after a restart.
 func (s *Syncer) Stop() {
 	s.mtx.Lock()
-	defer s.mtx.Unlock()
-	if s.active {
-		s.resetDirFn(s)
-		s.active = false
+	done := s.cancelResume()
+	s.active = false
+	s.mtx.Unlock()
+	if done != nil {
+		<-done
 	}
 }
 
-func (s *Syncer) processFile(ctx context.Context, file *dstypes.FileResponse) error {
+// cancelResume cancels the current sync and returns the channel closed once
+// its files are no longer being prepared, or nil if no sync was started. The
+// caller must hold the lock.
+func (s *Syncer) cancelResume() <-chan struct{} {
+	if s.fileWorkerCancelFn != nil {
+		s.fileWorkerCancelFn()
+	}
+	return s.resumeDone
+}
+
+func (s *Syncer) processChunk(ctx context.Context, chunk chunkResponse) error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
-	defer func() {
-		delete(s.pendingFiles, file.Filename)
-	}()
-
-	if file.Height != s.heightToSync {
-		return fmt.Errorf("current height is %d but received file for height %d", s.heightToSync, file.Height)
-	}
-
-	if expectedChecksum, ok := s.expectedChecksums[file.Filename]; !ok {
-		return fmt.Errorf("received unexpected file %s", file.Filename)
-	} else if _, ok := s.syncedFiles[file.Filename]; ok {
-		return fmt.Errorf("received duplicate file %s", file.Filename)
-	} else if _, ok := s.pendingFiles[file.Filename]; !ok {
-		return fmt.Errorf("received unrequested file %s", file.Filename)
-	} else {
-		checkSum := md5.Sum(file.Data)
-		if !bytes.Equal(checkSum[:], expectedChecksum) {
-			return errors.New("received unexpected checksum")
+
+	resp := chunk.file
+	if resp.Height != s.heightToSync {
+		return fmt.Errorf("current height is %d but received chunk for height %d", s.heightToSync, resp.Height)
+	}
+
+	file, ok := s.filesToSync[resp.Filename]
+	if !ok {
+		return fmt.Errorf("received unexpected file %s", resp.Filename)
+	}
+	id := chunkID{filename: resp.Filename, offset: resp.Offset}
+	var index uint64
+	if s.chunkSize == 0 {
+		if resp.Offset != 0 {
+			return fmt.Errorf("received chunk of %s at invalid offset %d", resp.Filename, resp.Offset)
 		}
+	} else if index = resp.Offset / s.chunkSize; resp.Offset%s.chunkSize != 0 || index >= uint64(len(file.chunkHashes)) {
+		return fmt.Errorf("received chunk of %s at invalid offset %d", resp.Filename, resp.Offset)
+	}
+	if file.synced[index] {
+		return fmt.Errorf("received duplicate chunk of %s at offset %d", resp.Filename, resp.Offset)
+	}
+	pending, ok := s.pendingChunks[id]
+	if !ok {
+		return fmt.Errorf("received unrequested chunk of %s at offset %d", resp.Filename, resp.Offset)
+	} else if pending.peer != chunk.from {
+		return fmt.Errorf("received chunk of %s at offset %d that was requested from %s", resp.Filename, resp.Offset, pending.peer)
 	}
 
-	var dbFile *os.File
-	var err error
-	if strings.HasSuffix(file.Filename, WasmSuffix) {
-		dbFile, err = os.Create(path.Join(s.wasmStateDirectory, strings.TrimSuffix(file.Filename, WasmSuffix)))
-	} else {
-		dbFile, err = os.Create(path.Join(s.applicationDBDirectory, file.Filename))
+	// Whatever the outcome, the chunk is no longer pending and the worker
+	// waiting on it can move on.
+	delete(s.pendingChunks, id)
+	pending.completion <- struct{}{}
+
+	if !bytes.Equal(hashChunk(resp.Data, s.chunkSize), file.chunkHashes[index]) {
+		s.unrequestedChunks = append(s.unrequestedChunks, id)
+		s.removePeer(chunk.from)
+		return fmt.Errorf("%w: %s at offset %d", errBadChunk, resp.Filename, resp.Offset)
 	}
-	if err != nil {
+
+	write := writeChunk
+	if s.chunkSize == 0 {
+		write = writeFile
+	}
+	if err := write(s.localPath(resp.Filename), resp.Offset, resp.Data); err != nil {
+		s.unrequestedChunks = append(s.unrequestedChunks, id)
 		return err
 	}
-	defer dbFile.Close()
-	_, err = dbFile.Write(file.Data)
+
+	file.synced[index] = true
+	s.numSyncedChunks++
+	s.completeIfSynced(ctx)
+	return nil
+}
+
+// completeIfSynced finishes the sync once every chunk has been written. The
+// caller must hold the lock, which is released while the application verifies
+// the restored state.
+func (s *Syncer) completeIfSynced(ctx context.Context) {
+	if !s.active || s.verifying || s.numSyncedChunks < s.numChunks {
+		return
+	}
+	// we have finished syncing, but the restored application state must match
+	// the light client verified state before the node is bootstrapped from it
+	metadataSetAt, state := s.metadataSetAt, s.state
+	s.verifying = true
+	s.mtx.Unlock()
+	err := s.verifyAppFn(ctx, state)
+	s.mtx.Lock()
+	s.verifying = false
+	if metadataSetAt != s.metadataSetAt || !s.active {
+		// the sync was stopped or restarted in the meantime
+		return
+	}
 	if err != nil {
-		return err
+		s.rejectSnapshot(ctx, err)
+		return
 	}
+	if err := s.postSyncFn(ctx, s.state, s.commit); err != nil {
+		// no graceful way to handle postsync error since we might be in a partially updated state
+		panic(err)
+	}
+	s.active = false
+}
 
-	s.syncedFiles[file.Filename] = struct{}{}
-	if len(s.syncedFiles) == len(s.expectedChecksums) {
-		// we have finished syncing
-		if err := s.postSyncFn(ctx, s.state, s.commit); err != nil {
-			// no graceful way to handle postsync error since we might be in a partially updated state
-			panic(err)
+// rejectSnapshot abandons the snapshot being synced after its restored
+// application state could not be verified. If the state did not match, the
+// metadata of the snapshot is ignored from then on and the peers that served
+// it are reported. Otherwise the verification is retried when the snapshot is
+// synced again, which reuses the chunks already on disk. A new sync starts
+// from the next metadata received. The caller must hold the lock.
+func (s *Syncer) rejectSnapshot(ctx context.Context, err error) {
+	s.logger.Error("rejecting snapshot", "height", s.heightToSync, "err", err)
+	if errors.Is(err, errVerifyFailed) {
+		s.rejectedDigests[string(s.metadataDigest)] = struct{}{}
+		for _, peer := range s.peersToSync {
+			go s.badPeerFn(ctx, peer, err)
+		}
+	}
+	if s.fileWorkerCancelFn != nil {
+		s.fileWorkerCancelFn()
+	}
+	s.metadataSetAt = time.Time{}
+	s.peersToSync = []types.NodeID{}
+	s.unrequestedChunks = []chunkID{}
+}
+
+// removePeer stops requesting chunks from peer for the current sync. The
+// caller must hold the lock.
+func (s *Syncer) removePeer(peer types.NodeID) {
+	s.badPeers[peer] = struct{}{}
+	for i, p := range s.peersToSync {
+		if p == peer {
+			s.peersToSync = append(s.peersToSync[:i], s.peersToSync[i+1:]...)
+			return
 		}
-		s.active = false
 	}
-	s.completionSignals[file.Filename] <- struct{}{}
-	return nil
 }
 
 func (s *Syncer) isCurrentMetadataTimedOut() (bool, time.Time) {
@@ -253,53 +425,238 @@ func (s *Syncer) isCurrentMetadataTimedOut() (bool, time.Time) {
 	return now.After(s.metadataSetAt.Add(s.timeoutInSeconds)), now
 }
 
-func (s *Syncer) requestFiles(ctx context.Context, metadataSetAt time.Time) {
+// resume lays out the files of the snapshot on disk, keeps whatever chunks
+// already hold the expected data, and then starts requesting the rest. Chunks
+// left over from an earlier attempt, including one interrupted by a restart,
+// are therefore not downloaded again.
+func (s *Syncer) resume(ctx context.Context, metadataSetAt time.Time, files map[string]*fileToSync, chunkSize uint64) {
+	unrequested, err := s.prepareFiles(ctx, files, chunkSize)
+	if err != nil {
+		s.logger.Error("failed to prepare files for sync", "err", err)
+		return
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if metadataSetAt != s.metadataSetAt || !s.active {
+		return
+	}
+	s.unrequestedChunks = unrequested
+	s.numSyncedChunks = s.numChunks - len(unrequested)
+	s.logger.Info(fmt.Sprintf("resuming sync for height %d with %d out of %d chunks already on disk", s.heightToSync, s.numSyncedChunks, s.numChunks))
+	s.completeIfSynced(ctx)
+	if s.active {
+		s.requestChunks(ctx, metadataSetAt)
+	}
+}
+
+// prepareFiles removes local files that are not part of the snapshot, sizes
+// the others to match it, and marks the chunks whose hash already matches as
+// synced. It returns the chunks that still need to be fetched.
+func (s *Syncer) prepareFiles(ctx context.Context, files map[string]*fileToSync, chunkSize uint64) ([]chunkID, error) {
+	for _, dir := range []string{s.applicationDBDirectory, s.wasmStateDirectory} {
+		if err := os.MkdirAll(dir, fs.ModePerm); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.removeExtraneousFiles(files); err != nil {
+		return nil, err
+	}
+
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	unrequested := []chunkID{}
+	for _, filename := range filenames {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		file := files[filename]
+		verify := verifyLocalChunks
+		if chunkSize == 0 {
+			verify = verifyLocalFile
+		}
+		if err := verify(s.localPath(filename), file, chunkSize); err != nil {
+			return nil, err
+		}
+		for i, synced := range file.synced {
+			if !synced {
+				unrequested = append(unrequested, chunkID{filename: filename, offset: uint64(i) * chunkSize})
+			}
+		}
+	}
+	return unrequested, nil
+}
+
+// removeExtraneousFiles deletes files in the sync directories that are not
+// part of the snapshot being synced.
+func (s *Syncer) removeExtraneousFiles(files map[string]*fileToSync) error {
+	for _, dir := range []struct {
+		path   string
+		suffix string
+	}{
+		{s.applicationDBDirectory, ""},
+		{s.wasmStateDirectory, WasmSuffix},
+	} {
+		entries, err := os.ReadDir(dir.path)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if _, ok := files[entry.Name()+dir.suffix]; ok && !entry.IsDir() {
+				continue
+			}
+			if err := os.RemoveAll(path.Join(dir.path, entry.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// verifyLocalChunks sizes the local copy of file to its expected size and
+// marks each chunk whose content already matches its hash as synced.
+func verifyLocalChunks(localPath string, file *fileToSync, chunkSize uint64) error {
+	f, err := os.OpenFile(localPath, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := f.Truncate(int64(file.size)); err != nil {
+		return err
+	}
+
+	buf := make([]byte, chunkSize)
+	for i, expected := range file.chunkHashes {
+		offset := uint64(i) * chunkSize
+		n := chunkSize
+		if offset+n > file.size {
+			n = file.size - offset
+		}
+		if _, err := f.ReadAt(buf[:n], int64(offset)); err != nil && err != io.EOF {
+			return err
+		}
+		sum := sha256.Sum256(buf[:n])
+		file.synced[i] = bytes.Equal(sum[:], expected)
+	}
+	return nil
+}
+
+// verifyLocalFile marks the single chunk of a file of a legacy snapshot as
+// synced if the local copy of the file matches its MD5 checksum.
+func verifyLocalFile(localPath string, file *fileToSync, _ uint64) error {
+	data, err := os.ReadFile(localPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	file.synced[0] = bytes.Equal(hashChunk(data, 0), file.chunkHashes[0])
+	return nil
+}
+
+// hashChunk returns the hash of a chunk of a snapshot with the given chunk
+// size: the SHA-256 hash, or the MD5 checksum of a whole file of a legacy
+// snapshot.
+func hashChunk(data []byte, chunkSize uint64) []byte {
+	if chunkSize == 0 {
+		sum := md5.Sum(data)
+		return sum[:]
+	}
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// writeFile replaces the file at localPath with data, a whole file of a
+// legacy snapshot.
+func writeFile(localPath string, _ uint64, data []byte) error {
+	return os.WriteFile(localPath, data, 0644)
+}
+
+// writeChunk writes data at offset in the file at localPath.
+func writeChunk(localPath string, offset uint64, data []byte) error {
+	f, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(data, int64(offset)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// localPath returns the path that the snapshot file filename is synced to.
+func (s *Syncer) localPath(filename string) string {
+	if strings.HasSuffix(filename, WasmSuffix) {
+		return path.Join(s.wasmStateDirectory, strings.TrimSuffix(filename, WasmSuffix))
+	}
+	return path.Join(s.applicationDBDirectory, filename)
+}
+
+func (s *Syncer) requestChunks(ctx context.Context, metadataSetAt time.Time) {
 	worker := func() {
 		for {
 			s.mtx.Lock()
-			if metadataSetAt != s.metadataSetAt {
+			if metadataSetAt != s.metadataSetAt || !s.active {
 				s.mtx.Unlock()
-				break
+				return
 			}
-			if len(s.expectedChecksums) == len(s.pendingFiles)+len(s.syncedFiles) {
-				// even if there are still pending items, there should be enough
-				// workers to handle them given one worker can have at most one
-				// pending item at a time
+			if len(s.unrequestedChunks) == 0 {
+				// even if there are still pending chunks, there should be
+				// enough workers to handle them given one worker can have at
+				// most one pending chunk at a time
 				s.mtx.Unlock()
-				break
+				return
 			}
-			var picked string
-			for filename := range s.expectedChecksums {
-				_, pending := s.pendingFiles[filename]
-				_, synced := s.syncedFiles[filename]
-				if pending || synced {
+			if len(s.peersToSync) == 0 {
+				// every peer has served bad chunks; wait for more peers to
+				// advertise the same snapshot or for the metadata to time out
+				s.mtx.Unlock()
+				select {
+				case <-time.After(s.sleepInSeconds):
 					continue
+				case <-ctx.Done():
+					return
 				}
-				picked = filename
-				break
 			}
-			s.pendingFiles[picked] = struct{}{}
-			completionSignal := make(chan struct{}, 1)
-			s.completionSignals[picked] = completionSignal
-			s.fileRequestFn(ctx, s.peersToSync[0], s.heightToSync, picked)
+
+			picked := s.unrequestedChunks[0]
+			s.unrequestedChunks = s.unrequestedChunks[1:]
+			peer := s.peersToSync[s.nextPeer%len(s.peersToSync)]
+			s.nextPeer++
+			pending := &pendingChunk{peer: peer, completion: make(chan struct{}, 1)}
+			s.pendingChunks[picked] = pending
+			// A zero length requests the whole file of a legacy snapshot.
+			length := s.chunkSize
+			if size := s.filesToSync[picked.filename].size; length != 0 && picked.offset+length > size {
+				length = size - picked.offset
+			}
+			s.fileRequestFn(ctx, peer, s.heightToSync, picked.filename, picked.offset, length)
 			s.mtx.Unlock()
 
-			ticker := time.NewTicker(s.fileWorkerTimeout)
-			defer ticker.Stop()
+			timer := time.NewTimer(s.fileWorkerTimeout)
 
 			select {
-			case <-completionSignal:
+			case <-pending.completion:
 
-			case <-ticker.C:
+			case <-timer.C:
 				s.mtx.Lock()
-				delete(s.pendingFiles, picked)
+				if s.pendingChunks[picked] == pending {
+					delete(s.pendingChunks, picked)
+					s.unrequestedChunks = append(s.unrequestedChunks, picked)
+				}
 				s.mtx.Unlock()
 
 			case <-ctx.Done():
+				timer.Stop()
 				return
 			}
 
-			ticker.Stop()
+			timer.Stop()
 		}
 	}
 	for i := 0; i < s.fileWorkerCount; i++ {
@@ -307,22 +664,115 @@ func (s *Syncer) requestFiles(ctx context.Context, metadataSetAt time.Time) {
 	}
 }
 
-func (s *Syncer) popFile() *dstypes.FileResponse {
+func (s *Syncer) popChunk() (chunkResponse, bool) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
-	if len(s.fileQueue) == 0 {
-		return nil
+	if len(s.chunkQueue) == 0 {
+		return chunkResponse{}, false
 	}
 
-	file := s.fileQueue[0]
-	s.fileQueue = s.fileQueue[1:]
-	return file
+	chunk := s.chunkQueue[0]
+	s.chunkQueue = s.chunkQueue[1:]
+	return chunk, true
 }
 
-func (s *Syncer) PushFile(file *dstypes.FileResponse) {
+func (s *Syncer) PushFile(from types.NodeID, file *dstypes.FileResponse) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
-	s.fileQueue = append(s.fileQueue, file)
+	s.chunkQueue = append(s.chunkQueue, chunkResponse{from: from, file: file})
+}
+
+// validateMetadata checks that metadata received from a peer describes a
+// well-formed snapshot and returns the files to sync, keyed by name. Peers
+// running an earlier version describe legacy snapshots, without chunks.
+func validateMetadata(metadata *dstypes.MetadataResponse) (map[string]*fileToSync, error) {
+	if metadata.ChunkSize == 0 {
+		return validateLegacyMetadata(metadata)
+	} else if metadata.ChunkSize > maxChunkSize {
+		return nil, fmt.Errorf("chunk size %d exceeds maximum %d", metadata.ChunkSize, maxChunkSize)
+	}
+
+	files := make(map[string]*fileToSync, len(metadata.Files))
+	for _, file := range metadata.Files {
+		if file.Filename == LockFile {
+			// ignore lockfile
+			continue
+		}
+		if file.Filename == "" || file.Filename != filepath.Base(file.Filename) || file.Filename == "." || file.Filename == ".." {
+			return nil, fmt.Errorf("invalid filename %q", file.Filename)
+		} else if _, ok := files[file.Filename]; ok {
+			return nil, fmt.Errorf("duplicate file %s", file.Filename)
+		} else if len(file.ChunkHashes) != numChunks(file.Size_, metadata.ChunkSize) {
+			return nil, fmt.Errorf("file %s of size %d has %d chunk hashes", file.Filename, file.Size_, len(file.ChunkHashes))
+		}
+		for _, hash := range file.ChunkHashes {
+			if len(hash) != sha256.Size {
+				return nil, fmt.Errorf("file %s has a chunk hash of invalid length %d", file.Filename, len(hash))
+			}
+		}
+		files[file.Filename] = &fileToSync{
+			filename:    file.Filename,
+			size:        file.Size_,
+			chunkHashes: file.ChunkHashes,
+			synced:      make([]bool, len(file.ChunkHashes)),
+		}
+	}
+	return files, nil
+}
+
+// validateLegacyMetadata checks the metadata of a legacy snapshot, whose files
+// are transferred whole and verified against their MD5 checksums.
+func validateLegacyMetadata(metadata *dstypes.MetadataResponse) (map[string]*fileToSync, error) {
+	if len(metadata.Files) != 0 {
+		return nil, errors.New("metadata describes file chunks without a chunk size")
+	} else if len(metadata.Filenames) != len(metadata.Md5Checksum) {
+		return nil, errors.New("inconsistent files and checksums count")
+	}
+
+	files := make(map[string]*fileToSync, len(metadata.Filenames))
+	for i, filename := range metadata.Filenames {
+		if filename == LockFile {
+			// ignore lockfile
+			continue
+		}
+		if filename == "" || filename != filepath.Base(filename) || filename == "." || filename == ".." {
+			return nil, fmt.Errorf("invalid filename %q", filename)
+		} else if _, ok := files[filename]; ok {
+			return nil, fmt.Errorf("duplicate file %s", filename)
+		} else if len(metadata.Md5Checksum[i]) != md5.Size {
+			return nil, fmt.Errorf("file %s has a checksum of invalid length %d", filename, len(metadata.Md5Checksum[i]))
+		}
+		files[filename] = &fileToSync{
+			filename:    filename,
+			chunkHashes: [][]byte{metadata.Md5Checksum[i]},
+			synced:      make([]bool, 1),
+		}
+	}
+	return files, nil
+}
+
+// metadataDigest returns a hash that identifies the content of the snapshot
+// described by metadata, independent of the order its files are listed in.
+func metadataDigest(metadata *dstypes.MetadataResponse) []byte {
+	files := make([]*dstypes.FileMetadata, len(metadata.Files))
+	copy(files, metadata.Files)
+	sort.Slice(files, func(i, j int) bool { return files[i].Filename < files[j].Filename })
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%d/%d", metadata.Height, metadata.ChunkSize)
+	for _, file := range files {
+		fmt.Fprintf(h, "/%s/%d", file.Filename, file.Size_)
+		for _, hash := range file.ChunkHashes {
+			h.Write(hash)
+		}
+	}
+	for i, filename := range metadata.Filenames {
+		fmt.Fprintf(h, "/%s", filename)
+		if i < len(metadata.Md5Checksum) {
+			h.Write(metadata.Md5Checksum[i])
+		}
+	}
+	return h.Sum(nil)
 }
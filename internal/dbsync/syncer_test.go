@@ -3,6 +3,10 @@ package dbsync
 import (
 	"context"
 	"crypto/md5"
+	"errors"
+	"os"
+	"path"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,113 +18,424 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func getTestSyncer(t *testing.T) *Syncer {
+const testChunkSize = 4
+
+type fileRequest struct {
+	peer     types.NodeID
+	filename string
+	offset   uint64
+	length   uint64
+}
+
+// testSyncerHooks records the requests and peer reports made by a test syncer.
+type testSyncerHooks struct {
+	mtx      sync.Mutex
+	requests []fileRequest
+	badPeers []types.NodeID
+	// verifyErr is returned by the verification of the restored state.
+	verifyErr error
+}
+
+func (h *testSyncerHooks) Requests() []fileRequest {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	return append([]fileRequest{}, h.requests...)
+}
+
+func getTestSyncer(t *testing.T) (*Syncer, *testSyncerHooks) {
 	baseConfig := config.DefaultBaseConfig()
 	dbsyncConfig := config.DefaultDBSyncConfig()
 	dbsyncConfig.TimeoutInSeconds = 99999
 	dbsyncConfig.NoFileSleepInSeconds = 5
 	dbsyncConfig.FileWorkerTimeout = 10
+	hooks := &testSyncerHooks{}
 	syncer := NewSyncer(
 		log.NewNopLogger(),
 		*dbsyncConfig,
 		baseConfig,
 		true,
 		func(ctx context.Context) error { return nil },
-		func(ctx context.Context, ni types.NodeID, u uint64, s string) error { return nil },
+		func(ctx context.Context, ni types.NodeID, u uint64, s string, offset, length uint64) error {
+			hooks.mtx.Lock()
+			defer hooks.mtx.Unlock()
+			hooks.requests = append(hooks.requests, fileRequest{peer: ni, filename: s, offset: offset, length: length})
+			return nil
+		},
 		func(ctx context.Context, u uint64) (state.State, *types.Commit, error) {
 			return state.State{}, nil, nil
 		},
+		func(ctx context.Context, s state.State) error {
+			hooks.mtx.Lock()
+			defer hooks.mtx.Unlock()
+			return hooks.verifyErr
+		},
 		func(ctx context.Context, s state.State, c *types.Commit) error { return nil },
-		func(s *Syncer) {
-			s.applicationDBDirectory = t.TempDir()
-			s.wasmStateDirectory = t.TempDir()
+		func(ctx context.Context, ni types.NodeID, err error) {
+			hooks.mtx.Lock()
+			defer hooks.mtx.Unlock()
+			hooks.badPeers = append(hooks.badPeers, ni)
 		},
 	)
 	syncer.active = true
 	syncer.applicationDBDirectory = t.TempDir()
 	syncer.wasmStateDirectory = t.TempDir()
-	return syncer
+	// stop the syncer before its directories are removed
+	t.Cleanup(syncer.Stop)
+	return syncer, hooks
+}
+
+func testMetadata(height uint64, files map[string][]byte) *dbsync.MetadataResponse {
+	metadata := &dbsync.MetadataResponse{
+		Height:    height,
+		Hash:      []byte("hash"),
+		ChunkSize: testChunkSize,
+	}
+	for filename, data := range files {
+		metadata.Files = append(metadata.Files, &dbsync.FileMetadata{
+			Filename:    filename,
+			Size_:       uint64(len(data)),
+			ChunkHashes: chunkHashes(data, testChunkSize),
+		})
+	}
+	return metadata
+}
+
+func waitForPending(t *testing.T, syncer *Syncer, filename string, offset uint64) types.NodeID {
+	t.Helper()
+	id := chunkID{filename: filename, offset: offset}
+	require.Eventually(t, func() bool {
+		syncer.mtx.RLock()
+		defer syncer.mtx.RUnlock()
+		_, ok := syncer.pendingChunks[id]
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+	syncer.mtx.RLock()
+	defer syncer.mtx.RUnlock()
+	return syncer.pendingChunks[id].peer
 }
 
 func TestSetMetadata(t *testing.T) {
-	syncer := getTestSyncer(t)
+	syncer, _ := getTestSyncer(t)
+	metadata := testMetadata(1, map[string][]byte{"f1": []byte("data")})
 	// initial
-	syncer.SetMetadata(context.Background(), types.NodeID("someone"), &dbsync.MetadataResponse{
-		Height:      1,
-		Hash:        []byte("hash"),
-		Filenames:   []string{"f1"},
-		Md5Checksum: [][]byte{[]byte("sum")},
-	})
+	syncer.SetMetadata(context.Background(), types.NodeID("someone"), metadata)
 	syncer.fileWorkerCancelFn()
+	<-syncer.resumeDone
 	require.Equal(t, uint64(1), syncer.heightToSync)
 	require.NotNil(t, syncer.metadataSetAt)
-	require.Equal(t, 1, len(syncer.expectedChecksums))
+	require.Equal(t, 1, len(syncer.filesToSync))
+	require.Equal(t, 1, syncer.numChunks)
 	require.Equal(t, 1, len(syncer.peersToSync))
 
 	// second time
-	syncer.SetMetadata(context.Background(), types.NodeID("someone else"), &dbsync.MetadataResponse{
-		Height:      1,
-		Hash:        []byte("hash"),
-		Filenames:   []string{"f1"},
-		Md5Checksum: [][]byte{[]byte("sum")},
-	})
+	syncer.SetMetadata(context.Background(), types.NodeID("someone else"), metadata)
 	require.Equal(t, uint64(1), syncer.heightToSync)
 	require.NotNil(t, syncer.metadataSetAt)
-	require.Equal(t, 1, len(syncer.expectedChecksums))
+	require.Equal(t, 1, len(syncer.filesToSync))
+	require.Equal(t, 2, len(syncer.peersToSync))
+
+	// a peer with a different snapshot at the same height cannot share the download
+	syncer.SetMetadata(context.Background(), types.NodeID("someone different"),
+		testMetadata(1, map[string][]byte{"f1": []byte("other")}))
 	require.Equal(t, 2, len(syncer.peersToSync))
 }
 
+func TestSetMetadataInvalid(t *testing.T) {
+	testCases := map[string]*dbsync.MetadataResponse{
+		"legacy checksum of invalid length": {Height: 1, Filenames: []string{"f1"}, Md5Checksum: [][]byte{[]byte("sum")}},
+		"legacy checksum missing":           {Height: 1, Filenames: []string{"f1", "f2"}, Md5Checksum: [][]byte{make([]byte, md5.Size)}},
+		"chunks without chunk size": {Height: 1, Files: []*dbsync.FileMetadata{
+			{Filename: "f1", Size_: 1, ChunkHashes: chunkHashes([]byte("d"), testChunkSize)},
+		}},
+		"path traversal": {Height: 1, ChunkSize: testChunkSize, Files: []*dbsync.FileMetadata{
+			{Filename: "../f1", Size_: 1, ChunkHashes: chunkHashes([]byte("d"), testChunkSize)},
+		}},
+		"missing chunk hash": {Height: 1, ChunkSize: testChunkSize, Files: []*dbsync.FileMetadata{
+			{Filename: "f1", Size_: 5, ChunkHashes: chunkHashes([]byte("data"), testChunkSize)},
+		}},
+		"chunk too large": {Height: 1, ChunkSize: maxChunkSize + 1},
+	}
+	for name, metadata := range testCases {
+		t.Run(name, func(t *testing.T) {
+			syncer, _ := getTestSyncer(t)
+			syncer.SetMetadata(context.Background(), types.NodeID("someone"), metadata)
+			require.Zero(t, syncer.heightToSync)
+			require.Empty(t, syncer.peersToSync)
+		})
+	}
+}
+
 func TestFileProcessHappyPath(t *testing.T) {
 	// successful process
-	syncer := getTestSyncer(t)
+	syncer, _ := getTestSyncer(t)
 	data := []byte("data")
-	sum := md5.Sum(data)
-	syncer.SetMetadata(context.Background(), types.NodeID("someone"), &dbsync.MetadataResponse{
-		Height:      1,
-		Hash:        []byte("hash"),
-		Filenames:   []string{"f1"},
-		Md5Checksum: [][]byte{sum[:]},
-	})
-	for {
-		syncer.mtx.RLock()
-		_, ok := syncer.pendingFiles["f1"]
-		syncer.mtx.RUnlock()
-		if ok {
-			break
-		}
-	}
-	syncer.PushFile(&dbsync.FileResponse{
+	syncer.SetMetadata(context.Background(), types.NodeID("someone"), testMetadata(1, map[string][]byte{"f1": data}))
+	peer := waitForPending(t, syncer, "f1", 0)
+	syncer.PushFile(peer, &dbsync.FileResponse{
 		Height:   1,
 		Filename: "f1",
 		Data:     data,
 	})
 	syncer.Process(context.Background())
+
+	written, err := os.ReadFile(path.Join(syncer.applicationDBDirectory, "f1"))
+	require.NoError(t, err)
+	require.Equal(t, data, written)
 }
 
 func TestFileProcessTimeoutReprocess(t *testing.T) {
 	// successful process
-	syncer := getTestSyncer(t)
+	syncer, _ := getTestSyncer(t)
 	data := []byte("data")
-	sum := md5.Sum(data)
-	syncer.SetMetadata(context.Background(), types.NodeID("someone"), &dbsync.MetadataResponse{
-		Height:      1,
-		Hash:        []byte("hash"),
-		Filenames:   []string{"f1"},
-		Md5Checksum: [][]byte{sum[:]},
-	})
-	for {
-		syncer.mtx.RLock()
-		_, ok := syncer.pendingFiles["f1"]
-		syncer.mtx.RUnlock()
-		if ok {
-			break
-		}
-	}
+	syncer.SetMetadata(context.Background(), types.NodeID("someone"), testMetadata(1, map[string][]byte{"f1": data}))
+	waitForPending(t, syncer, "f1", 0)
 	time.Sleep(syncer.fileWorkerTimeout + time.Second) // add some padding
-	syncer.PushFile(&dbsync.FileResponse{
+	peer := waitForPending(t, syncer, "f1", 0)
+	syncer.PushFile(peer, &dbsync.FileResponse{
 		Height:   1,
 		Filename: "f1",
 		Data:     data,
 	})
 	syncer.Process(context.Background())
 }
+
+// processInBackground runs the syncer until it finishes and returns a channel
+// that is closed once it does.
+func processInBackground(syncer *Syncer) <-chan struct{} {
+	syncer.sleepInSeconds = 10 * time.Millisecond
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		syncer.Process(context.Background())
+	}()
+	return done
+}
+
+func TestFileProcessChunksFromAllPeers(t *testing.T) {
+	syncer, _ := getTestSyncer(t)
+	syncer.fileWorkerCount = 1
+	data := []byte("0123456789ab")
+	metadata := testMetadata(1, map[string][]byte{"f1": data})
+	done := processInBackground(syncer)
+
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), metadata)
+	require.Equal(t, types.NodeID("p1"), waitForPending(t, syncer, "f1", 0))
+	syncer.SetMetadata(context.Background(), types.NodeID("p2"), metadata)
+
+	// chunks are requested from the peers in turn
+	for i, want := range []types.NodeID{"p1", "p2", "p1"} {
+		offset := uint64(i * testChunkSize)
+		require.Equal(t, want, waitForPending(t, syncer, "f1", offset))
+		syncer.PushFile(want, &dbsync.FileResponse{
+			Height:   1,
+			Filename: "f1",
+			Offset:   offset,
+			Data:     data[offset : offset+testChunkSize],
+		})
+	}
+	<-done
+
+	written, err := os.ReadFile(path.Join(syncer.applicationDBDirectory, "f1"))
+	require.NoError(t, err)
+	require.Equal(t, data, written)
+}
+
+func TestFileProcessBadChunk(t *testing.T) {
+	syncer, hooks := getTestSyncer(t)
+	syncer.fileWorkerCount = 1
+	data := []byte("data")
+	metadata := testMetadata(1, map[string][]byte{"f1": data})
+	done := processInBackground(syncer)
+
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), metadata)
+	require.Equal(t, types.NodeID("p1"), waitForPending(t, syncer, "f1", 0))
+	syncer.SetMetadata(context.Background(), types.NodeID("p2"), metadata)
+
+	// the corrupted chunk is attributed to p1, which is no longer used
+	syncer.PushFile("p1", &dbsync.FileResponse{Height: 1, Filename: "f1", Data: []byte("bad!")})
+	require.Eventually(t, func() bool {
+		hooks.mtx.Lock()
+		defer hooks.mtx.Unlock()
+		return len(hooks.badPeers) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	hooks.mtx.Lock()
+	require.Equal(t, []types.NodeID{"p1"}, hooks.badPeers)
+	hooks.mtx.Unlock()
+	require.Equal(t, types.NodeID("p2"), waitForPending(t, syncer, "f1", 0))
+	syncer.mtx.RLock()
+	require.Equal(t, []types.NodeID{"p2"}, syncer.peersToSync)
+	syncer.mtx.RUnlock()
+
+	// chunks from peers they were not requested from are ignored
+	syncer.PushFile("p1", &dbsync.FileResponse{Height: 1, Filename: "f1", Data: data})
+	syncer.PushFile("p2", &dbsync.FileResponse{Height: 1, Filename: "f1", Data: data})
+	<-done
+
+	written, err := os.ReadFile(path.Join(syncer.applicationDBDirectory, "f1"))
+	require.NoError(t, err)
+	require.Equal(t, data, written)
+}
+
+func TestFileProcessLegacySnapshot(t *testing.T) {
+	syncer, hooks := getTestSyncer(t)
+	data := []byte("0123456789ab")
+	sum := md5.Sum(data)
+	// peers running an earlier version send whole files and their checksums
+	metadata := &dbsync.MetadataResponse{
+		Height:      1,
+		Hash:        []byte("hash"),
+		Filenames:   []string{"f1", LockFile},
+		Md5Checksum: [][]byte{sum[:], make([]byte, md5.Size)},
+	}
+	done := processInBackground(syncer)
+
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), metadata)
+	peer := waitForPending(t, syncer, "f1", 0)
+	syncer.PushFile(peer, &dbsync.FileResponse{Height: 1, Filename: "f1", Data: data})
+	<-done
+
+	require.Equal(t, []fileRequest{{peer: "p1", filename: "f1"}}, hooks.Requests())
+	written, err := os.ReadFile(path.Join(syncer.applicationDBDirectory, "f1"))
+	require.NoError(t, err)
+	require.Equal(t, data, written)
+}
+
+func TestSetMetadataIgnoresBadPeer(t *testing.T) {
+	syncer, hooks := getTestSyncer(t)
+	syncer.fileWorkerCount = 1
+	data := []byte("data")
+	metadata := testMetadata(1, map[string][]byte{"f1": data})
+	done := processInBackground(syncer)
+
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), metadata)
+	require.Equal(t, types.NodeID("p1"), waitForPending(t, syncer, "f1", 0))
+	syncer.SetMetadata(context.Background(), types.NodeID("p2"), metadata)
+	syncer.PushFile("p1", &dbsync.FileResponse{Height: 1, Filename: "f1", Data: []byte("bad!")})
+	require.Eventually(t, func() bool {
+		hooks.mtx.Lock()
+		defer hooks.mtx.Unlock()
+		return len(hooks.badPeers) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, types.NodeID("p2"), waitForPending(t, syncer, "f1", 0))
+
+	// resending the metadata does not bring the bad peer back into the sync
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), metadata)
+	syncer.mtx.RLock()
+	require.Equal(t, []types.NodeID{"p2"}, syncer.peersToSync)
+	syncer.mtx.RUnlock()
+
+	syncer.PushFile("p2", &dbsync.FileResponse{Height: 1, Filename: "f1", Data: data})
+	<-done
+}
+
+func TestFileProcessResume(t *testing.T) {
+	syncer, hooks := getTestSyncer(t)
+	data := []byte("0123456789ab")
+
+	// a previous attempt left the first chunk and an unrelated file behind
+	require.NoError(t, os.WriteFile(path.Join(syncer.applicationDBDirectory, "f1"), []byte("0123xxxx"), 0644))
+	require.NoError(t, os.WriteFile(path.Join(syncer.applicationDBDirectory, "stale"), []byte("stale"), 0644))
+	done := processInBackground(syncer)
+
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), testMetadata(1, map[string][]byte{"f1": data}))
+	for _, offset := range []uint64{4, 8} {
+		peer := waitForPending(t, syncer, "f1", offset)
+		syncer.PushFile(peer, &dbsync.FileResponse{
+			Height:   1,
+			Filename: "f1",
+			Offset:   offset,
+			Data:     data[offset : offset+testChunkSize],
+		})
+	}
+	<-done
+
+	for _, req := range hooks.Requests() {
+		require.NotZero(t, req.offset, "chunk already on disk was requested again")
+	}
+	written, err := os.ReadFile(path.Join(syncer.applicationDBDirectory, "f1"))
+	require.NoError(t, err)
+	require.Equal(t, data, written)
+	_, err = os.Stat(path.Join(syncer.applicationDBDirectory, "stale"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFileProcessRejectsUnverifiedState(t *testing.T) {
+	syncer, hooks := getTestSyncer(t)
+	hooks.verifyErr = errVerifyFailed
+	bad := []byte("bad!")
+	metadata := testMetadata(1, map[string][]byte{"f1": bad})
+	done := processInBackground(syncer)
+
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), metadata)
+	syncer.SetMetadata(context.Background(), types.NodeID("p2"), metadata)
+	peer := waitForPending(t, syncer, "f1", 0)
+	syncer.PushFile(peer, &dbsync.FileResponse{Height: 1, Filename: "f1", Data: bad})
+
+	// the peers that served the snapshot are reported, and the sync goes on
+	require.Eventually(t, func() bool {
+		hooks.mtx.Lock()
+		defer hooks.mtx.Unlock()
+		return len(hooks.badPeers) == 2
+	}, 10*time.Second, 10*time.Millisecond)
+	hooks.mtx.Lock()
+	require.ElementsMatch(t, []types.NodeID{"p1", "p2"}, hooks.badPeers)
+	hooks.verifyErr = nil
+	hooks.mtx.Unlock()
+	syncer.mtx.RLock()
+	require.True(t, syncer.active)
+	syncer.mtx.RUnlock()
+
+	// the rejected snapshot is not synced again
+	syncer.SetMetadata(context.Background(), types.NodeID("p3"), metadata)
+	syncer.mtx.RLock()
+	require.True(t, syncer.metadataSetAt.IsZero())
+	require.Empty(t, syncer.peersToSync)
+	syncer.mtx.RUnlock()
+
+	good := []byte("good")
+	syncer.SetMetadata(context.Background(), types.NodeID("p3"), testMetadata(1, map[string][]byte{"f1": good}))
+	peer = waitForPending(t, syncer, "f1", 0)
+	require.Equal(t, types.NodeID("p3"), peer)
+	syncer.PushFile(peer, &dbsync.FileResponse{Height: 1, Filename: "f1", Data: good})
+	<-done
+
+	written, err := os.ReadFile(path.Join(syncer.applicationDBDirectory, "f1"))
+	require.NoError(t, err)
+	require.Equal(t, good, written)
+}
+
+func TestFileProcessRetriesFailedVerification(t *testing.T) {
+	syncer, hooks := getTestSyncer(t)
+	hooks.verifyErr = errors.New("application unavailable")
+	data := []byte("data")
+	metadata := testMetadata(1, map[string][]byte{"f1": data})
+	done := processInBackground(syncer)
+
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), metadata)
+	peer := waitForPending(t, syncer, "f1", 0)
+	syncer.PushFile(peer, &dbsync.FileResponse{Height: 1, Filename: "f1", Data: data})
+
+	// the snapshot is abandoned without blaming the peers that served it
+	require.Eventually(t, func() bool {
+		syncer.mtx.RLock()
+		defer syncer.mtx.RUnlock()
+		return syncer.metadataSetAt.IsZero()
+	}, 10*time.Second, 10*time.Millisecond)
+	hooks.mtx.Lock()
+	require.Empty(t, hooks.badPeers)
+	hooks.verifyErr = nil
+	hooks.mtx.Unlock()
+	syncer.mtx.RLock()
+	require.Empty(t, syncer.rejectedDigests)
+	require.True(t, syncer.active)
+	syncer.mtx.RUnlock()
+
+	// the same snapshot is verified again from the chunks already on disk
+	syncer.SetMetadata(context.Background(), types.NodeID("p1"), metadata)
+	<-done
+
+	require.Len(t, hooks.Requests(), 1)
+	written, err := os.ReadFile(path.Join(syncer.applicationDBDirectory, "f1"))
+	require.NoError(t, err)
+	require.Equal(t, data, written)
+}
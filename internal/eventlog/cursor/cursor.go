@@ -48,9 +48,11 @@ type Cursor struct {
 	sequence  uint16 // sequence number
 }
 
-// Before reports whether c is prior to o in time ordering. This comparison
-// ignores sequence numbers.
-func (c Cursor) Before(o Cursor) bool { return c.timestamp < o.timestamp }
+// Before reports whether c is prior to o in time ordering. Cursors with the
+// same time index are ordered by their sequence numbers.
+func (c Cursor) Before(o Cursor) bool {
+	return c.timestamp < o.timestamp || (c.timestamp == o.timestamp && c.sequence < o.sequence)
+}
 
 // Diff returns the time duration between c and o. The duration is negative if
 // c is before o in time order.
@@ -73,13 +73,16 @@ func TestCursor_roundTrip(t *testing.T) {
 func TestCursor_ordering(t *testing.T) {
 	// Condition: text1 precedes text2 in time order.
 	// Condition: text2 has an earlier sequence than text1.
+	// Condition: text3 has the same time as text2 and a later sequence.
 	const zero = ""
 	const text1 = "0000000012345678-0005"
 	const text2 = "00000000fecdeba9-0002"
+	const text3 = "00000000fecdeba9-0003"
 
 	zc := mustParse(t, zero)
 	c1 := mustParse(t, text1)
 	c2 := mustParse(t, text2)
+	c3 := mustParse(t, text3)
 
 	// Confirm for all pairs that string order respects time order.
 	pairs := []struct {
@@ -95,6 +98,9 @@ func TestCursor_ordering(t *testing.T) {
 		{text2, zero, c2, zc},
 		{text2, text1, c2, c1},
 		{text2, text2, c2, c2},
+		{text2, text3, c2, c3},
+		{text3, text2, c3, c2},
+		{text3, text1, c3, c1},
 	}
 	for _, pair := range pairs {
 		want := pair.t1 < pair.t2
@@ -4,14 +4,22 @@
 // New items are added to the head of the log (the newest end), and items that
 // fall outside the designated window are pruned from its tail (the oldest).
 // Items within the log are indexed by lexicographically-ordered cursors.
+//
+// A log may also be persistent, in which case its items are also written to a
+// database. The items of a persistent log survive restarts, and remain
+// available to scans after they are pruned from memory, until they are pruned
+// from the database by age or total size.
 package eventlog
 
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
+	dbm "github.com/tendermint/tm-db"
+
 	"github.com/ari-anchor/sei-tendermint/internal/eventlog/cursor"
 	"github.com/ari-anchor/sei-tendermint/types"
 )
@@ -20,13 +28,20 @@ import (
 // before the newest item. Use Add to add new items to the front (head) of the
 // log, and Scan or WaitScan to traverse the current contents of the log.
 //
-// After construction, a *Log is safe for concurrent access by one writer and
-// any number of readers.
+// After construction, a *Log is safe for concurrent access by any number of
+// writers and readers.
 type Log struct {
 	// These values do not change after construction.
 	windowSize time.Duration
 	maxItems   int
 	metrics    *Metrics
+	store      *store // nil unless the log is persistent
+
+	// Serializes writers, so that items are stored and linked into the log in
+	// the order of their cursors, and the log is pruned by one writer at a
+	// time. It is held across writing an item to the database, unlike mu.
+	writeMu sync.Mutex
+	source  cursor.Source // generator of cursors; protected by writeMu
 
 	// Protects access to the fields below.  Lock to modify the values of these
 	// fields, or to read or snapshot the values.
@@ -36,7 +51,6 @@ type Log struct {
 	oldestCursor cursor.Cursor // cursor of the oldest item
 	head         *logEntry     // pointer to the newest item
 	ready        chan struct{} // closed when head changes
-	source       cursor.Source // generator of cursors
 }
 
 // New constructs a new empty log with the given settings.
@@ -54,20 +68,66 @@ func New(opts LogSettings) (*Log, error) {
 	if opts.Metrics != nil {
 		lg.metrics = opts.Metrics
 	}
+	if opts.DB != nil {
+		if err := lg.openStore(opts); err != nil {
+			return nil, err
+		}
+	}
 	return lg, nil
 }
 
+// openStore opens the database of a persistent log, and restores the items
+// within the window of the log to memory.
+func (lg *Log) openStore(opts LogSettings) error {
+	retention := opts.Retention
+	if retention == 0 {
+		retention = opts.WindowSize
+	} else if retention < opts.WindowSize {
+		return errors.New("retention must not be shorter than the window size")
+	}
+	s, err := openStore(opts.DB, retention, opts.MaxBytes, lg.metrics)
+	if err != nil {
+		return fmt.Errorf("opening event log store: %w", err)
+	}
+	items, err := s.load(lg.windowSize, lg.maxItems)
+	if err != nil {
+		return fmt.Errorf("loading event log: %w", err)
+	}
+
+	// The items are loaded newest first, so link them from the oldest.
+	for i := len(items) - 1; i >= 0; i-- {
+		lg.head = &logEntry{item: items[i], next: lg.head}
+	}
+	lg.numItems = len(items)
+	if len(items) != 0 {
+		lg.oldestCursor = items[len(items)-1].Cursor
+	}
+	lg.metrics.numItems.Set(float64(lg.numItems))
+	lg.store = s
+	return nil
+}
+
 // Add adds a new item to the front of the log. If necessary, the log is pruned
 // to fit its constraints on size and age. Add blocks until both steps are done.
 //
-// Any error reported by Add arises from pruning; the new item was added to the
-// log regardless whether an error occurs.
+// Any error reported by Add arises from pruning, or from writing the item to
+// the database of a persistent log; the new item was added to the log
+// regardless whether an error occurs.
 func (lg *Log) Add(etype string, data types.EventData) error {
-	lg.mu.Lock()
-	head := &logEntry{
-		item: newItem(lg.source.Cursor(), etype, data),
-		next: lg.head,
+	// The cursor is assigned and the item linked in while holding writeMu,
+	// so that concurrent writers keep the log ordered newest first.
+	lg.writeMu.Lock()
+	item := newItem(lg.source.Cursor(), etype, data)
+
+	// Store the item before it is visible in memory, so that a scan which
+	// continues past the items in memory does not miss it.
+	var storeErr error
+	if lg.store != nil {
+		storeErr = lg.store.put(item)
 	}
+
+	lg.mu.Lock()
+	head := &logEntry{item: item, next: lg.head}
 	lg.numItems++
 	lg.updateHead(head)
 	size := lg.numItems
@@ -76,7 +136,22 @@ func (lg *Log) Add(etype string, data types.EventData) error {
 	// If the log requires pruning, do the pruning step outside the lock.  This
 	// permits readers to continue to make progress while we're working.
 	lg.mu.Unlock()
-	return lg.checkPrune(head, size, age)
+	err := lg.checkPrune(head, size, age)
+	lg.writeMu.Unlock()
+	if lg.store == nil {
+		return err
+	}
+
+	// Items pruned from memory remain in the database, so they are not lost.
+	if errors.Is(err, ErrLogPruned) {
+		err = nil
+	}
+	if storeErr != nil {
+		return fmt.Errorf("storing event: %w", storeErr)
+	} else if err != nil {
+		return err
+	}
+	return lg.store.prune()
 }
 
 // Scan scans the current contents of the log, calling f with each item until
@@ -88,6 +163,45 @@ func (lg *Log) Scan(f func(*Item) error) (Info, error) {
 	return lg.scanState(lg.state(), f)
 }
 
+// ScanAfter scans the items of the log newer than c, from the oldest to the
+// newest, calling f with each item until all items are visited or f reports an
+// error. If c is zero, all the items are visited. The error semantics of f are
+// the same as for Scan.
+//
+// Unlike Scan, ScanAfter visits the items in the order they were added, so a
+// reader can resume from the last item it visited. The items a persistent log
+// retains beyond its window are read from the database as they are visited.
+//
+// The Info value returned is valid even if ScanAfter reports an error.
+func (lg *Log) ScanAfter(c cursor.Cursor, f func(*Item) error) (Info, error) {
+	st := lg.state()
+	err := func() error {
+		if st.head == nil {
+			return nil
+		}
+		if lg.store != nil && (c.IsZero() || c.Before(st.tail)) {
+			if err := lg.store.scanAfter(c, st.tail, f); err != nil {
+				return err
+			}
+		}
+
+		var items []*Item
+		for cur := st.head; cur != nil && c.Before(cur.item.Cursor); cur = cur.next {
+			items = append(items, cur.item)
+		}
+		for i := len(items) - 1; i >= 0; i-- {
+			if err := f(items[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if errors.Is(err, ErrStopScan) {
+		return st.info(), nil
+	}
+	return st.info(), err
+}
+
 // WaitScan blocks until the cursor of the frontmost log item is different from
 // c, then executes a Scan on the contents of the log. If ctx ends before the
 // head is updated, WaitScan returns an error without calling f.
@@ -108,6 +222,9 @@ func (lg *Log) WaitScan(ctx context.Context, c cursor.Cursor, f func(*Item) erro
 // Info returns the current state of the log.
 func (lg *Log) Info() Info { return lg.state().info() }
 
+// WindowSize returns the size of the time window of the log.
+func (lg *Log) WindowSize() time.Duration { return lg.windowSize }
+
 // ErrStopScan is returned by a Scan callback to signal that scanning should be
 // terminated without error.
 var ErrStopScan = errors.New("stop scanning")
@@ -133,6 +250,21 @@ type LogSettings struct {
 
 	// If non-nil, exported metrics to update. If nil, metrics are discarded.
 	Metrics *Metrics
+
+	// If non-nil, the log is persistent: items are also written to this
+	// database, restored from it on construction, and scanned from it once
+	// they are pruned from memory.
+	DB dbm.DB
+
+	// The age, measured in time before the newest item, beyond which items
+	// are pruned from the database. If zero, the window size is used;
+	// otherwise it must not be shorter than the window size.
+	Retention time.Duration
+
+	// The maximum total size in bytes of the items retained in the database.
+	// A value ≤ 0 imposes no limit, otherwise the oldest items in excess of
+	// this size will be dropped from the database.
+	MaxBytes int64
 }
 
 // Info records the current state of the log at the time of a scan operation.
@@ -148,6 +280,10 @@ type logState struct {
 	newest cursor.Cursor
 	size   int
 	head   *logEntry
+
+	// For a persistent log, the cursor of the oldest item in memory. Scans
+	// continue with the stored items before it.
+	tail cursor.Cursor
 }
 
 func (st logState) info() Info {
@@ -163,12 +299,19 @@ func (lg *Log) state() logState {
 	if lg.head == nil {
 		return logState{} // empty
 	}
-	return logState{
+	st := logState{
 		oldest: lg.oldestCursor,
 		newest: lg.head.item.Cursor,
 		size:   lg.numItems,
 		head:   lg.head,
 	}
+	if lg.store != nil {
+		st.tail = st.oldest
+		if oldest, size := lg.store.info(); !oldest.IsZero() && oldest.Before(st.oldest) {
+			st.oldest, st.size = oldest, size
+		}
+	}
+	return st
 }
 
 // waitStateChange blocks until either ctx ends or the head of the log is
@@ -190,15 +333,21 @@ func (lg *Log) waitStateChange(ctx context.Context) (logState, error) {
 // description of the callback semantics.
 func (lg *Log) scanState(st logState, f func(*Item) error) (Info, error) {
 	info := Info{Oldest: st.oldest, Newest: st.newest, Size: st.size}
-	for cur := st.head; cur != nil; cur = cur.next {
-		if err := f(cur.item); err != nil {
-			if errors.Is(err, ErrStopScan) {
-				return info, nil
+	err := func() error {
+		for cur := st.head; cur != nil; cur = cur.next {
+			if err := f(cur.item); err != nil {
+				return err
 			}
-			return info, err
 		}
+		if lg.store != nil && st.head != nil {
+			return lg.store.scan(st.tail, f)
+		}
+		return nil
+	}()
+	if errors.Is(err, ErrStopScan) {
+		return info, nil
 	}
-	return info, nil
+	return info, err
 }
 
 // updateHead replaces the current head with newHead, signals any waiters, and
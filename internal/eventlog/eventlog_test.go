@@ -12,6 +12,8 @@ import (
 
 	"github.com/fortytw2/leaktest"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/ari-anchor/sei-tendermint/internal/eventlog"
 	"github.com/ari-anchor/sei-tendermint/internal/eventlog/cursor"
@@ -169,6 +171,58 @@ func TestConcurrent(t *testing.T) {
 	wg.Wait()
 }
 
+func TestConcurrentAdd(t *testing.T) {
+	for _, persist := range []bool{false, true} {
+		t.Run(fmt.Sprintf("persist=%v", persist), func(t *testing.T) {
+			settings := eventlog.LogSettings{
+				WindowSize: 60 * time.Second,
+				// All the items share a time index, so they are ordered by
+				// their sequence numbers.
+				Source: cursor.Source{TimeIndex: newFakeTime(time.Now().UnixNano()).timeIndex},
+			}
+			if persist {
+				settings.DB = dbm.NewMemDB()
+			}
+			lg, err := eventlog.New(settings)
+			if err != nil {
+				t.Fatalf("New unexpectedly failed: %v", err)
+			}
+
+			const numWriters, numItems = 8, 50
+			var wg sync.WaitGroup
+			for i := 0; i < numWriters; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < numItems; j++ {
+						if err := lg.Add("test-event", types.EventDataString("x")); err != nil {
+							t.Errorf("Add failed: %v", err)
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			// The items are linked in the order of their cursors.
+			var prev cursor.Cursor
+			n := 0
+			if _, err := lg.ScanAfter(cursor.Cursor{}, func(itm *eventlog.Item) error {
+				if n > 0 && !prev.Before(itm.Cursor) {
+					t.Errorf("Item %s follows %s", itm.Cursor, prev)
+				}
+				prev = itm.Cursor
+				n++
+				return nil
+			}); err != nil {
+				t.Fatalf("ScanAfter failed: %v", err)
+			}
+			if n != numWriters*numItems {
+				t.Errorf("Got %d items, want %d", n, numWriters*numItems)
+			}
+		})
+	}
+}
+
 func TestPruneSize(t *testing.T) {
 	const maxItems = 25
 	lg, err := eventlog.New(eventlog.LogSettings{
@@ -190,6 +244,204 @@ func TestPruneSize(t *testing.T) {
 	}
 }
 
+func TestPersistent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clk := newFakeTime(0)
+	db := dbm.NewMemDB()
+	open := func() *eventlog.Log {
+		t.Helper()
+		lg, err := eventlog.New(eventlog.LogSettings{
+			WindowSize: 60 * time.Second,
+			Retention:  120 * time.Second,
+			Source:     cursor.Source{TimeIndex: clk.timeIndex},
+			DB:         db,
+		})
+		if err != nil {
+			t.Fatalf("New unexpectedly failed: %v", err)
+		}
+		return lg
+	}
+
+	// Add events at seconds 0, 15, ..., 135. The log keeps the events within
+	// its 60-second window in memory, but scans also return the older ones
+	// from the database.
+	lg := open()
+	var want []string
+	for i := 1; i <= 10; i++ {
+		want = append(want, fmt.Sprintf("%016x-%04x", clk.timeIndex(), i))
+		mustAdd(t, lg, "test-event", types.EventDataString(strconv.Itoa(i)))
+		clk.advance(15 * time.Second)
+	}
+	// time now: 150 sec.
+	if diff := cmp.Diff(want, cursors(t, lg)); diff != "" {
+		t.Errorf("Cursors: (-want, +got)\n%s", diff)
+	}
+	if info := lg.Info(); info.Oldest.String() != want[0] || info.Size != len(want) {
+		t.Errorf("Info: got %+v, want oldest %s and size %d", info, want[0], len(want))
+	}
+
+	// Reopen the log on the same database, and verify that the events and
+	// their data survived.
+	lg = open()
+	if diff := cmp.Diff(want, cursors(t, lg)); diff != "" {
+		t.Errorf("Cursors after reopening: (-want, +got)\n%s", diff)
+	}
+	var data []string
+	if _, err := lg.Scan(func(itm *eventlog.Item) error {
+		if etype, ok := eventlog.FindType(itm.Events); !ok || etype != itm.Type {
+			t.Errorf("Item %v: got type %q, want %q", itm.Cursor, etype, itm.Type)
+		}
+		data = append(data, string(itm.Data.(types.EventDataString)))
+		return nil
+	}); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if diff := cmp.Diff([]string{"10", "9", "8", "7", "6", "5", "4", "3", "2", "1"}, data); diff != "" {
+		t.Errorf("Data after reopening: (-want, +got)\n%s", diff)
+	}
+
+	// A reader can resume from a cursor recorded before the restart.
+	var c cursor.Cursor
+	if err := c.UnmarshalText([]byte(want[len(want)-1])); err != nil {
+		t.Fatalf("Invalid cursor: %v", err)
+	}
+	mustAdd(t, lg, "test-event", types.EventDataString("11"))
+	want = append(want, fmt.Sprintf("%016x-%04x", clk.timeIndex(), 1))
+	var resumed []string
+	if _, err := lg.WaitScan(ctx, c, func(itm *eventlog.Item) error {
+		if !c.Before(itm.Cursor) {
+			return eventlog.ErrStopScan
+		}
+		resumed = append(resumed, itm.Cursor.String())
+		return nil
+	}); err != nil {
+		t.Fatalf("WaitScan failed: %v", err)
+	}
+	if diff := cmp.Diff(want[len(want)-1:], resumed); diff != "" {
+		t.Errorf("Resumed items: (-want, +got)\n%s", diff)
+	}
+
+	// Events older than the retention period are pruned from the database.
+	clk.advance(60 * time.Second) // time now: 210 sec.
+	want = append(want, fmt.Sprintf("%016x-%04x", clk.timeIndex(), 2))
+	mustAdd(t, lg, "test-event", types.EventDataString("12"))
+	want = want[6:] // retain events since second 90
+	if diff := cmp.Diff(want, cursors(t, lg)); diff != "" {
+		t.Errorf("Cursors after pruning: (-want, +got)\n%s", diff)
+	}
+	if diff := cmp.Diff(want, cursors(t, open())); diff != "" {
+		t.Errorf("Cursors after pruning and reopening: (-want, +got)\n%s", diff)
+	}
+}
+
+func TestPersistentPruneSize(t *testing.T) {
+	const maxBytes = 2000
+
+	db := dbm.NewMemDB()
+	lg, err := eventlog.New(eventlog.LogSettings{
+		WindowSize: 1 * time.Minute,
+		MaxItems:   5,
+		MaxBytes:   maxBytes,
+		DB:         db,
+	})
+	if err != nil {
+		t.Fatalf("New unexpectedly failed: %v", err)
+	}
+
+	var pruned bool
+	for i := 0; i < 60; i++ {
+		err := lg.Add("test-event", types.EventDataString(strconv.Itoa(i+1)))
+		if errors.Is(err, eventlog.ErrLogPruned) {
+			pruned = true
+		} else if err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+
+		var size int
+		it, err := db.Iterator(nil, nil)
+		if err != nil {
+			t.Fatalf("Iterator failed: %v", err)
+		}
+		for ; it.Valid(); it.Next() {
+			size += len(it.Key()) + len(it.Value())
+		}
+		it.Close()
+		if size > maxBytes {
+			t.Errorf("After add %d: stored size is %d, want ≤ %d", i+1, size, maxBytes)
+		}
+	}
+	if !pruned {
+		t.Error("Add did not report pruning")
+	}
+
+	// The stored items remain available beyond the items in memory.
+	if got := len(cursors(t, lg)); got <= 5 {
+		t.Errorf("Scan: got %d items, want more than the 5 in memory", got)
+	}
+}
+
+func TestScanAfter(t *testing.T) {
+	for _, persist := range []bool{false, true} {
+		t.Run(fmt.Sprintf("persist=%v", persist), func(t *testing.T) {
+			clk := newFakeTime(0)
+			settings := eventlog.LogSettings{
+				WindowSize: 60 * time.Second,
+				Source:     cursor.Source{TimeIndex: clk.timeIndex},
+			}
+			if persist {
+				settings.DB = dbm.NewMemDB()
+			}
+			lg, err := eventlog.New(settings)
+			if err != nil {
+				t.Fatalf("New unexpectedly failed: %v", err)
+			}
+
+			// Add pairs of events at seconds 0, 20, ..., 180, so that the
+			// oldest ones are retained only by a persistent log, and the
+			// events of a pair share a time index.
+			for i := 1; i <= 10; i++ {
+				mustAdd(t, lg, "test-event", types.EventDataString(strconv.Itoa(i)))
+				mustAdd(t, lg, "test-event", types.EventDataString(strconv.Itoa(i)))
+				clk.advance(20 * time.Second)
+			}
+			all := cursors(t, lg)
+
+			scanAfter := func(c string, limit int) []string {
+				t.Helper()
+				var after cursor.Cursor
+				if err := after.UnmarshalText([]byte(c)); err != nil {
+					t.Fatalf("Invalid cursor: %v", err)
+				}
+				var got []string
+				if _, err := lg.ScanAfter(after, func(itm *eventlog.Item) error {
+					got = append(got, itm.Cursor.String())
+					if len(got) == limit {
+						return eventlog.ErrStopScan
+					}
+					return nil
+				}); err != nil {
+					t.Fatalf("ScanAfter failed: %v", err)
+				}
+				return got
+			}
+
+			if diff := cmp.Diff(all, scanAfter("", 0)); diff != "" {
+				t.Errorf("ScanAfter(zero): (-want, +got)\n%s", diff)
+			}
+			for i, c := range all {
+				if diff := cmp.Diff(all[i+1:], scanAfter(c, 0), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("ScanAfter(%s): (-want, +got)\n%s", c, diff)
+				}
+			}
+			if diff := cmp.Diff(all[1:3], scanAfter(all[0], 2)); diff != "" {
+				t.Errorf("ScanAfter(%s) with stop: (-want, +got)\n%s", all[0], diff)
+			}
+		})
+	}
+}
+
 // mustAdd adds a single event to lg. If Add reports an error other than for
 // pruning, the test fails; otherwise the error is returned.
 func mustAdd(t *testing.T, lg *eventlog.Log, etype string, data types.EventData) {
@@ -20,11 +20,25 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "num_items",
 			Help:      "Number of items currently resident in the event log.",
 		}, labels).With(labelsAndValues...),
+		numStoredItems: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "num_stored_items",
+			Help:      "Number of items stored on disk by a persistent event log.",
+		}, labels).With(labelsAndValues...),
+		storedBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "stored_bytes",
+			Help:      "Total size in bytes of the items stored on disk by a persistent event log.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		numItems: discard.NewGauge(),
+		numItems:       discard.NewGauge(),
+		numStoredItems: discard.NewGauge(),
+		storedBytes:    discard.NewGauge(),
 	}
 }
@@ -11,4 +11,11 @@ type Metrics struct {
 
 	// Number of items currently resident in the event log.
 	numItems metrics.Gauge
+
+	// Number of items stored on disk by a persistent event log.
+	numStoredItems metrics.Gauge
+
+	// Total size in bytes of the items stored on disk by a persistent event
+	// log.
+	storedBytes metrics.Gauge
 }
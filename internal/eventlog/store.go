@@ -0,0 +1,308 @@
+package eventlog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ari-anchor/sei-tendermint/internal/eventlog/cursor"
+	"github.com/ari-anchor/sei-tendermint/internal/jsontypes"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// The key of a stored item is itemKeyPrefix followed by the text of its
+// cursor, which is of fixed width, so that keys sort in the order of the
+// cursors. itemKeyEnd sorts after all item keys.
+const (
+	itemKeyPrefix = "item:"
+	itemKeyEnd    = "item;"
+)
+
+func itemKey(c cursor.Cursor) []byte { return []byte(itemKeyPrefix + c.String()) }
+
+// storedItem is the encoding of an item in the database. Its ABCI events are
+// derived from the data when the item is loaded.
+type storedItem struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// A store keeps the items of a log in a database, where they survive restarts
+// of the node and are retained beyond the window of the log in memory, up to
+// an age and a total size.
+type store struct {
+	// These values do not change after construction.
+	db        dbm.DB
+	retention time.Duration
+	maxBytes  int64
+	metrics   *Metrics
+
+	// Protects access to the fields below.
+	mu sync.Mutex
+
+	numItems int
+	numBytes int64
+	oldest   cursor.Cursor // cursor of the oldest stored item
+	newest   cursor.Cursor // cursor of the newest stored item
+}
+
+// openStore opens a store on db, and reads the size and bounds of its contents.
+func openStore(db dbm.DB, retention time.Duration, maxBytes int64, metrics *Metrics) (*store, error) {
+	s := &store{db: db, retention: retention, maxBytes: maxBytes, metrics: metrics}
+
+	start, end := itemKeyRange()
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		c, err := parseItemKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		if s.numItems == 0 {
+			s.oldest = c
+		}
+		s.newest = c
+		s.numItems++
+		s.numBytes += int64(len(it.Key()) + len(it.Value()))
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	s.updateMetrics()
+	return s, nil
+}
+
+// info returns the cursor of the oldest stored item and the number of items.
+func (s *store) info() (cursor.Cursor, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.oldest, s.numItems
+}
+
+// put adds itm to the store.
+func (s *store) put(itm *Item) error {
+	data, err := jsontypes.Marshal(itm.Data)
+	if err != nil {
+		return fmt.Errorf("encoding event data: %w", err)
+	}
+	value, err := json.Marshal(storedItem{Type: itm.Type, Data: data})
+	if err != nil {
+		return err
+	}
+	key := itemKey(itm.Cursor)
+	if err := s.db.Set(key, value); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.numItems == 0 {
+		s.oldest = itm.Cursor
+	}
+	s.newest = itm.Cursor
+	s.numItems++
+	s.numBytes += int64(len(key) + len(value))
+	s.updateMetrics()
+	return nil
+}
+
+// scan calls f with each stored item older than before, from the newest to
+// the oldest, until all items are visited or f reports an error. If before is
+// zero, all the stored items are visited.
+func (s *store) scan(before cursor.Cursor, f func(*Item) error) error {
+	start, end := itemKeyRange()
+	if !before.IsZero() {
+		end = itemKey(before)
+	}
+	it, err := s.db.ReverseIterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		itm, err := decodeItem(it.Key(), it.Value())
+		if err != nil {
+			return err
+		}
+		if err := f(itm); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// scanAfter calls f with each stored item newer than after and older than
+// before, from the oldest to the newest, until all items are visited or f
+// reports an error. A zero bound is ignored.
+func (s *store) scanAfter(after, before cursor.Cursor, f func(*Item) error) error {
+	start, end := itemKeyRange()
+	if !after.IsZero() {
+		start = itemKey(after)
+	}
+	if !before.IsZero() {
+		end = itemKey(before)
+	}
+	it, err := s.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		itm, err := decodeItem(it.Key(), it.Value())
+		if err != nil {
+			return err
+		}
+		if !after.Before(itm.Cursor) {
+			continue
+		}
+		if err := f(itm); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// load returns the newest stored items, from the newest to the oldest, that
+// are no older than window before the newest one, up to maxItems if it is
+// positive.
+func (s *store) load(window time.Duration, maxItems int) ([]*Item, error) {
+	var items []*Item
+	err := s.scan(cursor.Cursor{}, func(itm *Item) error {
+		if len(items) != 0 && items[0].Cursor.Diff(itm.Cursor) > window {
+			return ErrStopScan
+		}
+		items = append(items, itm)
+		if maxItems > 0 && len(items) >= maxItems {
+			return ErrStopScan
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, ErrStopScan) {
+		return nil, err
+	}
+	return items, nil
+}
+
+// prune deletes the stored items older than the retention period before the
+// newest item, then the oldest items in excess of the size limit. It reports
+// ErrLogPruned if it deleted items within the retention period.
+func (s *store) prune() error {
+	// To avoid pruning for every item, don't trigger an age prune until the
+	// oldest item is at least this far beyond the retention period.
+	const retentionSlop = 30 * time.Second
+
+	s.mu.Lock()
+	newest, curItems, curBytes := s.newest, s.numItems, s.numBytes
+	needed := newest.Diff(s.oldest) >= s.retention+retentionSlop ||
+		(s.maxBytes > 0 && curBytes > s.maxBytes)
+	s.mu.Unlock()
+	if !needed {
+		return nil
+	}
+
+	// Prune by size to a fraction of the limit, so that we only have to prune
+	// for size occasionally.
+	targetBytes := 3 * s.maxBytes / 4
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	var (
+		numItems  int // number of items pruned
+		numBytes  int64
+		oldest    cursor.Cursor
+		prunedErr error
+	)
+	start, end := itemKeyRange()
+	it, err := s.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	for ; it.Valid(); it.Next() {
+		c, err := parseItemKey(it.Key())
+		if err != nil {
+			it.Close()
+			return err
+		}
+		tooOld := newest.Diff(c) > s.retention
+		tooBig := s.maxBytes > 0 && curBytes-numBytes > targetBytes
+		if (!tooOld && !tooBig) || c == newest {
+			oldest = c
+			break
+		}
+		if !tooOld {
+			prunedErr = ErrLogPruned
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			it.Close()
+			return err
+		}
+		numItems++
+		numBytes += int64(len(it.Key()) + len(it.Value()))
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.numItems = curItems - numItems
+	s.numBytes = curBytes - numBytes
+	s.oldest = oldest
+	if s.numItems == 0 {
+		s.oldest, s.newest = cursor.Cursor{}, cursor.Cursor{}
+	}
+	s.updateMetrics()
+	return prunedErr
+}
+
+// updateMetrics updates the metrics for the size of the store. The caller
+// must hold s.mu.
+func (s *store) updateMetrics() {
+	s.metrics.numStoredItems.Set(float64(s.numItems))
+	s.metrics.storedBytes.Set(float64(s.numBytes))
+}
+
+// itemKeyRange returns the range of the keys of the stored items.
+func itemKeyRange() (start, end []byte) {
+	return []byte(itemKeyPrefix), []byte(itemKeyEnd)
+}
+
+func parseItemKey(key []byte) (cursor.Cursor, error) {
+	var c cursor.Cursor
+	if len(key) <= len(itemKeyPrefix) {
+		return c, fmt.Errorf("invalid item key %q", key)
+	}
+	if err := c.UnmarshalText(key[len(itemKeyPrefix):]); err != nil {
+		return c, fmt.Errorf("invalid item key %q: %w", key, err)
+	}
+	return c, nil
+}
+
+func decodeItem(key, value []byte) (*Item, error) {
+	c, err := parseItemKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var si storedItem
+	if err := json.Unmarshal(value, &si); err != nil {
+		return nil, fmt.Errorf("decoding item %v: %w", c, err)
+	}
+	var data types.EventData
+	if err := jsontypes.Unmarshal(si.Data, &data); err != nil {
+		return nil, fmt.Errorf("decoding event data of item %v: %w", c, err)
+	}
+	return newItem(c, si.Type, data), nil
+}
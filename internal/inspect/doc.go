@@ -11,6 +11,9 @@ inconsistent state so the node will not be able to start up again.
 
 The RPC endpoints provided by the Inspector type allow for a node operator to inspect
 the block store and state store to better understand what may have caused the inconsistent state.
+Besides a subset of the endpoints of a node, the Inspector serves the state at past heights,
+the history of the validators and consensus params, the last heights of the consensus WAL,
+and a diff of the state store against the block store.
 
 
 The Inspector type's lifecycle is controlled by a context.Context
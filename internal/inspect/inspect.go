@@ -41,12 +41,14 @@ type Inspector struct {
 // New returns an Inspector that serves RPC on the specified BlockStore and StateStore.
 // The Inspector type does not modify the state or block stores.
 // The sinks are used to enable block and transaction querying via the RPC server.
+// The consensus WAL at walFile is read to serve its last heights; if walFile is
+// empty, the WAL is not served.
 // The caller is responsible for starting and stopping the Inspector service.
-func New(cfg *config.RPCConfig, bs state.BlockStore, ss state.Store, es []indexer.EventSink, logger log.Logger) *Inspector {
+func New(cfg *config.RPCConfig, bs state.BlockStore, ss state.Store, es []indexer.EventSink, walFile string, logger log.Logger) *Inspector {
 	eb := eventbus.NewDefault(logger.With("module", "events"))
 
 	return &Inspector{
-		routes:   rpc.Routes(*cfg, ss, bs, es, logger),
+		routes:   rpc.Routes(*cfg, ss, bs, es, walFile, logger),
 		config:   cfg,
 		logger:   logger,
 		eventBus: eb,
@@ -78,7 +80,7 @@ func NewFromConfig(logger log.Logger, cfg *config.Config) (*Inspector, error) {
 		return nil, err
 	}
 	ss := state.NewStore(sDB)
-	return New(cfg.RPC, bs, ss, sinks, logger), nil
+	return New(cfg.RPC, bs, ss, sinks, cfg.Consensus.WalFile(), logger), nil
 }
 
 // Run starts the Inspector servers and blocks until the servers shut down. The passed
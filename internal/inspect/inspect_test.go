@@ -1,10 +1,13 @@
 package inspect_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
@@ -17,13 +20,18 @@ import (
 
 	abcitypes "github.com/ari-anchor/sei-tendermint/abci/types"
 	"github.com/ari-anchor/sei-tendermint/config"
+	"github.com/ari-anchor/sei-tendermint/crypto/merkle"
+	"github.com/ari-anchor/sei-tendermint/internal/consensus"
 	"github.com/ari-anchor/sei-tendermint/internal/inspect"
 	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query"
+	sm "github.com/ari-anchor/sei-tendermint/internal/state"
 	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
 	indexermocks "github.com/ari-anchor/sei-tendermint/internal/state/indexer/mocks"
 	statemocks "github.com/ari-anchor/sei-tendermint/internal/state/mocks"
 	"github.com/ari-anchor/sei-tendermint/libs/log"
 	httpclient "github.com/ari-anchor/sei-tendermint/rpc/client/http"
+	"github.com/ari-anchor/sei-tendermint/rpc/coretypes"
+	jsonrpcclient "github.com/ari-anchor/sei-tendermint/rpc/jsonrpc/client"
 	"github.com/ari-anchor/sei-tendermint/types"
 )
 
@@ -85,7 +93,7 @@ func TestBlock(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
@@ -132,7 +140,7 @@ func TestTxSearch(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
@@ -179,7 +187,7 @@ func TestTx(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
@@ -227,7 +235,7 @@ func TestConsensusParams(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
@@ -278,7 +286,7 @@ func TestBlockResults(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
@@ -326,7 +334,7 @@ func TestCommit(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
@@ -380,7 +388,7 @@ func TestBlockByHash(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
@@ -433,7 +441,7 @@ func TestBlockchain(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
@@ -486,7 +494,7 @@ func TestValidators(t *testing.T) {
 
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
@@ -545,7 +553,7 @@ func TestBlockSearch(t *testing.T) {
 		Return([]int64{testHeight}, nil)
 	rpcConfig := config.TestRPCConfig()
 	l := log.NewNopLogger()
-	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, l)
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, []indexer.EventSink{eventSinkMock}, "", l)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
@@ -580,6 +588,167 @@ func TestBlockSearch(t *testing.T) {
 	stateStoreMock.AssertExpectations(t)
 }
 
+func TestState(t *testing.T) {
+	vals, _ := types.RandValidatorSet(2, 10)
+	params := *types.DefaultConsensusParams()
+	meta := &types.BlockMeta{
+		BlockID: types.BlockID{Hash: []byte("hash1")},
+		Header:  types.Header{Height: 1, Time: time.Now().UTC()},
+	}
+	stateStoreMock := &statemocks.Store{}
+	stateStoreMock.On("Load").Return(sm.State{
+		ChainID:         "test",
+		InitialHeight:   1,
+		LastBlockHeight: 2,
+		Validators:      vals,
+		NextValidators:  vals,
+		LastValidators:  vals,
+		ConsensusParams: params,
+		AppHash:         []byte("apphash2"),
+	}, nil)
+	stateStoreMock.On("LoadValidators", mock.Anything).Return(vals, nil)
+	stateStoreMock.On("LoadConsensusParams", int64(2)).Return(params, nil)
+	stateStoreMock.On("LoadFinalizeBlockResponses", int64(1)).Return(&abcitypes.ResponseFinalizeBlock{
+		AppHash: []byte("apphash1"),
+	}, nil)
+	blockStoreMock := &statemocks.BlockStore{}
+	blockStoreMock.On("LoadBlockMeta", int64(1)).Return(meta)
+
+	cli, ctx := runInspector(t, blockStoreMock, stateStoreMock, "")
+
+	var res coretypes.ResultState
+	require.NoError(t, cli.Call(ctx, "state", map[string]interface{}{}, &res))
+	require.True(t, res.Latest)
+	require.Equal(t, int64(2), res.LastBlockHeight)
+	require.Equal(t, "test", res.ChainID)
+
+	res = coretypes.ResultState{}
+	require.NoError(t, cli.Call(ctx, "state", map[string]interface{}{"height": "1"}, &res))
+	require.False(t, res.Latest)
+	require.Equal(t, int64(1), res.LastBlockHeight)
+	require.Equal(t, meta.BlockID, res.LastBlockID)
+	require.Equal(t, "test", res.ChainID)
+	require.Equal(t, []byte("apphash1"), []byte(res.AppHash))
+	require.Equal(t, merkle.HashFromByteSlices(nil), []byte(res.LastResultsHash))
+	require.Equal(t, vals.Hash(), res.Validators.Hash())
+
+	err := cli.Call(ctx, "state", map[string]interface{}{"height": "3"}, &res)
+	require.Error(t, err)
+
+	stateStoreMock.AssertExpectations(t)
+	blockStoreMock.AssertExpectations(t)
+}
+
+func TestStoreDiff(t *testing.T) {
+	vals, _ := types.RandValidatorSet(2, 10)
+	params := *types.DefaultConsensusParams()
+	header := func(height int64, appHash []byte) types.Header {
+		return types.Header{
+			Height:             height,
+			ValidatorsHash:     vals.Hash(),
+			NextValidatorsHash: vals.Hash(),
+			ConsensusHash:      params.HashConsensusParams(),
+			AppHash:            appHash,
+			LastResultsHash:    merkle.HashFromByteSlices(nil),
+		}
+	}
+	meta1 := &types.BlockMeta{BlockID: types.BlockID{Hash: []byte("hash1")}, Header: header(1, nil)}
+	meta2 := &types.BlockMeta{BlockID: types.BlockID{Hash: []byte("hash2")}, Header: header(2, []byte("wrong"))}
+
+	stateStoreMock := &statemocks.Store{}
+	stateStoreMock.On("Load").Return(sm.State{
+		InitialHeight:   1,
+		LastBlockHeight: 2,
+		LastBlockID:     meta2.BlockID,
+	}, nil)
+	stateStoreMock.On("LoadValidators", mock.Anything).Return(vals, nil)
+	stateStoreMock.On("LoadConsensusParams", mock.Anything).Return(params, nil)
+	stateStoreMock.On("LoadFinalizeBlockResponses", int64(1)).Return(&abcitypes.ResponseFinalizeBlock{
+		AppHash: []byte("apphash1"),
+	}, nil)
+	blockStoreMock := &statemocks.BlockStore{}
+	blockStoreMock.On("Base").Return(int64(1))
+	blockStoreMock.On("Height").Return(int64(2))
+	blockStoreMock.On("LoadBlockMeta", int64(1)).Return(meta1)
+	blockStoreMock.On("LoadBlockMeta", int64(2)).Return(meta2)
+	blockStoreMock.On("LoadBlockMeta", int64(3)).Return((*types.BlockMeta)(nil))
+
+	cli, ctx := runInspector(t, blockStoreMock, stateStoreMock, "")
+
+	var res coretypes.ResultStoreDiff
+	require.NoError(t, cli.Call(ctx, "store_diff", map[string]interface{}{}, &res))
+	require.Equal(t, int64(1), res.MinHeight)
+	require.Equal(t, int64(2), res.MaxHeight)
+	require.Equal(t, []coretypes.StoreMismatch{{
+		Height:     2,
+		Field:      "app_hash",
+		StateStore: fmt.Sprintf("%X", "apphash1"),
+		BlockStore: fmt.Sprintf("%X", "wrong"),
+	}}, res.Mismatches)
+
+	stateStoreMock.AssertExpectations(t)
+	blockStoreMock.AssertExpectations(t)
+}
+
+func TestWAL(t *testing.T) {
+	walFile := filepath.Join(t.TempDir(), "wal")
+	buf := new(bytes.Buffer)
+	enc := consensus.NewWALEncoder(buf)
+	for _, msg := range []consensus.WALMessage{
+		consensus.EndHeightMessage{Height: 0},
+		types.EventDataRoundState{Height: 1, Step: "RoundStepNewHeight"},
+		consensus.EndHeightMessage{Height: 1},
+		types.EventDataRoundState{Height: 2, Step: "RoundStepNewHeight"},
+	} {
+		require.NoError(t, enc.Encode(&consensus.TimedWALMessage{Time: time.Now(), Msg: msg}))
+	}
+	require.NoError(t, os.WriteFile(walFile, buf.Bytes(), 0600))
+
+	cli, ctx := runInspector(t, &statemocks.BlockStore{}, &statemocks.Store{}, walFile)
+
+	var res coretypes.ResultWAL
+	require.NoError(t, cli.Call(ctx, "wal", map[string]interface{}{}, &res))
+	require.Empty(t, res.Error)
+	require.Len(t, res.Messages, 2)
+	var msg struct {
+		Msg struct {
+			Type string `json:"type"`
+		} `json:"msg"`
+	}
+	require.NoError(t, json.Unmarshal(res.Messages[0], &msg))
+	require.Equal(t, "tendermint/wal/EndHeightMessage", msg.Msg.Type)
+
+	res = coretypes.ResultWAL{}
+	require.NoError(t, cli.Call(ctx, "wal", map[string]interface{}{"heights": "5"}, &res))
+	require.Len(t, res.Messages, 4)
+}
+
+// runInspector runs an Inspector on the given stores until the test ends, and
+// returns a client of its RPC server.
+func runInspector(t *testing.T, bs sm.BlockStore, ss sm.Store, walFile string) (*jsonrpcclient.Client, context.Context) {
+	t.Helper()
+	eventSinkMock := &indexermocks.EventSink{}
+	eventSinkMock.On("Stop").Return(nil)
+	eventSinkMock.On("Type").Return(indexer.EventSinkType("Mock"))
+
+	rpcConfig := config.TestRPCConfig()
+	d := inspect.New(rpcConfig, bs, ss, []indexer.EventSink{eventSinkMock}, walFile, log.NewNopLogger())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, d.Run(ctx))
+	}()
+	t.Cleanup(func() { cancel(); wg.Wait() })
+
+	requireConnect(t, rpcConfig.ListenAddress, 20)
+	cli, err := jsonrpcclient.New(rpcConfig.ListenAddress)
+	require.NoError(t, err)
+	return cli, ctx
+}
+
 func requireConnect(t testing.TB, addr string, retries int) {
 	parts := strings.SplitN(addr, "://", 2)
 	if len(parts) != 2 {
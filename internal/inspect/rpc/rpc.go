@@ -28,8 +28,12 @@ type eventBusUnsubscriber interface {
 	UnsubscribeAll(ctx context.Context, subscriber string) error
 }
 
-// Routes returns the set of routes used by the Inspector server.
-func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, es []indexer.EventSink, logger log.Logger) core.RoutesMap {
+// Routes returns the set of routes used by the Inspector server. Besides a
+// subset of the routes of a node, the Inspector serves routes to examine the
+// state at past heights, the history of the validators and consensus params,
+// the WAL at walFile, and the inconsistencies between the state and block
+// stores. If walFile is empty, the WAL is not available.
+func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, es []indexer.EventSink, walFile string, logger log.Logger) core.RoutesMap {
 	env := &core.Environment{
 		Config:     cfg,
 		EventSinks: es,
@@ -37,6 +41,11 @@ func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, es []index
 		BlockStore: bs,
 		Logger:     logger,
 	}
+	ienv := &inspectEnvironment{
+		stateStore: s,
+		blockStore: bs,
+		walFile:    walFile,
+	}
 	return core.RoutesMap{
 		"blockchain":       server.NewRPCFunc(env.BlockchainInfo),
 		"consensus_params": server.NewRPCFunc(env.ConsensusParams),
@@ -48,6 +57,13 @@ func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, es []index
 		"tx":               server.NewRPCFunc(env.Tx),
 		"tx_search":        server.NewRPCFunc(env.TxSearch),
 		"block_search":     server.NewRPCFunc(env.BlockSearch),
+
+		"state":                    server.NewRPCFunc(ienv.State),
+		"finalize_block_responses": server.NewRPCFunc(ienv.FinalizeBlockResponses),
+		"validators_history":       server.NewRPCFunc(ienv.ValidatorsHistory),
+		"consensus_params_history": server.NewRPCFunc(ienv.ConsensusParamsHistory),
+		"wal":                      server.NewRPCFunc(ienv.WAL),
+		"store_diff":               server.NewRPCFunc(ienv.StoreDiff),
 	}
 }
 
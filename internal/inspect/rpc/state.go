@@ -0,0 +1,415 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/crypto/merkle"
+	"github.com/ari-anchor/sei-tendermint/internal/consensus"
+	"github.com/ari-anchor/sei-tendermint/internal/jsontypes"
+	"github.com/ari-anchor/sei-tendermint/internal/state"
+	tmmath "github.com/ari-anchor/sei-tendermint/libs/math"
+	"github.com/ari-anchor/sei-tendermint/rpc/coretypes"
+	"github.com/ari-anchor/sei-tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/version"
+)
+
+const (
+	// maxHistoryHeights is the maximum number of heights covered by a request
+	// for the history of the validators or consensus params, or for a diff
+	// of the stores.
+	maxHistoryHeights = 100
+
+	// maxWALHeights is the maximum number of heights of the WAL returned.
+	maxWALHeights = 10
+)
+
+// inspectEnvironment serves the routes that only the Inspector provides, which
+// examine the stores and the WAL of a failed node in more detail than the
+// routes of the node.
+type inspectEnvironment struct {
+	stateStore state.Store
+	blockStore state.BlockStore
+	walFile    string
+}
+
+// State returns the consensus state after the block at the given height was
+// committed. The latest state is loaded from the state store, and the states
+// at earlier heights retained by the block store are reconstructed from the
+// stores. If the height is omitted, the latest state is returned.
+func (env *inspectEnvironment) State(ctx context.Context, req *coretypes.RequestBlockInfo) (*coretypes.ResultState, error) {
+	latest, err := env.stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	if latest.IsEmpty() {
+		return nil, errors.New("no state found")
+	}
+	if req.Height == nil || int64(*req.Height) == latest.LastBlockHeight {
+		return &coretypes.ResultState{
+			Version:                          latest.Version.Consensus,
+			Software:                         latest.Version.Software,
+			ChainID:                          latest.ChainID,
+			InitialHeight:                    latest.InitialHeight,
+			LastBlockHeight:                  latest.LastBlockHeight,
+			LastBlockID:                      latest.LastBlockID,
+			LastBlockTime:                    latest.LastBlockTime,
+			NextValidators:                   latest.NextValidators,
+			Validators:                       latest.Validators,
+			LastValidators:                   latest.LastValidators,
+			ConsensusParams:                  latest.ConsensusParams,
+			LastHeightValidatorsChanged:      latest.LastHeightValidatorsChanged,
+			LastHeightConsensusParamsChanged: latest.LastHeightConsensusParamsChanged,
+			LastResultsHash:                  latest.LastResultsHash,
+			AppHash:                          latest.AppHash,
+			Latest:                           true,
+		}, nil
+	}
+
+	height := int64(*req.Height)
+	if height <= 0 {
+		return nil, fmt.Errorf("%w (requested height: %d)", coretypes.ErrZeroOrNegativeHeight, height)
+	}
+	if height > latest.LastBlockHeight {
+		return nil, fmt.Errorf("%w (requested height: %d, state height: %d)",
+			coretypes.ErrHeightExceedsChainHead, height, latest.LastBlockHeight)
+	}
+	meta := env.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("%w (requested height: %d, base height: %d)",
+			coretypes.ErrHeightNotAvailable, height, env.blockStore.Base())
+	}
+
+	// The validators of a block are saved at its height, so the validators
+	// of the next block after the one at height are saved at height+1, and
+	// so on. Likewise, the consensus params of the next block are saved at
+	// height+1.
+	lastValidators, err := env.stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := env.stateStore.LoadValidators(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	nextValidators, err := env.stateStore.LoadValidators(height + 2)
+	if err != nil {
+		return nil, err
+	}
+	params, err := env.stateStore.LoadConsensusParams(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := env.stateStore.LoadFinalizeBlockResponses(height)
+	if err != nil {
+		return nil, err
+	}
+	lastResultsHash, err := resultsHash(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultState{
+		Version: version.Consensus{
+			Block: meta.Header.Version.Block,
+			App:   params.Version.AppVersion,
+		},
+		ChainID:         latest.ChainID,
+		InitialHeight:   latest.InitialHeight,
+		LastBlockHeight: height,
+		LastBlockID:     meta.BlockID,
+		LastBlockTime:   meta.Header.Time,
+		NextValidators:  nextValidators,
+		Validators:      validators,
+		LastValidators:  lastValidators,
+		ConsensusParams: params,
+		LastResultsHash: lastResultsHash,
+		AppHash:         resp.AppHash,
+	}, nil
+}
+
+// FinalizeBlockResponses returns the responses of the application to
+// FinalizeBlock for the block at the given height, as saved in the state
+// store. If the height is omitted, the responses for the latest block of the
+// state are returned.
+func (env *inspectEnvironment) FinalizeBlockResponses(ctx context.Context, req *coretypes.RequestBlockInfo) (*coretypes.ResultFinalizeBlockResponse, error) {
+	var height int64
+	if req.Height != nil {
+		height = int64(*req.Height)
+	} else {
+		latest, err := env.stateStore.Load()
+		if err != nil {
+			return nil, err
+		}
+		height = latest.LastBlockHeight
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("%w (requested height: %d)", coretypes.ErrZeroOrNegativeHeight, height)
+	}
+
+	resp, err := env.stateStore.LoadFinalizeBlockResponses(height)
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultFinalizeBlockResponse{Height: height, Response: resp}, nil
+}
+
+// ValidatorsHistory returns the validator set at the minimum height of the
+// requested range, followed by each change of the validator set within the
+// range. The range ends by default at the height of the next block after the
+// state, and covers at most maxHistoryHeights heights.
+func (env *inspectEnvironment) ValidatorsHistory(ctx context.Context, req *coretypes.RequestBlockchainInfo) (*coretypes.ResultValidatorSetHistory, error) {
+	latest, err := env.stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	minHeight, maxHeight, err := env.historyRange(latest.LastBlockHeight+1, int64(req.MinHeight), int64(req.MaxHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &coretypes.ResultValidatorSetHistory{Changes: []coretypes.ValidatorSetChange{}}
+	var lastHash []byte
+	for h := minHeight; h <= maxHeight; h++ {
+		vals, err := env.stateStore.LoadValidators(h)
+		if err != nil {
+			return nil, err
+		}
+		hash := vals.Hash()
+		if len(res.Changes) == 0 || !bytes.Equal(hash, lastHash) {
+			res.Changes = append(res.Changes, coretypes.ValidatorSetChange{
+				Height:     h,
+				Hash:       hash,
+				Validators: vals,
+			})
+		}
+		lastHash = hash
+	}
+	return res, nil
+}
+
+// ConsensusParamsHistory returns the consensus params at the minimum height
+// of the requested range, followed by each change of the consensus params
+// within the range. The range ends by default at the height of the next block
+// after the state, and covers at most maxHistoryHeights heights.
+func (env *inspectEnvironment) ConsensusParamsHistory(ctx context.Context, req *coretypes.RequestBlockchainInfo) (*coretypes.ResultConsensusParamsHistory, error) {
+	latest, err := env.stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	minHeight, maxHeight, err := env.historyRange(latest.LastBlockHeight+1, int64(req.MinHeight), int64(req.MaxHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &coretypes.ResultConsensusParamsHistory{Changes: []coretypes.ConsensusParamsChange{}}
+	var lastHash []byte
+	for h := minHeight; h <= maxHeight; h++ {
+		params, err := env.stateStore.LoadConsensusParams(h)
+		if err != nil {
+			return nil, err
+		}
+		hash := params.HashConsensusParams()
+		if len(res.Changes) == 0 || !bytes.Equal(hash, lastHash) {
+			res.Changes = append(res.Changes, coretypes.ConsensusParamsChange{
+				Height:          h,
+				Hash:            hash,
+				ConsensusParams: params,
+			})
+		}
+		lastHash = hash
+	}
+	return res, nil
+}
+
+// WAL returns the messages of the consensus WAL for the requested number of
+// last heights, up to maxWALHeights. The height in progress when the node
+// stopped counts as one of them. If the WAL is corrupted, the messages that
+// could be read are returned with the corruption error.
+func (env *inspectEnvironment) WAL(ctx context.Context, req *coretypes.RequestWAL) (*coretypes.ResultWAL, error) {
+	if env.walFile == "" {
+		return nil, errors.New("the WAL file is not configured")
+	}
+	heights := int64(1)
+	if req.Heights != nil {
+		heights = int64(*req.Heights)
+	}
+	if heights <= 0 {
+		return nil, fmt.Errorf("%w: heights must be positive", coretypes.ErrInvalidRequest)
+	}
+	heights = tmmath.MinInt64(heights, maxWALHeights)
+
+	msgs, err := consensus.LoadWALTail(env.walFile, int(heights))
+	if err != nil && !consensus.IsDataCorruptionError(err) {
+		return nil, err
+	}
+	res := &coretypes.ResultWAL{Messages: make([]json.RawMessage, 0, len(msgs))}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	for _, msg := range msgs {
+		bz, err := marshalWALMessage(msg)
+		if err != nil {
+			return nil, err
+		}
+		res.Messages = append(res.Messages, bz)
+	}
+	return res, nil
+}
+
+// marshalWALMessage encodes msg as JSON, with the type tag of its content.
+func marshalWALMessage(msg *consensus.TimedWALMessage) (json.RawMessage, error) {
+	tagged, ok := msg.Msg.(jsontypes.Tagged)
+	if !ok {
+		return nil, fmt.Errorf("unexpected WAL message type %T", msg.Msg)
+	}
+	content, err := jsontypes.Marshal(tagged)
+	if err != nil {
+		return nil, fmt.Errorf("encoding WAL message: %w", err)
+	}
+	return json.Marshal(struct {
+		Time time.Time       `json:"time"`
+		Msg  json.RawMessage `json:"msg"`
+	}{msg.Time, content})
+}
+
+// StoreDiff compares the state store to the block store within the requested
+// range of block heights, and reports each value of the headers of the blocks
+// that differs from the one derived from the state store: the hashes of the
+// validators and of the consensus params of a block, and the app hash and the
+// results hash of the previous block. It also compares the latest state to
+// the last blocks of the block store. The range ends by default at the height
+// of the block store, and covers at most maxHistoryHeights heights.
+func (env *inspectEnvironment) StoreDiff(ctx context.Context, req *coretypes.RequestBlockchainInfo) (*coretypes.ResultStoreDiff, error) {
+	latest, err := env.stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	res := &coretypes.ResultStoreDiff{
+		StateHeight:      latest.LastBlockHeight,
+		BlockStoreBase:   env.blockStore.Base(),
+		BlockStoreHeight: env.blockStore.Height(),
+		Mismatches:       []coretypes.StoreMismatch{},
+	}
+	check := func(height int64, field, stateStore, blockStore string) {
+		if stateStore != blockStore {
+			res.Mismatches = append(res.Mismatches, coretypes.StoreMismatch{
+				Height:     height,
+				Field:      field,
+				StateStore: stateStore,
+				BlockStore: blockStore,
+			})
+		}
+	}
+
+	// The block store is saved before the state store, so it may hold one
+	// block more than the state if the node stopped in between.
+	if res.BlockStoreHeight != latest.LastBlockHeight && res.BlockStoreHeight != latest.LastBlockHeight+1 {
+		check(latest.LastBlockHeight, "last_block_height",
+			fmt.Sprint(latest.LastBlockHeight), fmt.Sprint(res.BlockStoreHeight))
+	}
+	if meta := env.blockStore.LoadBlockMeta(latest.LastBlockHeight); meta != nil {
+		check(latest.LastBlockHeight, "last_block_id", latest.LastBlockID.String(), meta.BlockID.String())
+	}
+	if meta := env.blockStore.LoadBlockMeta(latest.LastBlockHeight + 1); meta != nil {
+		check(meta.Header.Height, "app_hash", fmt.Sprintf("%X", latest.AppHash), meta.Header.AppHash.String())
+		check(meta.Header.Height, "last_results_hash", fmt.Sprintf("%X", latest.LastResultsHash), meta.Header.LastResultsHash.String())
+	}
+
+	if res.BlockStoreHeight == 0 {
+		return res, nil
+	}
+	minHeight, maxHeight, err := env.historyRange(res.BlockStoreHeight, int64(req.MinHeight), int64(req.MaxHeight))
+	if err != nil {
+		return nil, err
+	}
+	res.MinHeight, res.MaxHeight = minHeight, maxHeight
+
+	for h := minHeight; h <= maxHeight; h++ {
+		meta := env.blockStore.LoadBlockMeta(h)
+		if meta == nil {
+			check(h, "block", "", "block not found")
+			continue
+		}
+		header := meta.Header
+
+		vals, err := env.stateStore.LoadValidators(h)
+		check(h, "validators_hash", validatorsHash(vals, err), header.ValidatorsHash.String())
+		nextVals, err := env.stateStore.LoadValidators(h + 1)
+		check(h, "next_validators_hash", validatorsHash(nextVals, err), header.NextValidatorsHash.String())
+		params, err := env.stateStore.LoadConsensusParams(h)
+		if err != nil {
+			check(h, "consensus_hash", err.Error(), header.ConsensusHash.String())
+		} else {
+			check(h, "consensus_hash", fmt.Sprintf("%X", params.HashConsensusParams()), header.ConsensusHash.String())
+		}
+
+		// The app hash and the results hash of a block are those of the
+		// previous block, which do not exist for the initial block. The
+		// responses may also have been discarded by the node.
+		if h <= latest.InitialHeight {
+			continue
+		}
+		resp, err := env.stateStore.LoadFinalizeBlockResponses(h - 1)
+		if errors.As(err, &state.ErrNoFinalizeBlockResponsesForHeight{}) {
+			continue
+		} else if err != nil {
+			check(h, "app_hash", err.Error(), header.AppHash.String())
+			continue
+		}
+		check(h, "app_hash", fmt.Sprintf("%X", resp.AppHash), header.AppHash.String())
+		if hash, err := resultsHash(resp); err != nil {
+			check(h, "last_results_hash", err.Error(), header.LastResultsHash.String())
+		} else {
+			check(h, "last_results_hash", fmt.Sprintf("%X", hash), header.LastResultsHash.String())
+		}
+	}
+	return res, nil
+}
+
+// historyRange returns the range of heights for a request with the given
+// bounds, which are zero if omitted, ending at most at height and starting at
+// the base of the block store. It covers at most maxHistoryHeights heights,
+// the last ones of the range if the minimum height is omitted.
+func (env *inspectEnvironment) historyRange(height, minHeight, maxHeight int64) (int64, int64, error) {
+	if minHeight < 0 || maxHeight < 0 {
+		return 0, 0, coretypes.ErrZeroOrNegativeHeight
+	}
+	if maxHeight == 0 || maxHeight > height {
+		maxHeight = height
+	}
+	if minHeight == 0 {
+		minHeight = maxHeight - maxHistoryHeights + 1
+	}
+	minHeight = tmmath.MaxInt64(minHeight, tmmath.MaxInt64(env.blockStore.Base(), 1))
+	maxHeight = tmmath.MinInt64(maxHeight, minHeight+maxHistoryHeights-1)
+	if minHeight > maxHeight {
+		return 0, 0, fmt.Errorf("%w: min height %d can't be greater than max height %d",
+			coretypes.ErrInvalidRequest, minHeight, maxHeight)
+	}
+	return minHeight, maxHeight, nil
+}
+
+// resultsHash returns the hash of the results of the transactions in resp, as
+// recorded in the header of the next block.
+func resultsHash(resp *abci.ResponseFinalizeBlock) ([]byte, error) {
+	rs, err := abci.MarshalTxResults(resp.TxResults)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling tx results: %w", err)
+	}
+	return merkle.HashFromByteSlices(rs), nil
+}
+
+// validatorsHash returns the hash of vals as recorded in a header, or the
+// error loading them.
+func validatorsHash(vals *types.ValidatorSet, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("%X", vals.Hash())
+}
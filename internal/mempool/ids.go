@@ -10,8 +10,8 @@ import (
 type IDs struct {
 	mtx       sync.RWMutex
 	peerMap   map[types.NodeID]uint16
-	nextID    uint16              // assumes that a node will never have over 65536 active peers
-	activeIDs map[uint16]struct{} // used to check if a given peerID key is used
+	nextID    uint16                  // assumes that a node will never have over 65536 active peers
+	activeIDs map[uint16]types.NodeID // used to check if a given peerID key is used
 }
 
 func NewMempoolIDs() *IDs {
@@ -19,7 +19,7 @@ func NewMempoolIDs() *IDs {
 		peerMap: make(map[types.NodeID]uint16),
 
 		// reserve UnknownPeerID for mempoolReactor.BroadcastTx
-		activeIDs: map[uint16]struct{}{UnknownPeerID: {}},
+		activeIDs: map[uint16]types.NodeID{UnknownPeerID: ""},
 		nextID:    1,
 	}
 }
@@ -37,7 +37,7 @@ func (ids *IDs) ReserveForPeer(peerID types.NodeID) {
 
 	curID := ids.nextPeerID()
 	ids.peerMap[peerID] = curID
-	ids.activeIDs[curID] = struct{}{}
+	ids.activeIDs[curID] = peerID
 }
 
 // Reclaim returns the ID reserved for the peer back to unused pool.
@@ -63,6 +63,26 @@ func (ids *IDs) GetForPeer(peerID types.NodeID) uint16 {
 	return ids.peerMap[peerID]
 }
 
+// LookupPeer returns the ID reserved for the peer, and false if no ID is
+// reserved for it.
+func (ids *IDs) LookupPeer(peerID types.NodeID) (uint16, bool) {
+	ids.mtx.RLock()
+	defer ids.mtx.RUnlock()
+
+	id, ok := ids.peerMap[peerID]
+	return id, ok
+}
+
+// GetNodeID returns the peer the given ID is reserved for, and false if the ID
+// is not reserved for a peer.
+func (ids *IDs) GetNodeID(id uint16) (types.NodeID, bool) {
+	ids.mtx.RLock()
+	defer ids.mtx.RUnlock()
+
+	peerID, ok := ids.activeIDs[id]
+	return peerID, ok && id != UnknownPeerID
+}
+
 // nextPeerID returns the next unused peer ID to use. We assume that the mutex
 // is already held.
 func (ids *IDs) nextPeerID() uint16 {
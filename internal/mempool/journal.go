@@ -0,0 +1,234 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+
+	tmmempool "github.com/ari-anchor/sei-tendermint/proto/tendermint/mempool"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// txJournal records the transactions admitted to the mempool in a database,
+// keyed by transaction hash, so the mempool can be restored after a restart.
+// Changes to the journal are buffered, and written to the database in a single
+// batch by flush, so that admitting and removing transactions does not wait on
+// the database.
+type txJournal struct {
+	db  dbm.DB
+	ids *IDs
+
+	mtx     sync.Mutex
+	pending map[types.TxKey]*tmmempool.JournalEntry // nil entries are deleted
+}
+
+// WithJournal sets the database in which the mempool journals its
+// transactions. See TxMempool.RestoreJournal.
+func WithJournal(db dbm.DB) TxMempoolOption {
+	return func(txmp *TxMempool) {
+		txmp.journal = &txJournal{
+			db:      db,
+			ids:     txmp.ids,
+			pending: make(map[types.TxKey]*tmmempool.JournalEntry),
+		}
+	}
+}
+
+// add records wtx in the journal, replacing its previous entry if any.
+func (j *txJournal) add(wtx *WrappedTx) {
+	entry := &tmmempool.JournalEntry{
+		Tx:        wtx.tx,
+		Priority:  wtx.priority,
+		Sender:    wtx.sender,
+		Timestamp: wtx.timestamp,
+		Height:    wtx.height,
+	}
+	for peer := range wtx.peers {
+		if nodeID, ok := j.ids.GetNodeID(peer); ok {
+			entry.Peers = append(entry.Peers, string(nodeID))
+		}
+	}
+	sort.Strings(entry.Peers)
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	j.pending[wtx.hash] = entry
+}
+
+// remove deletes wtx from the journal.
+func (j *txJournal) remove(wtx *WrappedTx) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	j.pending[wtx.hash] = nil
+}
+
+// flush writes the changes to the journal since the last flush to the
+// database.
+func (j *txJournal) flush() error {
+	j.mtx.Lock()
+	pending := j.pending
+	j.pending = make(map[types.TxKey]*tmmempool.JournalEntry)
+	j.mtx.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	batch := j.db.NewBatch()
+	defer batch.Close()
+
+	for key, entry := range pending {
+		key := key // the batch retains the key slices
+		if entry == nil {
+			if err := batch.Delete(key[:]); err != nil {
+				return err
+			}
+			continue
+		}
+		bz, err := entry.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(key[:], bz); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// FlushJournal writes the pending changes to the mempool journal to its
+// database. The mempool flushes its journal on every Update, so it only needs
+// to be called before the database is closed. It is a no-op unless the mempool
+// was created WithJournal.
+func (txmp *TxMempool) FlushJournal() error {
+	if txmp.journal == nil {
+		return nil
+	}
+	return txmp.journal.flush()
+}
+
+// load returns the entries of the journal ordered by their admission time.
+func (j *txJournal) load() ([]*tmmempool.JournalEntry, error) {
+	iter, err := j.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var entries []*tmmempool.JournalEntry
+	for ; iter.Valid(); iter.Next() {
+		entry := new(tmmempool.JournalEntry)
+		if err := entry.Unmarshal(iter.Value()); err != nil {
+			return nil, fmt.Errorf("decoding journal entry %X: %w", iter.Key(), err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, k int) bool {
+		return entries[i].Timestamp.Before(entries[k].Timestamp)
+	})
+	return entries, nil
+}
+
+// discard deletes the given entries from the journal.
+func (j *txJournal) discard(entries []*tmmempool.JournalEntry) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	for _, entry := range entries {
+		j.pending[types.Tx(entry.Tx).Key()] = nil
+	}
+}
+
+// RestoreJournal replays the transactions recorded in the mempool journal
+// through CheckTx, as of their original admission time and height. Entries
+// that exceed the TTLDuration or TTLNumBlocks limits at the given block height
+// are discarded. The transactions are attributed again to the peers they were
+// received from that are connected, so they are not sent back to them. It is a
+// no-op unless the mempool was created WithJournal.
+//
+// NOTE:
+//   - RestoreJournal must be called before the mempool receives transactions,
+//     once the application has caught up with the given height.
+func (txmp *TxMempool) RestoreJournal(ctx context.Context, height int64) error {
+	if txmp.journal == nil {
+		return nil
+	}
+
+	entries, err := txmp.journal.load()
+	if err != nil {
+		return fmt.Errorf("loading mempool journal: %w", err)
+	}
+
+	txmp.mtx.RLock()
+	defer txmp.mtx.RUnlock()
+
+	// The transactions admitted again are journaled anew, replacing their
+	// entries, while the others are discarded.
+	var discarded []*tmmempool.JournalEntry
+	var expired int
+	now := time.Now()
+	for _, entry := range entries {
+		if txmp.config.TTLDuration > 0 && now.Sub(entry.Timestamp) > txmp.config.TTLDuration ||
+			txmp.config.TTLNumBlocks > 0 && height-entry.Height > txmp.config.TTLNumBlocks {
+			discarded = append(discarded, entry)
+			expired++
+			continue
+		}
+
+		// IDs are not reserved for the peers that are not connected, since
+		// nothing would reclaim them if the peers never reconnected.
+		var peers []uint16
+		for _, peer := range entry.Peers {
+			if id, ok := txmp.ids.LookupPeer(types.NodeID(peer)); ok {
+				peers = append(peers, id)
+			}
+		}
+		txInfo := TxInfo{SenderID: UnknownPeerID}
+		if len(peers) > 0 {
+			txInfo.SenderID = peers[0]
+		}
+
+		err := txmp.checkTx(ctx, entry.Tx, nil, txInfo, entry.Timestamp, entry.Height)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			txmp.logger.Debug(
+				"failed to restore journaled transaction",
+				"tx", fmt.Sprintf("%X", types.Tx(entry.Tx).Hash()),
+				"err", err,
+			)
+		}
+		key := types.Tx(entry.Tx).Key()
+		if txmp.txStore.GetTxByHash(key) == nil {
+			discarded = append(discarded, entry)
+			continue
+		}
+		// The transaction is attributed to the first peer, as when it was
+		// admitted, and the others are recorded so it is not sent back to them.
+		for i := 1; i < len(peers); i++ {
+			txmp.txStore.GetOrSetPeerByTxHash(key, peers[i])
+		}
+	}
+
+	txmp.journal.discard(discarded)
+	if err := txmp.journal.flush(); err != nil {
+		return fmt.Errorf("writing mempool journal: %w", err)
+	}
+
+	txmp.logger.Info(
+		"restored mempool journal",
+		"entries", len(entries),
+		"restored", len(entries)-len(discarded),
+		"expired", expired,
+	)
+	return nil
+}
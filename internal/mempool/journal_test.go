@@ -0,0 +1,147 @@
+package mempool
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	abciclient "github.com/ari-anchor/sei-tendermint/abci/client"
+	"github.com/ari-anchor/sei-tendermint/abci/example/kvstore"
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+func TestTxMempool_RestoreJournal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := abciclient.NewLocalClient(log.NewNopLogger(), &application{Application: kvstore.NewApplication()})
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	db := dbm.NewMemDB()
+	txmp := setup(t, client, 100, WithJournal(db))
+	peer := types.NodeID("0011223344556677889900112233445566778899")
+	txmp.ids.ReserveForPeer(peer)
+
+	txs := types.Txs{
+		types.Tx("a=key=10"),
+		types.Tx("b=key=20"),
+		types.Tx("c=key=30"),
+		types.Tx("d=key=40"),
+	}
+	for i, tx := range txs {
+		txmp.height = int64(i + 1)
+		require.NoError(t, txmp.CheckTx(ctx, tx, nil, TxInfo{SenderID: 1}))
+	}
+	require.NoError(t, txmp.RemoveTxByKey(txs[3].Key()))
+
+	// The journal is only written when it is flushed.
+	entries, err := txmp.journal.load()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	require.NoError(t, txmp.FlushJournal())
+	entries, err = txmp.journal.load()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		wtx := txmp.txStore.GetTxByHash(txs[i].Key())
+		require.Equal(t, []byte(wtx.tx), entry.Tx)
+		require.Equal(t, wtx.priority, entry.Priority)
+		require.Equal(t, wtx.sender, entry.Sender)
+		require.True(t, wtx.timestamp.Equal(entry.Timestamp))
+		require.Equal(t, wtx.height, entry.Height)
+		require.Equal(t, []string{string(peer)}, entry.Peers)
+	}
+
+	// The transactions are restored as of their original admission, and
+	// attributed to the peer they were received from if it is connected,
+	// except for those that exceed the TTL.
+	restored := setup(t, client, 100, WithJournal(db))
+	restored.config.TTLNumBlocks = 2
+	restored.ids.ReserveForPeer(peer)
+	require.NoError(t, restored.RestoreJournal(ctx, 4))
+	require.Equal(t, types.Txs{txs[2], txs[1]}, restored.ReapMaxTxs(-1))
+	peerID := restored.ids.GetForPeer(peer)
+	for _, tx := range txs[1:3] {
+		wtx := restored.txStore.GetTxByHash(tx.Key())
+		orig := txmp.txStore.GetTxByHash(tx.Key())
+		require.True(t, orig.timestamp.Equal(wtx.timestamp))
+		require.Equal(t, orig.height, wtx.height)
+		require.True(t, restored.txStore.IsTxFromPeer(tx.Key(), peerID))
+	}
+
+	// The expired transaction is discarded from the journal.
+	entries, err = restored.journal.load()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// No ID is reserved for a peer that is not connected.
+	restored = setup(t, client, 100, WithJournal(db))
+	require.NoError(t, restored.RestoreJournal(ctx, 4))
+	require.Equal(t, 2, restored.Size())
+	_, ok := restored.ids.LookupPeer(peer)
+	require.False(t, ok)
+	for _, tx := range txs[1:3] {
+		require.False(t, restored.txStore.IsTxFromPeer(tx.Key(), peerID))
+	}
+
+	restored = setup(t, client, 100, WithJournal(db))
+	restored.config.TTLDuration = time.Nanosecond
+	require.NoError(t, restored.RestoreJournal(ctx, 4))
+	require.Zero(t, restored.Size())
+	entries, err = restored.journal.load()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+// bumpApplication adds a bump to the priorities reported by the application,
+// so rechecks can change the priority of transactions.
+type bumpApplication struct {
+	*application
+	bump int64
+}
+
+func (app *bumpApplication) CheckTx(ctx context.Context, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	res, err := app.application.CheckTx(ctx, req)
+	if err == nil {
+		res.Priority += atomic.LoadInt64(&app.bump)
+	}
+	return res, err
+}
+
+func TestTxMempool_JournalRecheck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	app := &bumpApplication{application: &application{Application: kvstore.NewApplication()}}
+	client := abciclient.NewLocalClient(log.NewNopLogger(), app)
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	db := dbm.NewMemDB()
+	txmp := setup(t, client, 100, WithJournal(db))
+	require.NoError(t, txmp.CheckTx(ctx, types.Tx("a=key=10"), nil, TxInfo{SenderID: 0}))
+
+	// The entry of a transaction whose priority changes on recheck is
+	// rewritten when the block is updated.
+	atomic.StoreInt64(&app.bump, 5)
+	txmp.Lock()
+	require.NoError(t, txmp.Update(ctx, 1, nil, nil, nil, nil, true))
+	txmp.Unlock()
+
+	entries, err := txmp.journal.load()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, int64(15), entries[0].Priority)
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -81,6 +83,14 @@ type TxMempool struct {
 	// index. i.e. older transactions are first.
 	timestampIndex *WrappedTxList
 
+	// journal, if not nil, records the transactions in the mempool so they can
+	// be restored after a restart.
+	journal *txJournal
+
+	// ids holds the IDs the mempool assigns to peers. It is shared with the
+	// reactor, so journaled transactions can be attributed to their peers.
+	ids *IDs
+
 	// A read/write lock is used to safe guard updates, insertions and deletions
 	// from the mempool. A read-lock is implicitly acquired when executing CheckTx,
 	// however, a caller must explicitly grab a write-lock via Lock when updating
@@ -89,6 +99,11 @@ type TxMempool struct {
 	preCheck  PreCheckFunc
 	postCheck PostCheckFunc
 
+	// nonceMtx serializes the insertion of new transactions, so that the nonce
+	// order of each sender's transactions and their pending marks are updated
+	// atomically while CheckTx runs under a read-lock.
+	nonceMtx sync.Mutex
+
 	// NodeID to count of transactions failing CheckTx
 	failedCheckTxCounts    map[types.NodeID]uint64
 	mtxFailedCheckTxCounts sync.RWMutex
@@ -122,6 +137,7 @@ func NewTxMempool(
 		}),
 		failedCheckTxCounts: map[types.NodeID]uint64{},
 		peerManager:         peerManager,
+		ids:                 NewMempoolIDs(),
 	}
 
 	if cfg.CacheSize > 0 {
@@ -246,6 +262,19 @@ func (txmp *TxMempool) CheckTx(
 	txmp.mtx.RLock()
 	defer txmp.mtx.RUnlock()
 
+	return txmp.checkTx(ctx, tx, cb, txInfo, time.Now().UTC(), txmp.height)
+}
+
+// checkTx implements CheckTx for a transaction received at the given time and
+// height. The caller must hold a mempool read-lock.
+func (txmp *TxMempool) checkTx(
+	ctx context.Context,
+	tx types.Tx,
+	cb func(*abci.ResponseCheckTx),
+	txInfo TxInfo,
+	timestamp time.Time,
+	height int64,
+) error {
 	if txSize := len(tx); txSize > txmp.config.MaxTxBytes {
 		return types.ErrTxTooLarge{
 			Max:    txmp.config.MaxTxBytes,
@@ -282,8 +311,8 @@ func (txmp *TxMempool) CheckTx(
 	wtx := &WrappedTx{
 		tx:        tx,
 		hash:      txHash,
-		timestamp: time.Now().UTC(),
-		height:    txmp.height,
+		timestamp: timestamp,
+		height:    height,
 	}
 
 	// only add new transaction if checkTx passes
@@ -321,6 +350,8 @@ func (txmp *TxMempool) HasTx(txKey types.TxKey) bool {
 	return txmp.txStore.GetTxByHash(txKey) != nil
 }
 
+// GetTxsForKeys returns the txs for the given keys in order. Keys for which no
+// tx is present in the mempool are skipped.
 func (txmp *TxMempool) GetTxsForKeys(txKeys []types.TxKey) types.Txs {
 	txmp.mtx.RLock()
 	defer txmp.mtx.RUnlock()
@@ -328,11 +359,57 @@ func (txmp *TxMempool) GetTxsForKeys(txKeys []types.TxKey) types.Txs {
 	txs := make([]types.Tx, 0, len(txKeys))
 	for _, txKey := range txKeys {
 		wtx := txmp.txStore.GetTxByHash(txKey)
+		if wtx == nil {
+			continue
+		}
 		txs = append(txs, wtx.tx)
 	}
 	return txs
 }
 
+// GetTxEntries returns the transactions in the mempool selected by filter,
+// along with their metadata, in descending priority order. Transactions of
+// the same priority are ordered by their arrival.
+func (txmp *TxMempool) GetTxEntries(filter TxFilter) []TxEntry {
+	txmp.mtx.RLock()
+	defer txmp.mtx.RUnlock()
+
+	// Concurrent CheckTx calls update the pending marks of transactions.
+	txmp.nonceMtx.Lock()
+	defer txmp.nonceMtx.Unlock()
+
+	now := time.Now()
+	var entries []TxEntry
+	for _, wtx := range txmp.txStore.GetAllTxs() {
+		if entry := wtx.entry(); filter.Matches(entry, now) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Priority != entries[j].Priority {
+			return entries[i].Priority > entries[j].Priority
+		}
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	return entries
+}
+
+// GetTxEntry returns the transaction in the mempool with the given key along
+// with its metadata, and reports whether it was found.
+func (txmp *TxMempool) GetTxEntry(txKey types.TxKey) (TxEntry, bool) {
+	txmp.mtx.RLock()
+	defer txmp.mtx.RUnlock()
+
+	txmp.nonceMtx.Lock()
+	defer txmp.nonceMtx.Unlock()
+
+	wtx := txmp.txStore.GetTxByHash(txKey)
+	if wtx == nil {
+		return TxEntry{}, false
+	}
+	return wtx.entry(), true
+}
+
 // Flush empties the mempool. It acquires a read-lock, fetches all the
 // transactions currently in the transaction store and removes each transaction
 // from the store and all indexes and finally resets the cache.
@@ -343,6 +420,11 @@ func (txmp *TxMempool) Flush() {
 	txmp.mtx.RLock()
 	defer txmp.mtx.RUnlock()
 
+	// Removing transactions updates the pending marks of their successors,
+	// which concurrent CheckTx calls update too.
+	txmp.nonceMtx.Lock()
+	defer txmp.nonceMtx.Unlock()
+
 	txmp.heightIndex.Reset()
 	txmp.timestampIndex.Reset()
 
@@ -355,7 +437,9 @@ func (txmp *TxMempool) Flush() {
 }
 
 // ReapMaxBytesMaxGas returns a list of transactions within the provided size
-// and gas constraints. Transaction are retrieved in priority order.
+// and gas constraints. Transaction are retrieved in priority order, except
+// that the transactions of each sender are retrieved in nonce order. Pending
+// transactions are not retrieved.
 //
 // NOTE:
 //   - Transactions returned are not removed from the mempool transaction
@@ -369,48 +453,38 @@ func (txmp *TxMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 		totalSize int64
 	)
 
-	// wTxs contains a list of *WrappedTx retrieved from the priority queue that
-	// need to be re-enqueued prior to returning.
-	wTxs := make([]*WrappedTx, 0, txmp.priorityIndex.NumTxs())
-	defer func() {
-		for _, wtx := range wTxs {
-			txmp.priorityIndex.PushTx(wtx)
-		}
-	}()
-
 	txs := make([]types.Tx, 0, txmp.priorityIndex.NumTxs())
 	if uint64(txmp.Size()) < txmp.config.TxNotifyThreshold {
 		// do not reap anything if threshold is not met
 		return txs
 	}
-	for txmp.priorityIndex.NumTxs() > 0 {
-		wtx := txmp.priorityIndex.PopTx()
-		txs = append(txs, wtx.tx)
-		wTxs = append(wTxs, wtx)
+	txmp.reapOrdered(func(wtx *WrappedTx) bool {
 		size := types.ComputeProtoSizeForTxs([]types.Tx{wtx.tx})
 
 		// Ensure we have capacity for the transaction with respect to the
 		// transaction size.
 		if maxBytes > -1 && totalSize+size > maxBytes {
-			return txs[:len(txs)-1]
+			return false
 		}
 
-		totalSize += size
-
 		// ensure we have capacity for the transaction with respect to total gas
 		gas := totalGas + wtx.gasWanted
 		if maxGas > -1 && gas > maxGas {
-			return txs[:len(txs)-1]
+			return false
 		}
 
+		totalSize += size
 		totalGas = gas
-	}
+		txs = append(txs, wtx.tx)
+		return true
+	})
 
 	return txs
 }
 
 // ReapMaxTxs returns a list of transactions within the provided number of
-// transactions bound. Transaction are retrieved in priority order.
+// transactions bound. Transaction are retrieved in the same order as by
+// ReapMaxBytesMaxGas.
 //
 // NOTE:
 //   - Transactions returned are not removed from the mempool transaction
@@ -424,21 +498,77 @@ func (txmp *TxMempool) ReapMaxTxs(max int) types.Txs {
 		max = numTxs
 	}
 
-	cap := tmmath.MinInt(numTxs, max)
+	txs := make([]types.Tx, 0, tmmath.MinInt(numTxs, max))
+	if max == 0 {
+		return txs
+	}
+	txmp.reapOrdered(func(wtx *WrappedTx) bool {
+		txs = append(txs, wtx.tx)
+		return len(txs) < max
+	})
+	return txs
+}
+
+// senderNonce identifies a transaction by its sender and nonce.
+type senderNonce struct {
+	sender string
+	nonce  uint64
+}
 
-	// wTxs contains a list of *WrappedTx retrieved from the priority queue that
+// reapOrdered pops transactions from the priority index in priority order and
+// passes them to fn until fn returns false or the index is exhausted. A
+// transaction of a sender is held back until the sender's transaction with the
+// preceding nonce has been passed, so that each sender's transactions are
+// passed in nonce order. All popped transactions are re-enqueued before
+// reapOrdered returns.
+//
+// NOTE:
+//   - The caller must hold a read-lock or a write-lock.
+func (txmp *TxMempool) reapOrdered(fn func(*WrappedTx) bool) {
+	// popped contains the *WrappedTx retrieved from the priority queue that
 	// need to be re-enqueued prior to returning.
-	wTxs := make([]*WrappedTx, 0, cap)
-	txs := make([]types.Tx, 0, cap)
-	for txmp.priorityIndex.NumTxs() > 0 && len(txs) < max {
+	popped := make([]*WrappedTx, 0, txmp.priorityIndex.NumTxs())
+	defer func() {
+		for _, wtx := range popped {
+			txmp.priorityIndex.PushTx(wtx)
+		}
+	}()
+
+	// next records, for each sender with a transaction passed to fn, the nonce
+	// of the sender's next transaction, and held records the transactions
+	// popped before their predecessor was passed.
+	next := make(map[string]uint64)
+	held := make(map[senderNonce]*WrappedTx)
+
+	for txmp.priorityIndex.NumTxs() > 0 {
 		wtx := txmp.priorityIndex.PopTx()
-		txs = append(txs, wtx.tx)
-		wTxs = append(wTxs, wtx)
-	}
-	for _, wtx := range wTxs {
-		txmp.priorityIndex.PushTx(wtx)
+		popped = append(popped, wtx)
+
+		if len(wtx.sender) > 0 {
+			// Transactions in the priority index form a prefix of their sender's
+			// transactions, so only the lowest nonce in the mempool may be
+			// passed before another of the sender's transactions.
+			nonce, ok := next[wtx.sender]
+			if ok && nonce != wtx.nonce || !ok && txmp.txStore.GetTxBySender(wtx.sender) != wtx {
+				held[senderNonce{wtx.sender, wtx.nonce}] = wtx
+				continue
+			}
+		}
+
+		for wtx != nil {
+			if !fn(wtx) {
+				return
+			}
+			if len(wtx.sender) == 0 {
+				break
+			}
+
+			next[wtx.sender] = wtx.nonce + 1
+			key := senderNonce{wtx.sender, wtx.nonce + 1}
+			wtx = held[key]
+			delete(held, key)
+		}
 	}
-	return txs
 }
 
 // Update iterates over all the transactions provided by the block producer,
@@ -480,7 +610,7 @@ func (txmp *TxMempool) Update(
 
 		// remove the committed transaction from the transaction store and indexes
 		if wtx := txmp.txStore.GetTxByHash(tx.Key()); wtx != nil {
-			txmp.removeTx(wtx, false)
+			txmp.removeCommittedTx(wtx)
 		}
 	}
 
@@ -502,7 +632,17 @@ func (txmp *TxMempool) Update(
 		}
 	}
 
+	if err := txmp.FlushJournal(); err != nil {
+		txmp.logger.Error("failed to write mempool journal", "err", err)
+	}
+
 	txmp.metrics.Size.Set(float64(txmp.Size()))
+	numTxs, txsBytes := txmp.txStore.MaxSenderUsage()
+	txmp.metrics.MaxSenderTxs.Set(float64(numTxs))
+	txmp.metrics.MaxSenderTxsBytes.Set(float64(txsBytes))
+	numTxs, txsBytes = txmp.txStore.MaxPeerUsage()
+	txmp.metrics.MaxPeerTxs.Set(float64(numTxs))
+	txmp.metrics.MaxPeerTxsBytes.Set(float64(txsBytes))
 	return nil
 }
 
@@ -563,31 +703,53 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, res *abci.ResponseCheck
 	sender := res.Sender
 	priority := res.Priority
 
+	wtx.gasWanted = res.GasWanted
+	wtx.priority = priority
+	wtx.sender = sender
+	wtx.nonce = res.Nonce
+	wtx.peers = map[uint16]struct{}{
+		txInfo.SenderID: {},
+	}
+
+	txmp.nonceMtx.Lock()
+	defer txmp.nonceMtx.Unlock()
+
+	// A transaction of the sender with the same nonce is replaced if the new
+	// transaction pays enough to bump it, and the new one is rejected otherwise.
+	var replaced *WrappedTx
 	if len(sender) > 0 {
-		if wtx := txmp.txStore.GetTxBySender(sender); wtx != nil {
-			txmp.logger.Error(
-				"rejected incoming good transaction; tx already exists for sender",
-				"tx", fmt.Sprintf("%X", wtx.tx.Hash()),
-				"sender", sender,
-			)
-			txmp.metrics.RejectedTxs.Add(1)
-			return nil
+		if existing := txmp.txStore.GetTxBySenderNonce(sender, res.Nonce); existing != nil {
+			if !txmp.canReplaceTx(existing, priority) {
+				txmp.logger.Error(
+					"rejected incoming good transaction; tx already exists for sender and nonce",
+					"tx", fmt.Sprintf("%X", existing.tx.Hash()),
+					"sender", sender,
+					"nonce", res.Nonce,
+				)
+				txmp.metrics.RejectedTxs.Add(1)
+				return nil
+			}
+			replaced = existing
 		}
 	}
 
-	if err := txmp.canAddTx(wtx); err != nil {
-		evictTxs := txmp.priorityIndex.GetEvictableTxs(
-			priority,
-			int64(wtx.Size()),
-			txmp.SizeBytes(),
-			txmp.config.MaxTxsBytes,
-		)
+	// Whether wtx is pending decides which transactions it may evict. It is
+	// decided again once it is inserted, since the evictions may open a nonce
+	// gap before it.
+	wtx.pending = txmp.isPending(wtx, res.Pending)
+	if err := txmp.canAddTx(wtx, replaced); err != nil {
+		var quotaErr types.ErrMempoolQuotaExceeded
+		if errors.As(err, &quotaErr) {
+			txmp.metrics.QuotaExceededTxs.With("quota", quotaErr.Quota).Add(1)
+		}
+
+		evictTxs := txmp.getEvictableTxs(wtx, replaced)
 		if len(evictTxs) == 0 {
 			// No room for the new incoming transaction so we just remove it from
 			// the cache.
 			txmp.cache.Remove(wtx.tx)
 			txmp.logger.Error(
-				"rejected incoming good transaction; mempool full",
+				"rejected incoming good transaction; no room in mempool",
 				"tx", fmt.Sprintf("%X", wtx.tx.Hash()),
 				"err", err.Error(),
 			)
@@ -603,7 +765,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, res *abci.ResponseCheck
 		for _, toEvict := range evictTxs {
 			txmp.removeTx(toEvict, true)
 			txmp.logger.Debug(
-				"evicted existing good transaction; mempool full",
+				"evicted existing good transaction; no room in mempool",
 				"old_tx", fmt.Sprintf("%X", toEvict.tx.Hash()),
 				"old_priority", toEvict.priority,
 				"new_tx", fmt.Sprintf("%X", wtx.tx.Hash()),
@@ -613,13 +775,21 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, res *abci.ResponseCheck
 		}
 	}
 
-	wtx.gasWanted = res.GasWanted
-	wtx.priority = priority
-	wtx.sender = sender
-	wtx.peers = map[uint16]struct{}{
-		txInfo.SenderID: {},
+	// NOTE: The replaced transaction may have been evicted above.
+	if replaced != nil && txmp.txStore.GetTxBySenderNonce(sender, res.Nonce) == replaced {
+		txmp.dropTx(replaced, false)
+		txmp.logger.Debug(
+			"replaced existing good transaction",
+			"old_tx", fmt.Sprintf("%X", replaced.tx.Hash()),
+			"old_priority", replaced.priority,
+			"new_tx", fmt.Sprintf("%X", wtx.tx.Hash()),
+			"new_priority", priority,
+		)
+		txmp.metrics.ReplacedTxs.Add(1)
 	}
 
+	wtx.pending = txmp.isPending(wtx, res.Pending)
+
 	txmp.metrics.TxSizeBytes.Observe(float64(wtx.Size()))
 	txmp.metrics.Size.Set(float64(txmp.Size()))
 
@@ -630,11 +800,96 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, res *abci.ResponseCheck
 		"tx", fmt.Sprintf("%X", wtx.tx.Hash()),
 		"height", txmp.height,
 		"num_txs", txmp.Size(),
+		"pending", wtx.pending,
 	)
+	switch {
+	case !wtx.pending:
+		txmp.promoteSuccessors(wtx)
+	case replaced != nil:
+		txmp.holdSuccessors(wtx)
+	}
 	txmp.notifyTxsAvailable()
 	return nil
 }
 
+// canReplaceTx reports whether a transaction with the given priority may
+// replace existing, the transaction in the mempool with the same sender and
+// nonce. Its priority must exceed that of existing by at least the configured
+// replace-by-fee bump, in percent.
+func (txmp *TxMempool) canReplaceTx(existing *WrappedTx, priority int64) bool {
+	bump := txmp.config.ReplaceByFeeBump
+	if bump <= 0 || priority <= existing.priority {
+		return false
+	}
+
+	// Compare in floating point, since the bump of a large priority may
+	// overflow an int64.
+	minPriority := float64(existing.priority) + math.Abs(float64(existing.priority))*float64(bump)/100
+	return float64(priority) >= minPriority
+}
+
+// isPending reports whether wtx must be held back until a transaction of the
+// same sender with a lower nonce arrives. That is the case if the mempool
+// holds lower nonces of the sender but not the immediate predecessor of wtx,
+// or if the predecessor is pending itself. If the mempool holds no lower nonce
+// of the sender, the application's verdict, appPending, applies.
+func (txmp *TxMempool) isPending(wtx *WrappedTx, appPending bool) bool {
+	if len(wtx.sender) == 0 {
+		return false
+	}
+
+	var prev *WrappedTx
+	for _, other := range txmp.txStore.GetTxsBySender(wtx.sender) {
+		if other.nonce >= wtx.nonce {
+			break
+		}
+		prev = other
+	}
+	if prev == nil {
+		return appPending
+	}
+	return prev.pending || prev.nonce+1 != wtx.nonce
+}
+
+// promoteSuccessors releases the pending transactions of the sender of wtx
+// whose nonces consecutively follow the nonce of wtx, which must not be
+// pending, into the priority index.
+func (txmp *TxMempool) promoteSuccessors(wtx *WrappedTx) {
+	if len(wtx.sender) == 0 {
+		return
+	}
+
+	next := wtx.nonce + 1
+	for _, other := range txmp.txStore.GetTxsBySender(wtx.sender) {
+		if other.nonce < next {
+			continue
+		}
+		if other.nonce > next || !other.pending {
+			return
+		}
+		other.pending = false
+		txmp.priorityIndex.PushTx(other)
+		next++
+	}
+}
+
+// holdSuccessors marks the transactions of the sender of wtx with nonces above
+// that of wtx as pending, and removes them from the priority index, since they
+// cannot be included in a block until the nonce of wtx is filled again.
+func (txmp *TxMempool) holdSuccessors(wtx *WrappedTx) {
+	if len(wtx.sender) == 0 {
+		return
+	}
+
+	for _, other := range txmp.txStore.GetTxsBySender(wtx.sender) {
+		if other.nonce <= wtx.nonce || other.pending {
+			continue
+		}
+		other.pending = true
+		txmp.priorityIndex.RemoveTx(other)
+	}
+}
+
 // handleRecheckResult handles the responses from ABCI CheckTx calls issued
 // during the recheck phase of a block Update.  It removes any transactions
 // invalidated by the application.
@@ -694,7 +949,25 @@ func (txmp *TxMempool) handleRecheckResult(tx types.Tx, res *abci.ResponseCheckT
 		}
 
 		if res.Code == abci.CodeTypeOK && err == nil {
-			wtx.priority = res.Priority
+			if wtx.priority != res.Priority {
+				wtx.priority = res.Priority
+				if txmp.journal != nil {
+					txmp.journal.add(wtx)
+				}
+			}
+
+			// The application may report a nonce gap as filled, e.g. after the
+			// preceding transaction was committed, or as newly opened.
+			switch pending := txmp.isPending(wtx, res.Pending); {
+			case wtx.pending && !pending:
+				wtx.pending = false
+				txmp.priorityIndex.PushTx(wtx)
+				txmp.promoteSuccessors(wtx)
+			case !wtx.pending && pending:
+				wtx.pending = true
+				txmp.priorityIndex.RemoveTx(wtx)
+				txmp.holdSuccessors(wtx)
+			}
 		} else {
 			txmp.logger.Debug(
 				"existing transaction no longer valid; failed re-CheckTx callback",
@@ -775,29 +1048,21 @@ func (txmp *TxMempool) updateReCheckTxs(ctx context.Context) {
 }
 
 // canAddTx returns an error if we cannot insert the provided *WrappedTx into
-// the mempool due to mempool configured constraints. If it returns nil,
-// the transaction can be inserted into the mempool.
-func (txmp *TxMempool) canAddTx(wtx *WrappedTx) error {
-	var (
-		numTxs    = txmp.Size()
-		sizeBytes = txmp.SizeBytes()
-	)
-
-	if numTxs >= txmp.config.Size || int64(wtx.Size())+sizeBytes > txmp.config.MaxTxsBytes {
-		return types.ErrMempoolIsFull{
-			NumTxs:      numTxs,
-			MaxTxs:      txmp.config.Size,
-			TxsBytes:    sizeBytes,
-			MaxTxsBytes: txmp.config.MaxTxsBytes,
-		}
-	}
-
-	return nil
+// the mempool due to mempool configured constraints, including the quotas of
+// its sender and peer. If it returns nil, the transaction can be inserted into
+// the mempool.
+//
+// If replaced is not nil, it is the transaction that wtx replaces, whose room
+// in the mempool is released to wtx.
+func (txmp *TxMempool) canAddTx(wtx, replaced *WrappedTx) error {
+	return txmp.newTxRoom(wtx, replaced).err()
 }
 
 func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 	txmp.txStore.SetTx(wtx)
-	txmp.priorityIndex.PushTx(wtx)
+	if !wtx.pending {
+		txmp.priorityIndex.PushTx(wtx)
+	}
 	txmp.heightIndex.Insert(wtx)
 	txmp.timestampIndex.Insert(wtx)
 
@@ -808,13 +1073,39 @@ func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 	wtx.gossipEl = gossipEl
 
 	atomic.AddInt64(&txmp.sizeBytes, int64(wtx.Size()))
+
+	if txmp.journal != nil {
+		txmp.journal.add(wtx)
+	}
 }
 
+// removeTx removes a transaction that was not committed from the mempool. The
+// transactions of the same sender with higher nonces are held back, since
+// their predecessor is gone.
 func (txmp *TxMempool) removeTx(wtx *WrappedTx, removeFromCache bool) {
 	if txmp.txStore.IsTxRemoved(wtx.hash) {
 		return
 	}
 
+	txmp.dropTx(wtx, removeFromCache)
+	if !wtx.pending {
+		txmp.holdSuccessors(wtx)
+	}
+}
+
+// removeCommittedTx removes a transaction included in a block from the
+// mempool. The transactions of the same sender with higher nonces remain
+// eligible for the next block.
+func (txmp *TxMempool) removeCommittedTx(wtx *WrappedTx) {
+	if txmp.txStore.IsTxRemoved(wtx.hash) {
+		return
+	}
+
+	txmp.dropTx(wtx, false)
+}
+
+// dropTx removes a transaction from the transaction store and all indexes.
+func (txmp *TxMempool) dropTx(wtx *WrappedTx, removeFromCache bool) {
 	txmp.txStore.RemoveTx(wtx)
 	txmp.priorityIndex.RemoveTx(wtx)
 	txmp.heightIndex.Remove(wtx)
@@ -830,6 +1121,10 @@ func (txmp *TxMempool) removeTx(wtx *WrappedTx, removeFromCache bool) {
 	if removeFromCache {
 		txmp.cache.Remove(wtx.tx)
 	}
+
+	if txmp.journal != nil {
+		txmp.journal.remove(wtx)
+	}
 }
 
 // purgeExpiredTxs removes all transactions that have exceeded their respective
@@ -72,6 +72,61 @@ func (app *application) CheckTx(_ context.Context, req *abci.RequestCheckTx) (*a
 	}, nil
 }
 
+// nonceApplication extends the KV store application by overriding CheckTx to
+// provide the sender, nonce and priority of transactions of the form
+// sender=nonce=priority. Nonces above the sender's expected nonce are reported
+// as pending.
+type nonceApplication struct {
+	*kvstore.Application
+
+	mtx      sync.Mutex
+	expected map[string]uint64
+}
+
+func newNonceApplication() *nonceApplication {
+	return &nonceApplication{
+		Application: kvstore.NewApplication(),
+		expected:    make(map[string]uint64),
+	}
+}
+
+func (app *nonceApplication) setExpected(sender string, nonce uint64) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.expected[sender] = nonce
+}
+
+func (app *nonceApplication) CheckTx(_ context.Context, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	parts := bytes.Split(req.Tx, []byte("="))
+	if len(parts) != 3 {
+		return &abci.ResponseCheckTx{Code: 101, GasWanted: 1}, nil
+	}
+	nonce, err := strconv.ParseUint(string(parts[1]), 10, 64)
+	if err != nil {
+		return &abci.ResponseCheckTx{Code: 100, GasWanted: 1}, nil
+	}
+	priority, err := strconv.ParseInt(string(parts[2]), 10, 64)
+	if err != nil {
+		return &abci.ResponseCheckTx{Code: 100, GasWanted: 1}, nil
+	}
+	sender := string(parts[0])
+	if nonce < app.expected[sender] {
+		return &abci.ResponseCheckTx{Code: 102, GasWanted: 1}, nil
+	}
+
+	return &abci.ResponseCheckTx{
+		Priority:  priority,
+		Sender:    sender,
+		Nonce:     nonce,
+		Pending:   nonce > app.expected[sender],
+		Code:      code.CodeTypeOK,
+		GasWanted: 1,
+	}, nil
+}
+
 func setup(t testing.TB, app abciclient.Client, cacheSize int, options ...TxMempoolOption) *TxMempool {
 	t.Helper()
 
@@ -465,6 +520,368 @@ func TestTxMempool_CheckTxSameSender(t *testing.T) {
 	require.Equal(t, 1, txmp.Size())
 }
 
+func TestTxMempool_ReapNonceOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := abciclient.NewLocalClient(log.NewNopLogger(), newNonceApplication())
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	txmp := setup(t, client, 100)
+
+	// The transactions of sender a are reaped in nonce order, ranked by the
+	// priority of the first one.
+	txs := types.Txs{
+		types.Tx("a=0=10"),
+		types.Tx("a=1=100"),
+		types.Tx("a=2=50"),
+		types.Tx("b=0=30"),
+		types.Tx("c=0=5"),
+	}
+	for _, tx := range []types.Tx{txs[2], txs[0], txs[3], txs[1], txs[4]} {
+		require.NoError(t, txmp.CheckTx(ctx, tx, nil, TxInfo{SenderID: 0}))
+	}
+	require.Equal(t, 5, txmp.Size())
+
+	want := types.Txs{txs[3], txs[0], txs[1], txs[2], txs[4]}
+	require.Equal(t, want, txmp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, want[:2], txmp.ReapMaxTxs(2))
+	require.Equal(t, want[:3], txmp.ReapMaxBytesMaxGas(-1, 3))
+
+	// Reaping leaves the priority index intact.
+	require.Equal(t, want, txmp.ReapMaxTxs(-1))
+
+	// A transaction for a sender and nonce already in the mempool is rejected.
+	require.NoError(t, txmp.CheckTx(ctx, types.Tx("a=1=99"), nil, TxInfo{SenderID: 0}))
+	require.Equal(t, 5, txmp.Size())
+}
+
+func TestTxMempool_PendingNonces(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	app := newNonceApplication()
+	client := abciclient.NewLocalClient(log.NewNopLogger(), app)
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	txmp := setup(t, client, 100)
+	txs := types.Txs{types.Tx("a=0=1"), types.Tx("a=1=1"), types.Tx("a=2=1"), types.Tx("a=3=1")}
+	checkTx := func(tx types.Tx) {
+		t.Helper()
+		require.NoError(t, txmp.CheckTx(ctx, tx, nil, TxInfo{SenderID: 0}))
+	}
+	update := func(height int64, committed types.Txs) {
+		t.Helper()
+		responses := make([]*abci.ExecTxResult, len(committed))
+		for i := range responses {
+			responses[i] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+		}
+		txmp.Lock()
+		require.NoError(t, txmp.Update(ctx, height, committed, responses, nil, nil, true))
+		txmp.Unlock()
+	}
+
+	// Transactions following a gap are held back until it is filled.
+	checkTx(txs[1])
+	checkTx(txs[3])
+	require.Equal(t, 2, txmp.Size())
+	require.Empty(t, txmp.ReapMaxTxs(-1))
+
+	checkTx(txs[0])
+	require.Equal(t, txs[:2], txmp.ReapMaxTxs(-1))
+
+	checkTx(txs[2])
+	require.Equal(t, txs, txmp.ReapMaxTxs(-1))
+
+	// Committing a transaction leaves its successors eligible.
+	app.setExpected("a", 1)
+	update(1, txs[:1])
+	require.Equal(t, txs[1:], txmp.ReapMaxTxs(-1))
+
+	// Removing a transaction that was not committed holds back its successors.
+	require.NoError(t, txmp.RemoveTxByKey(txs[2].Key()))
+	require.Equal(t, txs[1:2], txmp.ReapMaxTxs(-1))
+
+	// The application reports the gap as filled on recheck.
+	app.setExpected("a", 3)
+	update(2, types.Txs{txs[2]})
+	require.Equal(t, 1, txmp.Size())
+	require.Equal(t, txs[3:], txmp.ReapMaxTxs(-1))
+}
+
+func TestTxMempool_EvictPendingTxs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := abciclient.NewLocalClient(log.NewNopLogger(), newNonceApplication())
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	txmp := setup(t, client, 100)
+	txmp.config.Size = 3
+	checkTx := func(tx string) {
+		t.Helper()
+		require.NoError(t, txmp.CheckTx(ctx, types.Tx(tx), nil, TxInfo{SenderID: 0}))
+	}
+
+	// A sender fills the mempool with high-priority transactions that follow
+	// a nonce gap, and can never be included in a block.
+	checkTx("a=1=100")
+	checkTx("a=2=100")
+	checkTx("a=3=100")
+	require.Equal(t, 3, txmp.Size())
+	require.Empty(t, txmp.ReapMaxTxs(-1))
+
+	// They are evicted for transactions that can be included, whatever their
+	// priority.
+	checkTx("b=0=1")
+	checkTx("c=0=1")
+	require.Equal(t, 3, txmp.Size())
+	require.ElementsMatch(t, types.Txs{types.Tx("b=0=1"), types.Tx("c=0=1")}, txmp.ReapMaxTxs(-1))
+
+	// A pending transaction only evicts transactions of lower priority.
+	checkTx("d=1=1")
+	require.Nil(t, txmp.txStore.GetTxBySender("d"))
+	checkTx("d=1=2")
+	require.NotNil(t, txmp.txStore.GetTxBySender("d"))
+	require.Equal(t, 3, txmp.Size())
+}
+
+func TestTxMempool_ReplaceByFee(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := abciclient.NewLocalClient(log.NewNopLogger(), newNonceApplication())
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	txmp := setup(t, client, 100)
+	txmp.config.Size = 2
+	txmp.config.ReplaceByFeeBump = 10
+
+	checkTx := func(tx types.Tx) {
+		t.Helper()
+		require.NoError(t, txmp.CheckTx(ctx, tx, nil, TxInfo{SenderID: 0}))
+	}
+	gossiped := func() types.Txs {
+		var txs types.Txs
+		for e := txmp.gossipIndex.Front(); e != nil; e = e.Next() {
+			txs = append(txs, e.Value.(*WrappedTx).tx)
+		}
+		return txs
+	}
+
+	checkTx(types.Tx("a=0=100"))
+	checkTx(types.Tx("a=1=100"))
+	require.Equal(t, 2, txmp.Size())
+
+	// A transaction whose priority is not higher by the bump is rejected.
+	checkTx(types.Tx("a=1=109"))
+	require.Equal(t, types.Txs{types.Tx("a=0=100"), types.Tx("a=1=100")}, txmp.ReapMaxTxs(-1))
+
+	// The replacement takes the room of the replaced transaction in the full
+	// mempool, and is gossiped in its place.
+	checkTx(types.Tx("a=1=110"))
+	require.Equal(t, 2, txmp.Size())
+	require.Equal(t, types.Txs{types.Tx("a=0=100"), types.Tx("a=1=110")}, txmp.ReapMaxTxs(-1))
+	require.Equal(t, types.Txs{types.Tx("a=0=100"), types.Tx("a=1=110")}, gossiped())
+	require.Nil(t, txmp.txStore.GetTxByHash(types.Tx("a=1=100").Key()))
+	require.Equal(t, int64(len("a=0=100")+len("a=1=110")), txmp.SizeBytes())
+
+	// Replacing a transaction leaves its successors eligible.
+	checkTx(types.Tx("a=0=200"))
+	require.Equal(t, types.Txs{types.Tx("a=0=200"), types.Tx("a=1=110")}, txmp.ReapMaxTxs(-1))
+	require.Equal(t, types.Txs{types.Tx("a=1=110"), types.Tx("a=0=200")}, gossiped())
+
+	// Replace-by-fee can be disabled.
+	txmp.config.ReplaceByFeeBump = 0
+	checkTx(types.Tx("a=0=1000"))
+	require.Equal(t, types.Txs{types.Tx("a=0=200"), types.Tx("a=1=110")}, txmp.ReapMaxTxs(-1))
+}
+
+func TestTxMempool_SenderQuota(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := abciclient.NewLocalClient(log.NewNopLogger(), newNonceApplication())
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	txmp := setup(t, client, 100)
+	txmp.config.MaxTxsPerSender = 2
+
+	for _, tx := range []string{"a=0=10", "a=1=20", "b=0=5"} {
+		require.NoError(t, txmp.CheckTx(ctx, types.Tx(tx), nil, TxInfo{SenderID: 0}))
+	}
+	require.Equal(t, 3, txmp.Size())
+
+	// A sender over its quota cannot evict the transactions of others.
+	require.NoError(t, txmp.CheckTx(ctx, types.Tx("a=2=5"), nil, TxInfo{SenderID: 0}))
+	require.Equal(t, 3, txmp.Size())
+	require.Nil(t, txmp.txStore.GetTxBySenderNonce("a", 2))
+
+	// Nor its own transactions with lower nonces, which would hold it back.
+	require.NoError(t, txmp.CheckTx(ctx, types.Tx("a=2=30"), nil, TxInfo{SenderID: 0}))
+	require.Equal(t, 3, txmp.Size())
+	require.NotNil(t, txmp.txStore.GetTxBySenderNonce("a", 0))
+	require.NotNil(t, txmp.txStore.GetTxBySenderNonce("a", 1))
+	require.Nil(t, txmp.txStore.GetTxBySenderNonce("a", 2))
+	require.NotNil(t, txmp.txStore.GetTxBySender("b"))
+}
+
+func TestTxMempool_SenderQuotaEvictsHighestNonces(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := abciclient.NewLocalClient(log.NewNopLogger(), newNonceApplication())
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	txmp := setup(t, client, 100)
+	txmp.config.MaxTxsPerSender = 3
+
+	for _, tx := range []string{"a=0=10", "a=2=1", "a=3=5"} {
+		require.NoError(t, txmp.CheckTx(ctx, types.Tx(tx), nil, TxInfo{SenderID: 0}))
+	}
+	require.Equal(t, 3, txmp.Size())
+
+	// Filling the nonce gap evicts the transaction with the highest nonce,
+	// even though its successor has a lower priority, so that no transaction
+	// is held back by the eviction.
+	require.NoError(t, txmp.CheckTx(ctx, types.Tx("a=1=10"), nil, TxInfo{SenderID: 0}))
+	require.Equal(t, 3, txmp.Size())
+	require.Nil(t, txmp.txStore.GetTxBySenderNonce("a", 3))
+	require.ElementsMatch(t, types.Txs{
+		types.Tx("a=0=10"), types.Tx("a=1=10"), types.Tx("a=2=1"),
+	}, txmp.ReapMaxTxs(-1))
+}
+
+func TestTxMempool_PeerQuota(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := abciclient.NewLocalClient(log.NewNopLogger(), &application{Application: kvstore.NewApplication()})
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	txmp := setup(t, client, 100)
+	txmp.config.MaxTxsPerPeer = 2
+	txmp.config.MaxTxsBytesPerPeer = 20
+
+	checkTx := func(tx string, peerID uint16) {
+		t.Helper()
+		require.NoError(t, txmp.CheckTx(ctx, types.Tx(tx), nil, TxInfo{SenderID: peerID}))
+	}
+	checkTx("a=key=10", 1)
+	checkTx("b=key=20", 1)
+	checkTx("c=key=1", 2)
+	require.Equal(t, 3, txmp.Size())
+
+	// A peer over its quota cannot evict the transactions of others.
+	checkTx("d=key=5", 1)
+	require.Equal(t, 3, txmp.Size())
+	require.Nil(t, txmp.txStore.GetTxBySender("d"))
+
+	// It evicts the transaction it sent with the lowest priority instead.
+	checkTx("e=key=15", 1)
+	require.Equal(t, 3, txmp.Size())
+	require.Nil(t, txmp.txStore.GetTxBySender("a"))
+	require.NotNil(t, txmp.txStore.GetTxBySender("e"))
+	numTxs, txsBytes := txmp.txStore.PeerUsage(1)
+	require.Equal(t, 2, numTxs)
+	require.Equal(t, int64(len("b=key=20")+len("e=key=15")), txsBytes)
+
+	// The byte quota applies as well.
+	checkTx("f=key=100", 2)
+	checkTx("g=key=100000", 2)
+	require.Nil(t, txmp.txStore.GetTxBySender("c"))
+	require.Nil(t, txmp.txStore.GetTxBySender("f"))
+	require.NotNil(t, txmp.txStore.GetTxBySender("g"))
+
+	// Transactions submitted through RPC are not limited.
+	checkTx("h=key=1", UnknownPeerID)
+	checkTx("i=key=1", UnknownPeerID)
+	checkTx("j=key=1", UnknownPeerID)
+	require.Equal(t, 6, txmp.Size())
+}
+
+func TestTxMempool_GetTxEntries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := abciclient.NewLocalClient(log.NewNopLogger(), newNonceApplication())
+	if err := client.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Wait)
+
+	txmp := setup(t, client, 100)
+	txs := types.Txs{types.Tx("a=0=10"), types.Tx("a=2=30"), types.Tx("b=0=20")}
+	for i, tx := range txs {
+		require.NoError(t, txmp.CheckTx(ctx, tx, nil, TxInfo{SenderID: uint16(i + 1)}))
+	}
+	require.ErrorIs(t, txmp.CheckTx(ctx, txs[0], nil, TxInfo{SenderID: 5}), types.ErrTxInCache)
+
+	entry, ok := txmp.GetTxEntry(txs[0].Key())
+	require.True(t, ok)
+	require.Equal(t, txs[0], entry.Tx)
+	require.Equal(t, int64(10), entry.Priority)
+	require.Equal(t, "a", entry.Sender)
+	require.Equal(t, []uint16{1, 5}, entry.Peers)
+	require.False(t, entry.Pending)
+	require.True(t, entry.Evictable)
+
+	// A transaction following a nonce gap is pending, and can be evicted
+	// whatever its priority.
+	entry, ok = txmp.GetTxEntry(txs[1].Key())
+	require.True(t, ok)
+	require.Equal(t, uint64(2), entry.Nonce)
+	require.True(t, entry.Pending)
+	require.True(t, entry.Evictable)
+
+	_, ok = txmp.GetTxEntry(types.Tx("c=0=1").Key())
+	require.False(t, ok)
+
+	priority := func(p int64) *int64 { return &p }
+	testCases := []struct {
+		name   string
+		filter TxFilter
+		want   types.Txs
+	}{
+		{"All", TxFilter{}, types.Txs{txs[1], txs[2], txs[0]}},
+		{"Sender", TxFilter{Sender: "a"}, types.Txs{txs[1], txs[0]}},
+		{"MinPriority", TxFilter{MinPriority: priority(20)}, types.Txs{txs[1], txs[2]}},
+		{"MaxPriority", TxFilter{MaxPriority: priority(20)}, types.Txs{txs[2], txs[0]}},
+		{"MinAge", TxFilter{MinAge: time.Hour}, nil},
+		{"MaxAge", TxFilter{MaxAge: time.Hour}, types.Txs{txs[1], txs[2], txs[0]}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got types.Txs
+			for _, entry := range txmp.GetTxEntries(tc.filter) {
+				got = append(got, entry.Tx)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestTxMempool_ConcurrentTxs(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -46,6 +46,42 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "evicted_txs",
 			Help:      "Number of evicted transactions.",
 		}, labels).With(labelsAndValues...),
+		QuotaExceededTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "quota_exceeded_txs",
+			Help:      "Number of transactions exceeding a sender or peer quota.",
+		}, append(labels, "quota")).With(labelsAndValues...),
+		MaxSenderTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "max_sender_txs",
+			Help:      "Largest number of transactions of a single sender in the mempool.",
+		}, labels).With(labelsAndValues...),
+		MaxSenderTxsBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "max_sender_txs_bytes",
+			Help:      "Largest total size of the transactions of a single sender in the mempool in bytes.",
+		}, labels).With(labelsAndValues...),
+		MaxPeerTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "max_peer_txs",
+			Help:      "Largest number of transactions admitted from a single peer in the mempool.",
+		}, labels).With(labelsAndValues...),
+		MaxPeerTxsBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "max_peer_txs_bytes",
+			Help:      "Largest total size of the transactions admitted from a single peer in the mempool in bytes.",
+		}, labels).With(labelsAndValues...),
+		ReplacedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "replaced_txs",
+			Help:      "Number of replaced transactions.",
+		}, labels).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -57,11 +93,17 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Size:         discard.NewGauge(),
-		TxSizeBytes:  discard.NewHistogram(),
-		FailedTxs:    discard.NewCounter(),
-		RejectedTxs:  discard.NewCounter(),
-		EvictedTxs:   discard.NewCounter(),
-		RecheckTimes: discard.NewCounter(),
+		Size:              discard.NewGauge(),
+		TxSizeBytes:       discard.NewHistogram(),
+		FailedTxs:         discard.NewCounter(),
+		RejectedTxs:       discard.NewCounter(),
+		EvictedTxs:        discard.NewCounter(),
+		QuotaExceededTxs:  discard.NewCounter(),
+		MaxSenderTxs:      discard.NewGauge(),
+		MaxSenderTxsBytes: discard.NewGauge(),
+		MaxPeerTxs:        discard.NewGauge(),
+		MaxPeerTxsBytes:   discard.NewGauge(),
+		ReplacedTxs:       discard.NewCounter(),
+		RecheckTimes:      discard.NewCounter(),
 	}
 }
@@ -38,6 +38,38 @@ type Metrics struct {
 	//metrics:Number of evicted transactions.
 	EvictedTxs metrics.Counter
 
+	// QuotaExceededTxs defines the number of valid transactions that exceeded
+	// the quota of their sender or peer, labeled by the quota. These
+	// transactions were either rejected, or replaced lower priority
+	// transactions of the same party.
+	//metrics:Number of transactions exceeding a sender or peer quota.
+	QuotaExceededTxs metrics.Counter `metrics_labels:"quota"`
+
+	// MaxSenderTxs and MaxSenderTxsBytes define the largest number and the
+	// largest total size of the transactions of a single sender in the
+	// mempool, as defined by the ABCI application. They are the usage that
+	// counts towards the sender quota, and are updated after each block.
+	//metrics:Largest number of transactions of a single sender in the mempool.
+	MaxSenderTxs metrics.Gauge
+	//metrics:Largest total size of the transactions of a single sender in the mempool in bytes.
+	MaxSenderTxsBytes metrics.Gauge
+
+	// MaxPeerTxs and MaxPeerTxsBytes define the largest number and the
+	// largest total size of the transactions admitted from a single peer in
+	// the mempool. They are the usage that counts towards the peer quota, and
+	// are updated after each block.
+	//metrics:Largest number of transactions admitted from a single peer in the mempool.
+	MaxPeerTxs metrics.Gauge
+	//metrics:Largest total size of the transactions admitted from a single peer in the mempool in bytes.
+	MaxPeerTxsBytes metrics.Gauge
+
+	// ReplacedTxs defines the number of replaced transactions. These are valid
+	// transactions that existed in the mempool but were replaced by a
+	// transaction of the same sender and nonce with a sufficiently higher
+	// priority.
+	//metrics:Number of replaced transactions.
+	ReplacedTxs metrics.Counter
+
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
 }
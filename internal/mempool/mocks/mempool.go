@@ -57,6 +57,43 @@ func (_m *Mempool) FlushAppConn(_a0 context.Context) error {
 	return r0
 }
 
+// GetTxEntries provides a mock function with given fields: filter
+func (_m *Mempool) GetTxEntries(filter mempool.TxFilter) []mempool.TxEntry {
+	ret := _m.Called(filter)
+
+	var r0 []mempool.TxEntry
+	if rf, ok := ret.Get(0).(func(mempool.TxFilter) []mempool.TxEntry); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mempool.TxEntry)
+		}
+	}
+
+	return r0
+}
+
+// GetTxEntry provides a mock function with given fields: txKey
+func (_m *Mempool) GetTxEntry(txKey types.TxKey) (mempool.TxEntry, bool) {
+	ret := _m.Called(txKey)
+
+	var r0 mempool.TxEntry
+	if rf, ok := ret.Get(0).(func(types.TxKey) mempool.TxEntry); ok {
+		r0 = rf(txKey)
+	} else {
+		r0 = ret.Get(0).(mempool.TxEntry)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(types.TxKey) bool); ok {
+		r1 = rf(txKey)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetTxsForKeys provides a mock function with given fields: txKeys
 func (_m *Mempool) GetTxsForKeys(txKeys []types.TxKey) types.Txs {
 	ret := _m.Called(txKeys)
@@ -66,6 +66,15 @@ func (pq *TxPriorityQueue) GetEvictableTxs(priority, txSize, totalSize, cap int6
 	return nil
 }
 
+// GetTxs returns a copy of the transactions in the priority queue, in no
+// particular order. It is thread safe.
+func (pq *TxPriorityQueue) GetTxs() []*WrappedTx {
+	pq.mtx.RLock()
+	defer pq.mtx.RUnlock()
+
+	return append([]*WrappedTx(nil), pq.txs...)
+}
+
 // NumTxs returns the number of transactions in the priority queue. It is
 // thread safe.
 func (pq *TxPriorityQueue) NumTxs() int {
@@ -75,12 +84,13 @@ func (pq *TxPriorityQueue) NumTxs() int {
 	return len(pq.txs)
 }
 
-// RemoveTx removes a specific transaction from the priority queue.
+// RemoveTx removes a specific transaction from the priority queue. It is a
+// no-op if the transaction is not in the queue.
 func (pq *TxPriorityQueue) RemoveTx(tx *WrappedTx) {
 	pq.mtx.Lock()
 	defer pq.mtx.Unlock()
 
-	if tx.heapIndex < len(pq.txs) {
+	if tx.heapIndex >= 0 && tx.heapIndex < len(pq.txs) && pq.txs[tx.heapIndex] == tx {
 		heap.Remove(pq, tx.heapIndex)
 	}
 }
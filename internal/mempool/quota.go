@@ -0,0 +1,225 @@
+package mempool
+
+import (
+	"math"
+	"sort"
+
+	"github.com/ari-anchor/sei-tendermint/config"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// txUsage is the number and the total size of a set of transactions.
+type txUsage struct {
+	numTxs   int
+	txsBytes int64
+}
+
+// release removes wtx from the usage.
+func (u *txUsage) release(wtx *WrappedTx) {
+	u.numTxs--
+	u.txsBytes -= int64(wtx.Size())
+}
+
+// exceeds reports whether adding a transaction of the given size to the usage
+// exceeds the given limits, where zero limits are disabled.
+func (u txUsage) exceeds(size int64, maxTxs int, maxTxsBytes int64) bool {
+	return maxTxs > 0 && u.numTxs >= maxTxs || maxTxsBytes > 0 && u.txsBytes+size > maxTxsBytes
+}
+
+// txRoom tracks the room in the mempool that remains for a new transaction,
+// along with the room in the quotas of its sender and of the peer it was
+// received from, as transactions are released from the mempool.
+type txRoom struct {
+	cfg    *config.MempoolConfig
+	store  *TxStore
+	wtx    *WrappedTx
+	peerID uint16
+
+	total  txUsage
+	sender txUsage
+	peer   txUsage
+}
+
+// newTxRoom returns the room for wtx in the mempool, where replaced, if not
+// nil, is the transaction wtx replaces and whose room is released to it.
+func (txmp *TxMempool) newTxRoom(wtx, replaced *WrappedTx) *txRoom {
+	r := &txRoom{
+		cfg:   txmp.config,
+		store: txmp.txStore,
+		wtx:   wtx,
+		total: txUsage{txmp.Size(), txmp.SizeBytes()},
+	}
+
+	// A new transaction has only been received from the peer it is admitted
+	// from.
+	for peerID := range wtx.peers {
+		r.peerID = peerID
+	}
+
+	if len(wtx.sender) > 0 {
+		r.sender.numTxs, r.sender.txsBytes = txmp.txStore.SenderUsage(wtx.sender)
+	}
+	if r.peerID != UnknownPeerID {
+		r.peer.numTxs, r.peer.txsBytes = txmp.txStore.PeerUsage(r.peerID)
+	}
+
+	if replaced != nil {
+		r.release(replaced)
+	}
+	return r
+}
+
+// release returns the room taken by wtx, which is in the mempool.
+func (r *txRoom) release(wtx *WrappedTx) {
+	r.total.release(wtx)
+	if len(r.wtx.sender) > 0 && wtx.sender == r.wtx.sender {
+		r.sender.release(wtx)
+	}
+	if r.peerID != UnknownPeerID && r.store.IsTxFromPeer(wtx.hash, r.peerID) {
+		r.peer.release(wtx)
+	}
+}
+
+func (r *txRoom) isFull() bool {
+	return r.total.numTxs >= r.cfg.Size || r.total.txsBytes+int64(r.wtx.Size()) > r.cfg.MaxTxsBytes
+}
+
+func (r *txRoom) senderExceeded() bool {
+	return len(r.wtx.sender) > 0 &&
+		r.sender.exceeds(int64(r.wtx.Size()), r.cfg.MaxTxsPerSender, r.cfg.MaxTxsBytesPerSender)
+}
+
+func (r *txRoom) peerExceeded() bool {
+	return r.peerID != UnknownPeerID &&
+		r.peer.exceeds(int64(r.wtx.Size()), r.cfg.MaxTxsPerPeer, r.cfg.MaxTxsBytesPerPeer)
+}
+
+// err returns an error if there is no room for the transaction in the mempool
+// or in one of its quotas.
+func (r *txRoom) err() error {
+	switch {
+	case r.isFull():
+		return types.ErrMempoolIsFull{
+			NumTxs:      r.total.numTxs,
+			MaxTxs:      r.cfg.Size,
+			TxsBytes:    r.total.txsBytes,
+			MaxTxsBytes: r.cfg.MaxTxsBytes,
+		}
+	case r.senderExceeded():
+		return types.ErrMempoolQuotaExceeded{
+			Quota:       "sender",
+			NumTxs:      r.sender.numTxs,
+			MaxTxs:      r.cfg.MaxTxsPerSender,
+			TxsBytes:    r.sender.txsBytes,
+			MaxTxsBytes: r.cfg.MaxTxsBytesPerSender,
+		}
+	case r.peerExceeded():
+		return types.ErrMempoolQuotaExceeded{
+			Quota:       "peer",
+			NumTxs:      r.peer.numTxs,
+			MaxTxs:      r.cfg.MaxTxsPerPeer,
+			TxsBytes:    r.peer.txsBytes,
+			MaxTxsBytes: r.cfg.MaxTxsBytesPerPeer,
+		}
+	}
+	return nil
+}
+
+// canEvict reports whether candidate, a transaction in the mempool, may be
+// evicted to make room for wtx. A pending transaction cannot be included in a
+// block until the nonce gap before it is filled, so it may be evicted for any
+// transaction that is not pending itself. Otherwise, only transactions of
+// lower priority than wtx may be evicted. A transaction never evicts one of
+// its sender with a lower nonce, which would hold it back.
+func canEvict(candidate, wtx *WrappedTx) bool {
+	if len(wtx.sender) > 0 && candidate.sender == wtx.sender && candidate.nonce < wtx.nonce {
+		return false
+	}
+	if candidate.pending && !wtx.pending {
+		return true
+	}
+	return candidate.priority < wtx.priority
+}
+
+// isEvictable reports whether canEvict allows wtx to be evicted for any
+// transaction, that is for a transaction of another sender with the highest
+// possible priority.
+func isEvictable(wtx *WrappedTx) bool {
+	return canEvict(wtx, &WrappedTx{priority: math.MaxInt64})
+}
+
+// getEvictableTxs returns the transactions to evict from the mempool to make
+// room for wtx, or nil if there are not enough transactions that canEvict
+// allows to evict. Pending transactions are evicted first, and then the
+// others in ascending priority order. If wtx exceeds the quota of its sender
+// or peer, the transactions of that party are evicted first, so a party over
+// its quota can only make room for a transaction at its own expense.
+//
+// Evicting a transaction holds back the transactions of its sender with higher
+// nonces, so the transactions of a sender are evicted from the highest nonce
+// down: when a transaction is picked, the transaction of its sender with the
+// highest nonce that is left is evicted in its place.
+func (txmp *TxMempool) getEvictableTxs(wtx, replaced *WrappedTx) []*WrappedTx {
+	var (
+		room    = txmp.newTxRoom(wtx, replaced)
+		toEvict []*WrappedTx
+		evicted = map[*WrappedTx]bool{replaced: true}
+	)
+
+	// evict picks candidates in eviction order until the room is no longer
+	// exceeded, and reports whether it succeeded.
+	evict := func(candidates []*WrappedTx, exceeded func() bool) bool {
+		// The candidates of each sender in increasing nonce order.
+		senderTxs := make(map[string][]*WrappedTx)
+		for _, candidate := range candidates {
+			if len(candidate.sender) > 0 {
+				senderTxs[candidate.sender] = append(senderTxs[candidate.sender], candidate)
+			}
+		}
+		for _, txs := range senderTxs {
+			sort.Slice(txs, func(i, j int) bool { return txs[i].nonce < txs[j].nonce })
+		}
+
+		sort.Slice(candidates, func(i, j int) bool {
+			if candidates[i].pending != candidates[j].pending {
+				return candidates[i].pending
+			}
+			return candidates[i].priority < candidates[j].priority
+		})
+		for _, candidate := range candidates {
+			if !exceeded() {
+				break
+			}
+			if evicted[candidate] {
+				continue
+			}
+			if len(candidate.sender) > 0 {
+				txs := senderTxs[candidate.sender]
+				for evicted[txs[len(txs)-1]] {
+					txs = txs[:len(txs)-1]
+				}
+				senderTxs[candidate.sender] = txs
+				candidate = txs[len(txs)-1]
+			}
+			if !canEvict(candidate, wtx) {
+				continue
+			}
+			evicted[candidate] = true
+			toEvict = append(toEvict, candidate)
+			room.release(candidate)
+		}
+		return !exceeded()
+	}
+
+	if room.senderExceeded() && !evict(txmp.txStore.GetTxsBySender(wtx.sender), room.senderExceeded) {
+		return nil
+	}
+	if room.peerExceeded() && !evict(txmp.txStore.GetTxsByPeer(room.peerID), room.peerExceeded) {
+		return nil
+	}
+	// Pending transactions are not in the priority index.
+	if room.isFull() && !evict(txmp.txStore.GetAllTxs(), room.isFull) {
+		return nil
+	}
+	return toEvict
+}
@@ -56,7 +56,7 @@ func NewReactor(
 		logger:       logger,
 		cfg:          cfg,
 		mempool:      txmp,
-		ids:          NewMempoolIDs(),
+		ids:          txmp.ids,
 		peerEvents:   peerEvents,
 		peerRoutines: make(map[types.NodeID]context.CancelFunc),
 		observePanic: defaultObservePanic,
@@ -21,6 +21,58 @@ type TxInfo struct {
 	SenderNodeID types.NodeID
 }
 
+// TxEntry describes a transaction in the mempool along with its metadata.
+type TxEntry struct {
+	Tx        types.Tx
+	Priority  int64
+	Sender    string
+	Nonce     uint64
+	GasWanted int64
+	Height    int64
+	Timestamp time.Time
+
+	// Peers holds the IDs of the peers the transaction was received from.
+	Peers []uint16
+
+	// Pending is true if the transaction waits for a transaction of the same
+	// sender with a lower nonce.
+	Pending bool
+
+	// Evictable is true if the transaction can be evicted from the mempool to
+	// make room for another transaction. Pending transactions may be evicted
+	// for any transaction that is not pending, and the others for transactions
+	// of higher priority.
+	Evictable bool
+}
+
+// TxFilter selects the transactions in the mempool by their metadata. The
+// zero value of each field imposes no restriction.
+type TxFilter struct {
+	Sender      string
+	MinPriority *int64
+	MaxPriority *int64
+
+	// MinAge and MaxAge bound the time elapsed since the transaction was
+	// received.
+	MinAge time.Duration
+	MaxAge time.Duration
+}
+
+// Matches reports whether the transaction described by entry is selected by
+// the filter at the given time.
+func (f TxFilter) Matches(entry TxEntry, now time.Time) bool {
+	age := now.Sub(entry.Timestamp)
+	switch {
+	case f.Sender != "" && entry.Sender != f.Sender,
+		f.MinPriority != nil && entry.Priority < *f.MinPriority,
+		f.MaxPriority != nil && entry.Priority > *f.MaxPriority,
+		f.MinAge > 0 && age < f.MinAge,
+		f.MaxAge > 0 && age > f.MaxAge:
+		return false
+	}
+	return true
+}
+
 // WrappedTx defines a wrapper around a raw transaction with additional metadata
 // that is used for indexing.
 type WrappedTx struct {
@@ -44,6 +96,15 @@ type WrappedTx struct {
 	// the ResponseCheckTx response.
 	sender string
 
+	// nonce orders the transactions of the same sender, as specified by the
+	// application in the ResponseCheckTx response.
+	nonce uint64
+
+	// pending marks a transaction that cannot be included in a block before a
+	// transaction of the same sender with a lower nonce arrives. Pending
+	// transactions are kept out of the priority index so they are not reaped.
+	pending bool
+
 	// timestamp is the time at which the node first received the transaction from
 	// a peer. It is used as a second dimension is prioritizing transactions when
 	// two transactions have the same priority.
@@ -69,6 +130,27 @@ func (wtx *WrappedTx) Size() int {
 	return len(wtx.tx)
 }
 
+// entry returns the description of the transaction. The caller must ensure the
+// transaction is not modified concurrently.
+func (wtx *WrappedTx) entry() TxEntry {
+	entry := TxEntry{
+		Tx:        wtx.tx,
+		Priority:  wtx.priority,
+		Sender:    wtx.sender,
+		Nonce:     wtx.nonce,
+		GasWanted: wtx.gasWanted,
+		Height:    wtx.height,
+		Timestamp: wtx.timestamp,
+		Pending:   wtx.pending,
+		Evictable: isEvictable(wtx),
+	}
+	for peerID := range wtx.peers {
+		entry.Peers = append(entry.Peers, peerID)
+	}
+	sort.Slice(entry.Peers, func(i, j int) bool { return entry.Peers[i] < entry.Peers[j] })
+	return entry
+}
+
 // TxStore implements a thread-safe mapping of valid transaction(s).
 //
 // NOTE:
@@ -78,13 +160,23 @@ func (wtx *WrappedTx) Size() int {
 type TxStore struct {
 	mtx       sync.RWMutex
 	hashTxs   map[types.TxKey]*WrappedTx // primary index
-	senderTxs map[string]*WrappedTx      // sender is defined by the ABCI application
+	senderTxs map[string][]*WrappedTx    // sender is defined by the ABCI application; sorted by nonce
+
+	// peerTxs holds the transactions by the peer they were admitted from,
+	// other than UnknownPeerID. senderBytes and peerBytes hold the total size
+	// of the transactions of each sender and peer.
+	peerTxs     map[uint16]map[types.TxKey]*WrappedTx
+	senderBytes map[string]int64
+	peerBytes   map[uint16]int64
 }
 
 func NewTxStore() *TxStore {
 	return &TxStore{
-		senderTxs: make(map[string]*WrappedTx),
-		hashTxs:   make(map[types.TxKey]*WrappedTx),
+		senderTxs:   make(map[string][]*WrappedTx),
+		hashTxs:     make(map[types.TxKey]*WrappedTx),
+		peerTxs:     make(map[uint16]map[types.TxKey]*WrappedTx),
+		senderBytes: make(map[string]int64),
+		peerBytes:   make(map[uint16]int64),
 	}
 }
 
@@ -111,13 +203,106 @@ func (txs *TxStore) GetAllTxs() []*WrappedTx {
 	return wTxs
 }
 
-// GetTxBySender returns a *WrappedTx by the transaction's sender property
-// defined by the ABCI application.
+// GetTxBySender returns the *WrappedTx with the lowest nonce among those of
+// the given sender, as defined by the ABCI application.
 func (txs *TxStore) GetTxBySender(sender string) *WrappedTx {
 	txs.mtx.RLock()
 	defer txs.mtx.RUnlock()
 
-	return txs.senderTxs[sender]
+	if senderTxs := txs.senderTxs[sender]; len(senderTxs) > 0 {
+		return senderTxs[0]
+	}
+	return nil
+}
+
+// GetTxBySenderNonce returns the *WrappedTx of the given sender with the given
+// nonce, or nil if there is none.
+func (txs *TxStore) GetTxBySenderNonce(sender string, nonce uint64) *WrappedTx {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	senderTxs := txs.senderTxs[sender]
+	if i := searchNonce(senderTxs, nonce); i < len(senderTxs) && senderTxs[i].nonce == nonce {
+		return senderTxs[i]
+	}
+	return nil
+}
+
+// GetTxsBySender returns all the transactions of the given sender in
+// increasing nonce order.
+func (txs *TxStore) GetTxsBySender(sender string) []*WrappedTx {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	return append([]*WrappedTx(nil), txs.senderTxs[sender]...)
+}
+
+// GetTxsByPeer returns all the transactions admitted from the given peer.
+func (txs *TxStore) GetTxsByPeer(peerID uint16) []*WrappedTx {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	peerTxs := make([]*WrappedTx, 0, len(txs.peerTxs[peerID]))
+	for _, wtx := range txs.peerTxs[peerID] {
+		peerTxs = append(peerTxs, wtx)
+	}
+	return peerTxs
+}
+
+// SenderUsage returns the number and the total size of the transactions of
+// the given sender.
+func (txs *TxStore) SenderUsage(sender string) (int, int64) {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	return len(txs.senderTxs[sender]), txs.senderBytes[sender]
+}
+
+// PeerUsage returns the number and the total size of the transactions
+// admitted from the given peer.
+func (txs *TxStore) PeerUsage(peerID uint16) (int, int64) {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	return len(txs.peerTxs[peerID]), txs.peerBytes[peerID]
+}
+
+// MaxSenderUsage returns the largest number and the largest total size of the
+// transactions of a single sender, which may be of different senders.
+func (txs *TxStore) MaxSenderUsage() (int, int64) {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	var maxTxs int
+	var maxBytes int64
+	for sender, senderTxs := range txs.senderTxs {
+		if len(senderTxs) > maxTxs {
+			maxTxs = len(senderTxs)
+		}
+		if txs.senderBytes[sender] > maxBytes {
+			maxBytes = txs.senderBytes[sender]
+		}
+	}
+	return maxTxs, maxBytes
+}
+
+// MaxPeerUsage returns the largest number and the largest total size of the
+// transactions admitted from a single peer, which may be of different peers.
+func (txs *TxStore) MaxPeerUsage() (int, int64) {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	var maxTxs int
+	var maxBytes int64
+	for peerID, peerTxs := range txs.peerTxs {
+		if len(peerTxs) > maxTxs {
+			maxTxs = len(peerTxs)
+		}
+		if txs.peerBytes[peerID] > maxBytes {
+			maxBytes = txs.peerBytes[peerID]
+		}
+	}
+	return maxTxs, maxBytes
 }
 
 // GetTxByHash returns a *WrappedTx by the transaction's hash.
@@ -143,17 +328,42 @@ func (txs *TxStore) IsTxRemoved(hash types.TxKey) bool {
 }
 
 // SetTx stores a *WrappedTx by it's hash. If the transaction also contains a
-// non-empty sender, we additionally store the transaction by the sender as
-// defined by the ABCI application.
+// non-empty sender, we additionally store the transaction by the sender and
+// nonce as defined by the ABCI application, replacing any transaction of the
+// sender with the same nonce. The transaction is attributed to the peers it
+// has been received from so far, i.e. the peer it is admitted from.
 func (txs *TxStore) SetTx(wtx *WrappedTx) {
 	txs.mtx.Lock()
 	defer txs.mtx.Unlock()
 
+	key := wtx.tx.Key()
 	if len(wtx.sender) > 0 {
-		txs.senderTxs[wtx.sender] = wtx
+		senderTxs := txs.senderTxs[wtx.sender]
+		i := searchNonce(senderTxs, wtx.nonce)
+		if i < len(senderTxs) && senderTxs[i].nonce == wtx.nonce {
+			txs.senderBytes[wtx.sender] -= int64(senderTxs[i].Size())
+			senderTxs[i] = wtx
+		} else {
+			senderTxs = append(senderTxs, nil)
+			copy(senderTxs[i+1:], senderTxs[i:])
+			senderTxs[i] = wtx
+		}
+		txs.senderTxs[wtx.sender] = senderTxs
+		txs.senderBytes[wtx.sender] += int64(wtx.Size())
 	}
 
-	txs.hashTxs[wtx.tx.Key()] = wtx
+	for peerID := range wtx.peers {
+		if peerID == UnknownPeerID {
+			continue
+		}
+		if txs.peerTxs[peerID] == nil {
+			txs.peerTxs[peerID] = make(map[types.TxKey]*WrappedTx)
+		}
+		txs.peerTxs[peerID][key] = wtx
+		txs.peerBytes[peerID] += int64(wtx.Size())
+	}
+
+	txs.hashTxs[key] = wtx
 }
 
 // RemoveTx removes a *WrappedTx from the transaction store. It deletes all
@@ -162,14 +372,46 @@ func (txs *TxStore) RemoveTx(wtx *WrappedTx) {
 	txs.mtx.Lock()
 	defer txs.mtx.Unlock()
 
+	key := wtx.tx.Key()
 	if len(wtx.sender) > 0 {
-		delete(txs.senderTxs, wtx.sender)
+		senderTxs := txs.senderTxs[wtx.sender]
+		if i := searchNonce(senderTxs, wtx.nonce); i < len(senderTxs) && senderTxs[i] == wtx {
+			senderTxs = append(senderTxs[:i], senderTxs[i+1:]...)
+			txs.senderBytes[wtx.sender] -= int64(wtx.Size())
+		}
+		if len(senderTxs) == 0 {
+			delete(txs.senderTxs, wtx.sender)
+			delete(txs.senderBytes, wtx.sender)
+		} else {
+			txs.senderTxs[wtx.sender] = senderTxs
+		}
+	}
+
+	for peerID := range wtx.peers {
+		peerTxs := txs.peerTxs[peerID]
+		if peerTxs[key] != wtx {
+			continue
+		}
+		delete(peerTxs, key)
+		txs.peerBytes[peerID] -= int64(wtx.Size())
+		if len(peerTxs) == 0 {
+			delete(txs.peerTxs, peerID)
+			delete(txs.peerBytes, peerID)
+		}
 	}
 
-	delete(txs.hashTxs, wtx.tx.Key())
+	delete(txs.hashTxs, key)
 	wtx.removed = true
 }
 
+// searchNonce returns the index of the first transaction in senderTxs, which
+// must be sorted by nonce, whose nonce is at least nonce.
+func searchNonce(senderTxs []*WrappedTx, nonce uint64) int {
+	return sort.Search(len(senderTxs), func(i int) bool {
+		return senderTxs[i].nonce >= nonce
+	})
+}
+
 // TxHasPeer returns true if a transaction by hash has a given peer ID and false
 // otherwise. If the transaction does not exist, false is returned.
 func (txs *TxStore) TxHasPeer(hash types.TxKey, peerID uint16) bool {
@@ -185,6 +427,16 @@ func (txs *TxStore) TxHasPeer(hash types.TxKey, peerID uint16) bool {
 	return ok
 }
 
+// IsTxFromPeer returns true if a transaction by hash was admitted from the
+// given peer, and is therefore attributed to it, and false otherwise.
+func (txs *TxStore) IsTxFromPeer(hash types.TxKey, peerID uint16) bool {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	_, ok := txs.peerTxs[peerID][hash]
+	return ok
+}
+
 // GetOrSetPeerByTxHash looks up a WrappedTx by transaction hash and adds the
 // given peerID to the WrappedTx's set of peers that sent us this transaction.
 // We return true if we've already recorded the given peer for this transaction
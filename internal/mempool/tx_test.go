@@ -31,6 +31,97 @@ func TestTxStore_GetTxBySender(t *testing.T) {
 	require.Equal(t, wtx, res)
 }
 
+func TestTxStore_GetTxBySenderNonce(t *testing.T) {
+	txs := NewTxStore()
+	for _, nonce := range []uint64{2, 0, 1} {
+		txs.SetTx(&WrappedTx{
+			tx:        []byte(fmt.Sprintf("test_tx_%d", nonce)),
+			sender:    "foo",
+			nonce:     nonce,
+			timestamp: time.Now(),
+		})
+	}
+
+	senderTxs := txs.GetTxsBySender("foo")
+	require.Len(t, senderTxs, 3)
+	for i, wtx := range senderTxs {
+		require.Equal(t, uint64(i), wtx.nonce)
+	}
+	require.Equal(t, senderTxs[0], txs.GetTxBySender("foo"))
+	require.Equal(t, senderTxs[1], txs.GetTxBySenderNonce("foo", 1))
+	require.Nil(t, txs.GetTxBySenderNonce("foo", 3))
+	require.Nil(t, txs.GetTxBySenderNonce("bar", 1))
+
+	txs.RemoveTx(senderTxs[1])
+	require.Nil(t, txs.GetTxBySenderNonce("foo", 1))
+	require.Len(t, txs.GetTxsBySender("foo"), 2)
+
+	txs.RemoveTx(senderTxs[0])
+	txs.RemoveTx(senderTxs[2])
+	require.Nil(t, txs.GetTxBySender("foo"))
+	require.Empty(t, txs.senderTxs)
+}
+
+func TestTxStore_Usage(t *testing.T) {
+	txs := NewTxStore()
+	wtxs := []*WrappedTx{
+		{tx: []byte("test_tx_0"), sender: "foo", nonce: 0, peers: map[uint16]struct{}{1: {}}},
+		{tx: []byte("test_tx_10"), sender: "foo", nonce: 1, peers: map[uint16]struct{}{2: {}}},
+		{tx: []byte("test_tx_200"), sender: "bar", peers: map[uint16]struct{}{1: {}}},
+		{tx: []byte("test_tx_3000"), sender: "baz", peers: map[uint16]struct{}{UnknownPeerID: {}}},
+	}
+	for _, wtx := range wtxs {
+		wtx.hash = wtx.tx.Key()
+		txs.SetTx(wtx)
+	}
+
+	usage := func(numTxs int, txsBytes int64) []interface{} {
+		return []interface{}{numTxs, txsBytes}
+	}
+	sender := func(sender string) []interface{} {
+		numTxs, txsBytes := txs.SenderUsage(sender)
+		return usage(numTxs, txsBytes)
+	}
+	peer := func(peerID uint16) []interface{} {
+		numTxs, txsBytes := txs.PeerUsage(peerID)
+		return usage(numTxs, txsBytes)
+	}
+	maxSender := func() []interface{} {
+		numTxs, txsBytes := txs.MaxSenderUsage()
+		return usage(numTxs, txsBytes)
+	}
+	maxPeer := func() []interface{} {
+		numTxs, txsBytes := txs.MaxPeerUsage()
+		return usage(numTxs, txsBytes)
+	}
+
+	require.Equal(t, usage(2, 19), sender("foo"))
+	require.Equal(t, usage(1, 11), sender("bar"))
+	require.Equal(t, usage(2, 20), peer(1))
+	require.Equal(t, usage(1, 10), peer(2))
+	require.Equal(t, usage(0, 0), peer(UnknownPeerID))
+	require.Equal(t, usage(2, 19), maxSender())
+	require.Equal(t, usage(2, 20), maxPeer())
+	require.True(t, txs.IsTxFromPeer(wtxs[0].hash, 1))
+	require.False(t, txs.IsTxFromPeer(wtxs[0].hash, 2))
+
+	// Peers relaying a transaction later are not charged for it.
+	txs.GetOrSetPeerByTxHash(wtxs[1].hash, 1)
+	require.Equal(t, usage(2, 20), peer(1))
+	require.ElementsMatch(t, []*WrappedTx{wtxs[0], wtxs[2]}, txs.GetTxsByPeer(1))
+
+	txs.RemoveTx(wtxs[0])
+	txs.RemoveTx(wtxs[1])
+	require.Equal(t, usage(0, 0), sender("foo"))
+	require.Equal(t, usage(1, 11), peer(1))
+	require.Equal(t, usage(0, 0), peer(2))
+	// The largest usages may be of different senders.
+	require.Equal(t, usage(1, 12), maxSender())
+	require.Equal(t, usage(1, 11), maxPeer())
+	require.Len(t, txs.peerTxs, 1)
+	require.Len(t, txs.senderBytes, 2)
+}
+
 func TestTxStore_GetTxByHash(t *testing.T) {
 	txs := NewTxStore()
 	wtx := &WrappedTx{
@@ -42,6 +42,14 @@ type Mempool interface {
 
 	GetTxsForKeys(txKeys []types.TxKey) types.Txs
 
+	// GetTxEntries returns the transactions in the mempool selected by filter,
+	// along with their metadata, in descending priority order.
+	GetTxEntries(filter TxFilter) []TxEntry
+
+	// GetTxEntry returns the transaction with the given key along with its
+	// metadata, and reports whether it is in the mempool.
+	GetTxEntry(txKey types.TxKey) (TxEntry, bool)
+
 	// ReapMaxBytesMaxGas reaps transactions from the mempool up to maxBytes
 	// bytes total with the condition that the total gasWanted must be less than
 	// maxGas.
@@ -6,6 +6,11 @@
 //
 //	abci.invoice.number = 22 AND abci.invoice.owner = 'Ivan'
 //
+// Conditions may be combined with AND, OR and NOT, and grouped with
+// parentheses:
+//
+//	tm.event = 'Tx' AND (transfer.sender = 'Ivan' OR transfer.recipient = 'Ivan')
+//
 // Query expressions can handle attribute values encoding numbers, strings,
 // dates, and timestamps.  The complete query grammar is described in the
 // query/syntax package.
@@ -27,17 +32,18 @@ var All *Query
 
 // A Query is the compiled form of a query.
 type Query struct {
-	ast   syntax.Query
-	conds []condition
+	expr syntax.Expr
+	ast  syntax.Query // nil if expr is not a conjunction of conditions
+	root node
 }
 
 // New parses and compiles the query expression into an executable query.
 func New(query string) (*Query, error) {
-	ast, err := syntax.Parse(query)
+	expr, err := syntax.ParseExpr(query)
 	if err != nil {
 		return nil, err
 	}
-	return Compile(ast)
+	return CompileExpr(expr)
 }
 
 // MustCompile compiles the query expression into an executable query.
@@ -55,15 +61,18 @@ func MustCompile(query string) *Query {
 
 // Compile compiles the given query AST so it can be used to match events.
 func Compile(ast syntax.Query) (*Query, error) {
-	conds := make([]condition, len(ast))
-	for i, q := range ast {
-		cond, err := compileCondition(q)
-		if err != nil {
-			return nil, fmt.Errorf("compile %s: %w", q, err)
-		}
-		conds[i] = cond
+	return CompileExpr(ast.Expr())
+}
+
+// CompileExpr compiles the given query expression so it can be used to match
+// events.
+func CompileExpr(expr syntax.Expr) (*Query, error) {
+	root, err := compileNode(expr)
+	if err != nil {
+		return nil, err
 	}
-	return &Query{ast: ast, conds: conds}, nil
+	ast, _ := expr.Conditions()
+	return &Query{expr: expr, ast: ast, root: root}, nil
 }
 
 // Matches reports whether q matches the given events. If q == nil, the query
@@ -72,12 +81,7 @@ func (q *Query) Matches(events []types.Event) bool {
 	if q == nil {
 		return true
 	}
-	for _, cond := range q.conds {
-		if !cond.matchesAny(events) {
-			return false
-		}
-	}
-	return len(events) != 0
+	return q.root.matches(events) && len(events) != 0
 }
 
 // String matches part of the pubsub.Query interface.
@@ -85,10 +89,11 @@ func (q *Query) String() string {
 	if q == nil {
 		return "<empty>"
 	}
-	return q.ast.String()
+	return q.expr.String()
 }
 
-// Syntax returns the syntax tree representation of q.
+// Syntax returns the syntax tree representation of q. It returns nil if q is
+// not a conjunction of conditions; use Expr to inspect such queries.
 func (q *Query) Syntax() syntax.Query {
 	if q == nil {
 		return nil
@@ -96,6 +101,81 @@ func (q *Query) Syntax() syntax.Query {
 	return q.ast
 }
 
+// Expr returns the expression tree representation of q.
+func (q *Query) Expr() syntax.Expr {
+	if q == nil {
+		return syntax.Expr{}
+	}
+	return q.expr
+}
+
+// A node is a compiled query expression. A leaf node matches events with its
+// condition; an inner node combines the matches of its operands.
+type node struct {
+	op   syntax.Token // TAnd, TOr or TNot, for an inner node
+	cond *condition   // the condition of a leaf node
+	args []node
+}
+
+func compileNode(expr syntax.Expr) (node, error) {
+	if expr.Cond != nil {
+		cond, err := compileCondition(*expr.Cond)
+		if err != nil {
+			return node{}, fmt.Errorf("compile %s: %w", expr.Cond, err)
+		}
+		return node{cond: &cond}, nil
+	}
+
+	switch expr.Op {
+	case syntax.TAnd, syntax.TOr:
+		if len(expr.Args) == 0 {
+			return node{}, fmt.Errorf("missing operands for %v", expr.Op)
+		}
+	case syntax.TNot:
+		if len(expr.Args) != 1 {
+			return node{}, fmt.Errorf("%v requires one operand, got %d", expr.Op, len(expr.Args))
+		}
+	default:
+		return node{}, fmt.Errorf("unknown expression operator %v", expr.Op)
+	}
+	out := node{op: expr.Op, args: make([]node, len(expr.Args))}
+	for i, arg := range expr.Args {
+		n, err := compileNode(arg)
+		if err != nil {
+			return node{}, err
+		}
+		out.args[i] = n
+	}
+	return out, nil
+}
+
+// matches reports whether n matches the given events.
+func (n node) matches(events []types.Event) bool {
+	if n.cond != nil {
+		return n.cond.matchesAny(events)
+	}
+	switch n.op {
+	case syntax.TAnd:
+		for _, arg := range n.args {
+			if !arg.matches(events) {
+				return false
+			}
+		}
+		return true
+	case syntax.TOr:
+		for _, arg := range n.args {
+			if arg.matches(events) {
+				return true
+			}
+		}
+		return false
+	case syntax.TNot:
+		return !n.args[0].matches(events)
+	default:
+		return false
+	}
+}
+
 // A condition is a compiled match condition.  A condition matches an event if
 // the event has the designated type, contains an attribute with the given
 // name, and the match function returns true for the attribute value.
@@ -165,11 +245,48 @@ func compileCondition(cond syntax.Condition) (condition, error) {
 		return out, nil
 	}
 
+	// IN matches a value equal to any of its arguments.
+	if cond.Op == syntax.TIn {
+		if len(cond.List) == 0 {
+			return condition{}, fmt.Errorf("missing arguments for %v", cond.Op)
+		}
+		matches := make([]func(string) bool, len(cond.List))
+		for i, arg := range cond.List {
+			c, err := compileCondition(syntax.Condition{Tag: cond.Tag, Op: syntax.TEq, Arg: arg})
+			if err != nil {
+				return condition{}, err
+			}
+			matches[i] = c.match
+		}
+		out.match = func(s string) bool {
+			for _, match := range matches {
+				if match(s) {
+					return true
+				}
+			}
+			return false
+		}
+		return out, nil
+	}
+
 	// All the other operators require an argument.
 	if cond.Arg == nil {
 		return condition{}, fmt.Errorf("missing argument for %v", cond.Op)
 	}
 
+	// Patterns are compiled here, so that invalid ones are reported.
+	if cond.Op == syntax.TMatches {
+		if cond.Arg.Type != syntax.TString {
+			return condition{}, fmt.Errorf("invalid op/arg combination (%v, %v)", cond.Op, cond.Arg.Type)
+		}
+		re, err := regexp.Compile(cond.Arg.Value())
+		if err != nil {
+			return condition{}, fmt.Errorf("invalid pattern: %w", err)
+		}
+		out.match = re.MatchString
+		return out, nil
+	}
+
 	// Precompile the argument value matcher.
 	argType := cond.Arg.Type
 	var argValue interface{}
@@ -216,6 +333,13 @@ var opTypeMap = map[syntax.Token]map[syntax.Token]func(interface{}) func(string)
 			}
 		},
 	},
+	syntax.TStartsWith: {
+		syntax.TString: func(v interface{}) func(string) bool {
+			return func(s string) bool {
+				return strings.HasPrefix(s, v.(string))
+			}
+		},
+	},
 	syntax.TEq: {
 		syntax.TString: func(v interface{}) func(string) bool {
 			return func(s string) bool { return s == v.(string) }
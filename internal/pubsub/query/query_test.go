@@ -183,6 +183,48 @@ func TestCompiledMatches(t *testing.T) {
 			apiEvents, false},
 		{`tm.event = 'Tx' AND rewards.withdraw.source = 'W'`,
 			apiEvents, false},
+
+		{`transfer.sender = 'AddrZ' OR transfer.recipient = 'AddrD'`,
+			apiEvents, true},
+		{`transfer.sender = 'AddrZ' OR transfer.recipient = 'AddrZ'`,
+			apiEvents, false},
+		{`NOT transfer.sender = 'AddrZ'`,
+			apiEvents, true},
+		{`NOT transfer.sender = 'AddrC'`,
+			apiEvents, false},
+		{`NOT slash.reason EXISTS`,
+			apiEvents, true},
+		{`tm.event = 'Tx' AND (transfer.sender = 'AddrZ' OR rewards.withdraw.amount > 90)`,
+			apiEvents, true},
+		{`tm.event = 'Tx' AND NOT (transfer.sender = 'AddrZ' OR rewards.withdraw.amount > 90)`,
+			apiEvents, false},
+		{`tm.event = 'NewBlock' OR transfer.amount = 160 AND NOT tm.height > 5`,
+			apiEvents, true},
+		{`(tm.event = 'NewBlock' OR transfer.amount = 160) AND tm.height > 5`,
+			apiEvents, false},
+		{`NOT transfer.sender = 'AddrZ'`,
+			nil, false},
+
+		{`transfer.sender IN ('AddrA', 'AddrC')`,
+			apiEvents, true},
+		{`transfer.sender IN ('AddrA', 'AddrB')`,
+			apiEvents, false},
+		{`rewards.withdraw.amount IN (10, 45)`,
+			apiEvents, true},
+		{`tm.height IN (4, 6)`,
+			apiEvents, false},
+		{`rewards.withdraw.source STARTS_WITH 'Src'`,
+			apiEvents, true},
+		{`rewards.withdraw.source STARTS_WITH 'X'`,
+			apiEvents, false},
+		{`rewards.withdraw.address MATCHES '^Addr[AB]$'`,
+			apiEvents, true},
+		{`rewards.withdraw.address MATCHES 'ddr'`,
+			apiEvents, true},
+		{`rewards.withdraw.address MATCHES '^ddr'`,
+			apiEvents, false},
+		{`tm.event = 'Tx' AND NOT transfer.recipient MATCHES '^Addr[A-C]$'`,
+			apiEvents, true},
 	}
 
 	// NOTE: The original implementation allowed arbitrary prefix matches on
@@ -216,6 +258,34 @@ func TestCompiledMatches(t *testing.T) {
 	}
 }
 
+func TestCompileExpr(t *testing.T) {
+	expr, err := syntax.ParseExpr(`a.x = 1 OR NOT (a.y EXISTS AND a.z < 5)`)
+	if err != nil {
+		t.Fatalf("ParseExpr: unexpected error: %v", err)
+	}
+	q, err := query.CompileExpr(expr)
+	if err != nil {
+		t.Fatalf("CompileExpr: unexpected error: %v", err)
+	}
+	if got, want := q.String(), expr.String(); got != want {
+		t.Errorf("String: got %#q, want %#q", got, want)
+	}
+	if ast := q.Syntax(); ast != nil {
+		t.Errorf("Syntax: got %#q, want nil", ast)
+	}
+
+	// A conjunction is also available as a list of conditions.
+	q = query.MustCompile(`a.x = 1 AND (a.y EXISTS AND a.z < 5)`)
+	if got, want := q.Syntax().String(), `a.x = 1 AND a.y EXISTS AND a.z < 5`; got != want {
+		t.Errorf("Syntax: got %#q, want %#q", got, want)
+	}
+
+	// Invalid conditions are reported wherever they appear.
+	if q, err := query.New(`a.x = 1 OR NOT a.y CONTAINS 5`); err == nil {
+		t.Errorf("New: got %#q, want error", q)
+	}
+}
+
 func TestAllMatchesAll(t *testing.T) {
 	events := newTestEvents(
 		``,
@@ -5,13 +5,19 @@
 //
 // The grammar of the query language is defined by the following EBNF:
 //
-//   query      = conditions EOF
-//   conditions = condition {"AND" condition}
+//   query      = expr EOF
+//   expr       = term {"OR" term}
+//   term       = factor {"AND" factor}
+//   factor     = "NOT" factor / "(" expr ")" / condition
 //   condition  = tag comparison
-//   comparison = equal / order / contains / "EXISTS"
-//   equal      = "=" (date / number / time / value)
+//   comparison = equal / order / contains / prefix / match / in / "EXISTS"
+//   equal      = "=" operand
 //   order      = cmp (date / number / time)
 //   contains   = "CONTAINS" value
+//   prefix     = "STARTS_WITH" value
+//   match      = "MATCHES" value
+//   in         = "IN" "(" operand {"," operand} ")"
+//   operand    = date / number / time / value
 //   cmp        = "<" / "<=" / ">" / ">="
 //
 // The lexical terms are defined here using RE2 regular expression notation:
@@ -31,4 +37,16 @@
 //   // A quoted literal string value ('a b c')
 //   value  = #'\'[^\']*\''
 //
+// The argument of MATCHES is a regular expression in RE2 syntax, which matches
+// a value if it matches any part of it; use ^ and $ to match the whole value.
+// IN matches a value equal to any of its operands.
+//
+// NOT binds more tightly than AND, which binds more tightly than OR, so that
+//
+//   a.x = 1 OR NOT a.y = 2 AND a.z EXISTS
+//
+// is equivalent to
+//
+//   a.x = 1 OR ((NOT a.y = 2) AND a.z EXISTS)
+//
 package syntax
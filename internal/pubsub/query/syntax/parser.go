@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,12 @@ func Parse(s string) (Query, error) {
 	return NewParser(strings.NewReader(s)).Parse()
 }
 
+// ParseExpr parses the specified query expression string. It is shorthand for
+// constructing a parser for s and calling its ParseExpr method.
+func ParseExpr(s string) (Expr, error) {
+	return NewParser(strings.NewReader(s)).ParseExpr()
+}
+
 // Query is the root of the parse tree for a query.  A query is the conjunction
 // of one or more conditions.
 type Query []Condition
@@ -27,19 +34,96 @@ func (q Query) String() string {
 	return strings.Join(ss, " AND ")
 }
 
+// Expr returns the expression tree equivalent to q.
+func (q Query) Expr() Expr {
+	if len(q) == 1 {
+		return Expr{Cond: &q[0]}
+	}
+	args := make([]Expr, len(q))
+	for i := range q {
+		args[i] = Expr{Cond: &q[i]}
+	}
+	return Expr{Op: TAnd, Args: args}
+}
+
+// An Expr is a node in the parse tree of a query expression. A leaf node holds
+// a single condition. An inner node combines its operands with the AND or OR
+// operator, or negates its only operand with the NOT operator.
+type Expr struct {
+	Op   Token      // TAnd, TOr or TNot, for an inner node
+	Cond *Condition // the condition of a leaf node
+	Args []Expr     // the operands of an inner node
+}
+
+// Conditions returns the conditions of e and true if e is a conjunction of
+// conditions, so that it can be represented as a Query. Otherwise it returns
+// nil and false.
+func (e Expr) Conditions() (Query, bool) {
+	if e.Cond != nil {
+		return Query{*e.Cond}, true
+	}
+	if e.Op != TAnd {
+		return nil, false
+	}
+	var q Query
+	for _, arg := range e.Args {
+		conds, ok := arg.Conditions()
+		if !ok {
+			return nil, false
+		}
+		q = append(q, conds...)
+	}
+	return q, true
+}
+
+func (e Expr) String() string {
+	if e.Cond != nil {
+		return e.Cond.String()
+	}
+	switch e.Op {
+	case TNot:
+		if arg := e.Args[0]; arg.Cond == nil && arg.Op != TNot {
+			return "NOT (" + arg.String() + ")"
+		}
+		return "NOT " + e.Args[0].String()
+	case TAnd, TOr:
+		ss := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			ss[i] = arg.String()
+			if e.Op == TAnd && arg.Cond == nil && arg.Op == TOr {
+				ss[i] = "(" + ss[i] + ")"
+			}
+		}
+		if e.Op == TAnd {
+			return strings.Join(ss, " AND ")
+		}
+		return strings.Join(ss, " OR ")
+	default:
+		return ""
+	}
+}
+
 // A Condition is a single conditional expression, consisting of a tag, a
 // comparison operator, and an optional argument. The type of the argument
-// depends on the operator.
+// depends on the operator. The IN operator takes a list of arguments instead.
 type Condition struct {
-	Tag string
-	Op  Token
-	Arg *Arg
+	Tag  string
+	Op   Token
+	Arg  *Arg
+	List []*Arg // the arguments of IN
 
 	opText string
 }
 
 func (c Condition) String() string {
 	s := c.Tag + " " + c.opText
+	if c.Op == TIn {
+		ss := make([]string, len(c.List))
+		for i, arg := range c.List {
+			ss[i] = arg.String()
+		}
+		return s + " (" + strings.Join(ss, ", ") + ")"
+	}
 	if c.Arg != nil {
 		return s + " " + c.Arg.String()
 	}
@@ -113,6 +197,7 @@ func (a *Arg) Value() string {
 // defined in the syntax package documentation.
 type Parser struct {
 	scanner *Scanner
+	eof     bool // the scanner has reached the end of the input
 }
 
 // NewParser constructs a new parser that reads the input from r.
@@ -120,34 +205,115 @@ func NewParser(r io.Reader) *Parser {
 	return &Parser{scanner: NewScanner(r)}
 }
 
-// Parse parses the complete input and returns the resulting query.
+// Parse parses the complete input and returns the resulting query. It reports
+// an error if the input is not a conjunction of conditions; use ParseExpr to
+// parse queries using the OR and NOT operators.
 func (p *Parser) Parse() (Query, error) {
-	cond, err := p.parseCond()
+	expr, err := p.ParseExpr()
 	if err != nil {
 		return nil, err
 	}
-	conds := []Condition{cond}
-	for p.scanner.Next() != io.EOF {
-		if tok := p.scanner.Token(); tok != TAnd {
-			return nil, fmt.Errorf("offset %d: got %v, want %v", p.scanner.Pos(), tok, TAnd)
+	q, ok := expr.Conditions()
+	if !ok {
+		return nil, fmt.Errorf("query %q is not a conjunction of conditions", expr)
+	}
+	return q, nil
+}
+
+// ParseExpr parses the complete input and returns the resulting expression.
+func (p *Parser) ParseExpr() (Expr, error) {
+	if err := p.next(); err != nil {
+		return Expr{}, err
+	}
+	expr, err := p.parseBinary(TOr, p.parseTerm)
+	if err != nil {
+		return Expr{}, err
+	}
+	if !p.eof {
+		return Expr{}, fmt.Errorf("offset %d: got %v, wanted %s",
+			p.scanner.Pos(), p.scanner.Token(), tokLabel([]Token{TAnd, TOr}))
+	}
+	return expr, nil
+}
+
+// parseTerm parses a conjunction of factors: factor {AND factor}.
+func (p *Parser) parseTerm() (Expr, error) {
+	return p.parseBinary(TAnd, p.parseFactor)
+}
+
+// parseBinary parses one or more operands separated by the operator op, and
+// combines them into a single expression. Nested expressions with the same
+// operator are flattened into their parent.
+func (p *Parser) parseBinary(op Token, parseArg func() (Expr, error)) (Expr, error) {
+	var args []Expr
+	for {
+		arg, err := parseArg()
+		if err != nil {
+			return Expr{}, err
+		}
+		if arg.Cond == nil && arg.Op == op {
+			args = append(args, arg.Args...)
+		} else {
+			args = append(args, arg)
+		}
+		if !p.at(op) {
+			break
+		}
+		if err := p.next(); err != nil {
+			return Expr{}, err
+		}
+	}
+	if len(args) == 1 {
+		return args[0], nil
+	}
+	return Expr{Op: op, Args: args}, nil
+}
+
+// parseFactor parses a negated factor, a parenthesized expression, or a
+// condition.
+func (p *Parser) parseFactor() (Expr, error) {
+	switch {
+	case p.at(TNot):
+		if err := p.next(); err != nil {
+			return Expr{}, err
+		}
+		arg, err := p.parseFactor()
+		if err != nil {
+			return Expr{}, err
+		}
+		return Expr{Op: TNot, Args: []Expr{arg}}, nil
+
+	case p.at(TLParen):
+		if err := p.next(); err != nil {
+			return Expr{}, err
 		}
+		expr, err := p.parseBinary(TOr, p.parseTerm)
+		if err != nil {
+			return Expr{}, err
+		}
+		if !p.at(TRParen) {
+			return Expr{}, p.unexpected(TRParen)
+		}
+		return expr, p.next()
+
+	default:
 		cond, err := p.parseCond()
 		if err != nil {
-			return nil, err
+			return Expr{}, err
 		}
-		conds = append(conds, cond)
+		return Expr{Cond: &cond}, p.next()
 	}
-	return conds, nil
 }
 
-// parseCond parses a conditional expression: tag OP value.
+// parseCond parses a conditional expression: tag OP value. The tag must be the
+// current token.
 func (p *Parser) parseCond() (Condition, error) {
 	var cond Condition
-	if err := p.require(TTag); err != nil {
-		return cond, err
+	if !p.at(TTag) {
+		return cond, p.unexpected(TTag, TNot, TLParen)
 	}
 	cond.Tag = p.scanner.Text()
-	if err := p.require(TLeq, TGeq, TLt, TGt, TEq, TContains, TExists); err != nil {
+	if err := p.require(TLeq, TGeq, TLt, TGt, TEq, TContains, TStartsWith, TMatches, TIn, TExists); err != nil {
 		return cond, err
 	}
 	cond.Op = p.scanner.Token()
@@ -159,8 +325,18 @@ func (p *Parser) parseCond() (Condition, error) {
 		err = p.require(TNumber, TTime, TDate)
 	case TEq:
 		err = p.require(TNumber, TTime, TDate, TString)
-	case TContains:
+	case TContains, TStartsWith:
 		err = p.require(TString)
+	case TMatches:
+		if err := p.require(TString); err != nil {
+			return cond, err
+		}
+		if _, err := regexp.Compile(p.scanner.Text()); err != nil {
+			return cond, fmt.Errorf("offset %d: invalid pattern: %w", p.scanner.Pos(), err)
+		}
+	case TIn:
+		cond.List, err = p.parseList()
+		return cond, err
 	case TExists:
 		// no argument
 		return cond, nil
@@ -174,6 +350,53 @@ func (p *Parser) parseCond() (Condition, error) {
 	return cond, nil
 }
 
+// parseList parses the parenthesized, comma-separated arguments of IN.
+func (p *Parser) parseList() ([]*Arg, error) {
+	if err := p.require(TLParen); err != nil {
+		return nil, err
+	}
+	var list []*Arg
+	for {
+		if err := p.require(TNumber, TTime, TDate, TString); err != nil {
+			return nil, err
+		}
+		list = append(list, &Arg{Type: p.scanner.Token(), text: p.scanner.Text()})
+		if err := p.require(TComma, TRParen); err != nil {
+			return nil, err
+		}
+		if p.scanner.Token() == TRParen {
+			return list, nil
+		}
+	}
+}
+
+// next advances the scanner to the next token, if any, and records whether
+// the end of the input has been reached.
+func (p *Parser) next() error {
+	err := p.scanner.Next()
+	if err == io.EOF {
+		p.eof = true
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("offset %d: %w", p.scanner.Pos(), err)
+	}
+	return nil
+}
+
+// at reports whether the current token has type tok.
+func (p *Parser) at(tok Token) bool {
+	return !p.eof && p.scanner.Token() == tok
+}
+
+// unexpected reports that the current token is not one of the specified token
+// types.
+func (p *Parser) unexpected(tokens ...Token) error {
+	if p.eof {
+		return fmt.Errorf("offset %d: unexpected end of input, wanted %s", p.scanner.Pos(), tokLabel(tokens))
+	}
+	return fmt.Errorf("offset %d: got %v, wanted %s", p.scanner.Pos(), p.scanner.Token(), tokLabel(tokens))
+}
+
 // require advances the scanner and requires that the resulting token is one of
 // the specified token types.
 func (p *Parser) require(tokens ...Token) error {
@@ -30,23 +30,40 @@ const (
 	TGeq             // operator: >=
 
 	// Do not reorder these values without updating the scanner code.
+
+	TOr         // operator: OR
+	TNot        // operator: NOT
+	TLParen     // group: (
+	TRParen     // group: )
+	TIn         // operator: IN
+	TStartsWith // operator: STARTS_WITH
+	TMatches    // operator: MATCHES
+	TComma      // list separator: ,
 )
 
 var tString = [...]string{
-	TInvalid:  "invalid token",
-	TTag:      "tag",
-	TString:   "string",
-	TNumber:   "number",
-	TTime:     "timestamp",
-	TDate:     "datestamp",
-	TAnd:      "AND operator",
-	TContains: "CONTAINS operator",
-	TExists:   "EXISTS operator",
-	TEq:       "= operator",
-	TLt:       "< operator",
-	TLeq:      "<= operator",
-	TGt:       "> operator",
-	TGeq:      ">= operator",
+	TInvalid:    "invalid token",
+	TTag:        "tag",
+	TString:     "string",
+	TNumber:     "number",
+	TTime:       "timestamp",
+	TDate:       "datestamp",
+	TAnd:        "AND operator",
+	TContains:   "CONTAINS operator",
+	TExists:     "EXISTS operator",
+	TEq:         "= operator",
+	TLt:         "< operator",
+	TLeq:        "<= operator",
+	TGt:         "> operator",
+	TGeq:        ">= operator",
+	TOr:         "OR operator",
+	TNot:        "NOT operator",
+	TLParen:     "(",
+	TRParen:     ")",
+	TIn:         "IN operator",
+	TStartsWith: "STARTS_WITH operator",
+	TMatches:    "MATCHES operator",
+	TComma:      ",",
 }
 
 func (t Token) String() string {
@@ -107,6 +124,18 @@ func (s *Scanner) Next() error {
 			return s.scanString(ch)
 		case '<', '>', '=':
 			return s.scanCompare(ch)
+		case '(':
+			s.buf.WriteRune(ch)
+			s.tok = TLParen
+			return nil
+		case ')':
+			s.buf.WriteRune(ch)
+			s.tok = TRParen
+			return nil
+		case ',':
+			s.buf.WriteRune(ch)
+			s.tok = TComma
+			return nil
 		default:
 			return s.invalid(ch)
 		}
@@ -224,10 +253,20 @@ func (s *Scanner) scanTagLike(first rune) error {
 		s.tok = TTag
 	case "AND":
 		s.tok = TAnd
+	case "OR":
+		s.tok = TOr
+	case "NOT":
+		s.tok = TNot
 	case "EXISTS":
 		s.tok = TExists
 	case "CONTAINS":
 		s.tok = TContains
+	case "IN":
+		s.tok = TIn
+	case "STARTS_WITH":
+		s.tok = TStartsWith
+	case "MATCHES":
+		s.tok = TMatches
 	default:
 		s.tok = TTag
 	}
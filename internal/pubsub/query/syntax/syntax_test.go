@@ -39,7 +39,15 @@ func TestScanner(t *testing.T) {
 		{`x AND y`, []syntax.Token{syntax.TTag, syntax.TAnd, syntax.TTag}},
 		{`x.y CONTAINS 'z'`, []syntax.Token{syntax.TTag, syntax.TContains, syntax.TString}},
 		{`foo EXISTS`, []syntax.Token{syntax.TTag, syntax.TExists}},
+		{`x IN (1, 'y')`, []syntax.Token{
+			syntax.TTag, syntax.TIn, syntax.TLParen, syntax.TNumber, syntax.TComma, syntax.TString, syntax.TRParen,
+		}},
+		{`x STARTS_WITH 'y'`, []syntax.Token{syntax.TTag, syntax.TStartsWith, syntax.TString}},
+		{`x MATCHES 'y+'`, []syntax.Token{syntax.TTag, syntax.TMatches, syntax.TString}},
 		{`and AND`, []syntax.Token{syntax.TTag, syntax.TAnd}},
+		{`NOT (x OR y)`, []syntax.Token{
+			syntax.TNot, syntax.TLParen, syntax.TTag, syntax.TOr, syntax.TTag, syntax.TRParen,
+		}},
 
 		// Timestamp
 		{`TIME 2021-11-23T15:16:17Z`, []syntax.Token{syntax.TTime}},
@@ -167,6 +175,24 @@ func TestParseValid(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"coin.denom IN ('usei', 'uatom')", true},
+		{"coin.amount IN (1,2.5,3)", true},
+		{"tx.date IN (DATE 2013-05-03, TIME 2013-05-03T14:45:00Z)", true},
+		{"coin.denom IN ('usei')", true},
+		{"coin.denom IN ()", false},
+		{"coin.denom IN 'usei'", false},
+		{"coin.denom IN ('usei',)", false},
+		{"coin.denom IN ('usei' 'uatom')", false},
+		{"coin.denom IN ('usei'", false},
+
+		{"contract.address STARTS_WITH 'sei1'", true},
+		{"contract.address STARTS_WITH 1", false},
+		{"contract.address STARTS_WITH", false},
+
+		{"contract.address MATCHES '^sei1[a-z0-9]+$'", true},
+		{"contract.address MATCHES 'a(b'", false},
+		{"contract.address MATCHES 5", false},
 	}
 
 	for _, test := range tests {
@@ -188,3 +214,89 @@ func TestParseValid(t *testing.T) {
 		}
 	}
 }
+
+func TestParseExpr(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string // the canonical form, or "" if the input is invalid
+	}{
+		{"a.x = 1", "a.x = 1"},
+		{"a.x = 1 OR a.y = 2", "a.x = 1 OR a.y = 2"},
+		{"NOT a.x = 1", "NOT a.x = 1"},
+		{"NOT NOT a.x = 1", "NOT NOT a.x = 1"},
+		{"(a.x = 1)", "a.x = 1"},
+		{"((a.x = 1))", "a.x = 1"},
+		{"a.x = 1 OR a.y = 2 AND a.z = 3", "a.x = 1 OR a.y = 2 AND a.z = 3"},
+		{"(a.x = 1 OR a.y = 2) AND a.z = 3", "(a.x = 1 OR a.y = 2) AND a.z = 3"},
+		{"a.x = 1 AND (a.y = 2 AND a.z = 3)", "a.x = 1 AND a.y = 2 AND a.z = 3"},
+		{"(a.x = 1 OR a.y = 2) OR a.z = 3", "a.x = 1 OR a.y = 2 OR a.z = 3"},
+		{"NOT (a.x = 1 OR a.y EXISTS)", "NOT (a.x = 1 OR a.y EXISTS)"},
+		{"NOT (a.x = 1 AND a.y = 2)", "NOT (a.x = 1 AND a.y = 2)"},
+		{"NOT a.x = 1 AND a.y CONTAINS 'z'", "NOT a.x = 1 AND a.y CONTAINS 'z'"},
+		{"a.x>=DATE 2021-11-23 OR(a.y<5)", "a.x >= DATE 2021-11-23 OR a.y < 5"},
+
+		{"", ""},
+		{"()", ""},
+		{"(a.x = 1", ""},
+		{"a.x = 1)", ""},
+		{"a.x = 1 OR", ""},
+		{"OR a.x = 1", ""},
+		{"NOT", ""},
+		{"a.x NOT = 1", ""},
+		{"a.x = 1 NOT a.y = 2", ""},
+		{"a.x = 1 AND OR a.y = 2", ""},
+		{"(a.x = 1) (a.y = 2)", ""},
+	}
+	for _, test := range tests {
+		expr, err := syntax.ParseExpr(test.input)
+		if test.want == "" {
+			if err == nil {
+				t.Errorf("ParseExpr %#q: got %#q, want error", test.input, expr)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("ParseExpr %#q: unexpected error: %v", test.input, err)
+			continue
+		}
+		if got := expr.String(); got != test.want {
+			t.Errorf("ParseExpr %#q: got %#q, want %#q", test.input, got, test.want)
+		}
+
+		// Check that the expression round-trips.
+		r, err := syntax.ParseExpr(expr.String())
+		if err != nil {
+			t.Errorf("Reparse %#q failed: %v", expr, err)
+		} else if !reflect.DeepEqual(r, expr) {
+			t.Errorf("Reparse diff\nold: %+v\nnew: %+v", expr, r)
+		}
+	}
+}
+
+func TestExprConditions(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string // the conjunction, or "" if there is none
+	}{
+		{"a.x = 1", "a.x = 1"},
+		{"a.x = 1 AND (a.y = 2 AND a.z EXISTS)", "a.x = 1 AND a.y = 2 AND a.z EXISTS"},
+		{"a.x = 1 OR a.y = 2", ""},
+		{"a.x = 1 AND NOT a.y = 2", ""},
+	}
+	for _, test := range tests {
+		expr, err := syntax.ParseExpr(test.input)
+		if err != nil {
+			t.Fatalf("ParseExpr %#q: unexpected error: %v", test.input, err)
+		}
+		q, ok := expr.Conditions()
+		if ok != (test.want != "") {
+			t.Errorf("Conditions %#q: got ok=%v, want %v", test.input, ok, !ok)
+		} else if ok && q.String() != test.want {
+			t.Errorf("Conditions %#q: got %#q, want %#q", test.input, q, test.want)
+		}
+
+		// Parse accepts only conjunctions.
+		if _, err := syntax.Parse(test.input); (err == nil) != ok {
+			t.Errorf("Parse %#q: got err=%v, want error %v", test.input, err, !ok)
+		}
+	}
+}
@@ -209,8 +209,9 @@ func (env *Environment) BlockResults(ctx context.Context, req *coretypes.Request
 
 // BlockSearch searches for a paginated set of blocks matching the provided query.
 func (env *Environment) BlockSearch(ctx context.Context, req *coretypes.RequestBlockSearch) (*coretypes.ResultBlockSearch, error) {
-	if !indexer.KVSinkEnabled(env.EventSinks) {
-		return nil, fmt.Errorf("block searching is disabled due to no kvEventSink")
+	sink := indexer.SearchSink(env.EventSinks)
+	if sink == nil {
+		return nil, fmt.Errorf("block searching is disabled due to no kv, psql or sqlite event sink")
 	}
 
 	q, err := tmquery.New(req.Query)
@@ -218,14 +219,7 @@ func (env *Environment) BlockSearch(ctx context.Context, req *coretypes.RequestB
 		return nil, err
 	}
 
-	var kvsink indexer.EventSink
-	for _, sink := range env.EventSinks {
-		if sink.Type() == indexer.KV {
-			kvsink = sink
-		}
-	}
-
-	results, err := kvsink.SearchBlockEvents(ctx, q)
+	results, err := sink.SearchBlockEvents(ctx, q)
 	if err != nil {
 		return nil, err
 	}
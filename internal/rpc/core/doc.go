@@ -19,6 +19,7 @@ Available endpoints:
 /abci_info
 /dump_consensus_state
 /genesis
+/mempool_txs
 /net_info
 /num_unconfirmed_txs
 /status
@@ -37,6 +38,7 @@ Endpoints that require arguments:
 /commit?height=_
 /dial_seeds?seeds=_
 /dial_persistent_peers?persistent_peers=_
+/mempool_tx?txkey=_
 /subscribe?event=_
 /tx?hash=_&prove=_
 /unsubscribe?event=_
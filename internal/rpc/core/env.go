@@ -351,6 +351,14 @@ func (env *Environment) StartService(ctx context.Context, conf *config.Config) (
 		listeners[i] = listener
 	}
 
+	if conf.RPC.GRPCListenAddress != "" {
+		listener, err := env.startGRPC(ctx, conf.RPC.GRPCListenAddress, cfg.MaxOpenConnections)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, listener)
+	}
+
 	return listeners, nil
 
 }
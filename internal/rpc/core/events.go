@@ -190,6 +190,7 @@ func (env *Environment) Events(ctx context.Context, req *coretypes.RequestEvents
 
 	var info eventlog.Info
 	var items []*eventlog.Item
+	var newest cursor.Cursor
 	var err error
 	accept := func(itm *eventlog.Item) error {
 		// N.B. We accept up to one item more than requested, so we can tell how
@@ -197,6 +198,15 @@ func (env *Environment) Events(ctx context.Context, req *coretypes.RequestEvents
 		if len(items) > maxItems || itm.Cursor.Before(after) {
 			return eventlog.ErrStopScan
 		}
+
+		// Without a cursor to page from, look only within the window of the
+		// log. A persistent log may retain items much older than that.
+		if newest.IsZero() {
+			newest = itm.Cursor
+		}
+		if before.IsZero() && after.IsZero() && newest.Diff(itm.Cursor) > env.EventLog.WindowSize() {
+			return eventlog.ErrStopScan
+		}
 		if cursorInRange(itm.Cursor, before, after) && query.Matches(itm.Events) {
 			items = append(items, itm)
 		}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ari-anchor/sei-tendermint/internal/eventlog"
+	"github.com/ari-anchor/sei-tendermint/internal/eventlog/cursor"
+	tmquery "github.com/ari-anchor/sei-tendermint/internal/pubsub/query"
+	tmrpc "github.com/ari-anchor/sei-tendermint/proto/tendermint/rpc"
+	rpcserver "github.com/ari-anchor/sei-tendermint/rpc/jsonrpc/server"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// startGRPC starts a gRPC server for the EventsAPI service on listenAddr,
+// which runs until ctx is canceled.
+func (env *Environment) startGRPC(ctx context.Context, listenAddr string, maxOpenConnections int) (net.Listener, error) {
+	if env.EventLog == nil {
+		return nil, errors.New("the gRPC server requires the event log")
+	}
+	listener, err := rpcserver.Listen(listenAddr, maxOpenConnections)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := grpc.NewServer()
+	tmrpc.RegisterEventsAPIServer(srv, eventsAPIServer{env: env})
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+	}()
+	go func() {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			env.Logger.Error("error serving gRPC server", "err", err)
+		}
+	}()
+
+	env.Logger.Info("gRPC server started", "addr", listener.Addr())
+	return listener, nil
+}
+
+// eventsAPIServer implements the EventsAPI service on the event log.
+type eventsAPIServer struct {
+	env *Environment
+}
+
+var _ tmrpc.EventsAPIServer = eventsAPIServer{}
+
+// Events streams the items of the event log matching the query of req, from
+// the oldest to the newest, followed by the matching items added to the log
+// later, until the client cancels the stream.
+//
+// Items are read from the log as the stream sends them, so a client that is
+// slow to receive holds back the server through the flow control of the
+// stream, rather than piling items up in memory. If the client falls so far
+// behind that items it has not received are pruned from the log, the stream
+// fails with OutOfRange, and reports the cursor of the oldest item of the log
+// in the trailer metadata tmrpc.OldestCursorTrailer. A persistent event log
+// lets a client resume from any cursor it retains.
+func (s eventsAPIServer) Events(req *tmrpc.EventsRequest, stream tmrpc.EventsAPI_EventsServer) error {
+	lg := s.env.EventLog
+	if len(req.Query) > maxQueryLength {
+		return status.Error(codes.InvalidArgument, "maximum query length exceeded")
+	}
+	query := tmquery.All
+	if req.Query != "" {
+		q, err := tmquery.New(req.Query)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+		}
+		query = q
+	}
+
+	var after cursor.Cursor
+	if err := after.UnmarshalText([]byte(req.After)); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid cursor %q: %v", req.After, err)
+	}
+	if after.IsZero() {
+		after = lg.Info().Newest
+	}
+
+	ctx := stream.Context()
+	send := func(itm *eventlog.Item) error {
+		if query.Matches(itm.Events) {
+			data, err := types.EventDataToProto(itm.Data)
+			if err != nil {
+				return status.Errorf(codes.Internal, "encoding event %v: %v", itm.Cursor, err)
+			}
+			if err := stream.Send(&tmrpc.EventItem{
+				Cursor: itm.Cursor.String(),
+				Event:  itm.Type,
+				Data:   *data,
+			}); err != nil {
+				return err
+			}
+		}
+		after = itm.Cursor
+		return nil
+	}
+	for {
+		if info := lg.Info(); !after.IsZero() && after.Before(info.Oldest) {
+			stream.SetTrailer(metadata.Pairs(tmrpc.OldestCursorTrailer, info.Oldest.String()))
+			return status.Errorf(codes.OutOfRange,
+				"events after cursor %v have been pruned; the oldest event is %v", after, info.Oldest)
+		}
+		if _, err := lg.ScanAfter(after, send); err != nil {
+			return err
+		}
+
+		// Wait for an item newer than the last one scanned. The head of the
+		// log may differ from the cursor without being newer, if the client
+		// resumed from a cursor ahead of the log.
+		cur := after
+		for {
+			info, err := lg.WaitScan(ctx, cur, func(*eventlog.Item) error { return eventlog.ErrStopScan })
+			if err != nil {
+				return status.FromContextError(err).Err()
+			}
+			if after.Before(info.Newest) {
+				break
+			}
+			cur = info.Newest
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/internal/eventlog"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	"github.com/ari-anchor/sei-tendermint/rpc/client/eventstream"
+	rpcgrpc "github.com/ari-anchor/sei-tendermint/rpc/client/grpc"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+func TestGRPCEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lg, err := eventlog.New(eventlog.LogSettings{WindowSize: time.Minute})
+	require.NoError(t, err)
+	env := &Environment{EventLog: lg, Logger: log.NewNopLogger()}
+	listener, err := env.startGRPC(ctx, "tcp://127.0.0.1:0", 0)
+	require.NoError(t, err)
+
+	cli, err := rpcgrpc.Dial(ctx, "tcp://"+listener.Addr().String())
+	require.NoError(t, err)
+	defer cli.Close()
+
+	var height int64
+	addTx := func() {
+		t.Helper()
+		height++
+		require.NoError(t, lg.Add(types.EventTxValue, types.EventDataTx{
+			TxResult: abci.TxResult{Height: height, Tx: types.Tx(fmt.Sprintf("tx-%d", height))},
+		}))
+	}
+	for i := 0; i < 3; i++ {
+		addTx()
+	}
+
+	// Run stream until it delivers n items.
+	collect := func(stream *rpcgrpc.Stream, n int) []*rpcgrpc.Item {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		var items []*rpcgrpc.Item
+		require.NoError(t, stream.Run(ctx, func(itm *rpcgrpc.Item) error {
+			items = append(items, itm)
+			if len(items) == n {
+				return eventstream.ErrStopRunning
+			}
+			return nil
+		}))
+		return items
+	}
+	heights := func(items []*rpcgrpc.Item) []int64 {
+		var hs []int64
+		for _, itm := range items {
+			hs = append(hs, itm.Data.(types.EventDataTx).Height)
+		}
+		return hs
+	}
+
+	// A new stream delivers the events published after it starts. Keep
+	// publishing until it has started and received two of them.
+	stream := cli.Stream("tm.event = 'Tx'", nil)
+	stop := make(chan struct{})
+	published := make(chan struct{})
+	go func() {
+		defer close(published)
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(10 * time.Millisecond):
+				addTx()
+			}
+		}
+	}()
+	items := collect(stream, 2)
+	close(stop)
+	<-published
+	hs := heights(items)
+	assert.Greater(t, hs[0], int64(3))
+	assert.Equal(t, hs[0]+1, hs[1])
+	assert.Equal(t, types.EventTxValue, items[0].Event)
+	assert.Equal(t, items[1].Cursor, stream.Cursor())
+
+	// A stream resumed from a cursor delivers the matching events after it,
+	// including those already published.
+	stream = cli.Stream(fmt.Sprintf("tx.height > %d", hs[1]), &rpcgrpc.StreamOptions{ResumeFrom: items[0].Cursor})
+	addTx()
+	items = collect(stream, 1)
+	assert.Equal(t, []int64{hs[1] + 1}, heights(items))
+
+	// A stream that is run again continues after the last event it delivered.
+	addTx()
+	items = collect(stream, 1)
+	assert.Equal(t, []int64{hs[1] + 2}, heights(items))
+
+	// An invalid query fails the stream.
+	err = cli.Stream("tx.height >", nil).Run(ctx, func(*rpcgrpc.Item) error { return nil })
+	assert.Error(t, err)
+}
+
+func TestGRPCEventsMissed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lg, err := eventlog.New(eventlog.LogSettings{WindowSize: time.Minute, MaxItems: 2})
+	require.NoError(t, err)
+	env := &Environment{EventLog: lg, Logger: log.NewNopLogger()}
+	listener, err := env.startGRPC(ctx, "tcp://127.0.0.1:0", 0)
+	require.NoError(t, err)
+
+	cli, err := rpcgrpc.Dial(ctx, "tcp://"+listener.Addr().String())
+	require.NoError(t, err)
+	defer cli.Close()
+
+	var cursors []string
+	for i := 0; i < 5; i++ {
+		err := lg.Add("test-event", types.EventDataString(fmt.Sprint(i)))
+		if err != nil && !errors.Is(err, eventlog.ErrLogPruned) {
+			require.NoError(t, err)
+		}
+		cursors = append(cursors, lg.Info().Newest.String())
+	}
+
+	stream := cli.Stream("", &rpcgrpc.StreamOptions{ResumeFrom: cursors[0]})
+	err = stream.Run(ctx, func(*rpcgrpc.Item) error { return nil })
+	var missed *eventstream.MissedItemsError
+	require.ErrorAs(t, err, &missed)
+	assert.Equal(t, cursors[0], missed.NewestSeen)
+	assert.Equal(t, lg.Info().Oldest.String(), missed.OldestPresent)
+}
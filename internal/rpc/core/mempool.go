@@ -96,12 +96,12 @@ func (env *Environment) BroadcastTxCommit(ctx context.Context, req *coretypes.Re
 			}, nil
 		}
 
-		if !indexer.KVSinkEnabled(env.EventSinks) {
+		if indexer.SearchSink(env.EventSinks) == nil {
 			return &coretypes.ResultBroadcastTxCommit{
 					CheckTx: *r,
 					Hash:    req.Tx.Hash(),
 				},
-				errors.New("cannot confirm transaction because kvEventSink is not enabled")
+				errors.New("cannot confirm transaction because no kv, psql or sqlite event sink is enabled")
 		}
 
 		startAt := time.Now()
@@ -176,6 +176,76 @@ func (env *Environment) NumUnconfirmedTxs(ctx context.Context) (*coretypes.Resul
 		TotalBytes: env.Mempool.SizeBytes()}, nil
 }
 
+// MempoolTxs gets the transactions in the mempool that match the given
+// filters, along with their metadata, in order of priority.
+func (env *Environment) MempoolTxs(ctx context.Context, req *coretypes.RequestMempoolTxs) (*coretypes.ResultMempoolTxs, error) {
+	filter := mempool.TxFilter{
+		Sender: req.Sender,
+	}
+	if req.MinAge != nil {
+		filter.MinAge = time.Duration(*req.MinAge) * time.Second
+	}
+	if req.MaxAge != nil {
+		filter.MaxAge = time.Duration(*req.MaxAge) * time.Second
+	}
+	if req.MinPriority != nil {
+		minPriority := int64(*req.MinPriority)
+		filter.MinPriority = &minPriority
+	}
+	if req.MaxPriority != nil {
+		maxPriority := int64(*req.MaxPriority)
+		filter.MaxPriority = &maxPriority
+	}
+
+	entries := env.Mempool.GetTxEntries(filter)
+	totalCount := len(entries)
+	perPage := env.validatePerPage(req.PerPage.IntPtr())
+	page, err := validatePage(req.Page.IntPtr(), perPage, totalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	skipCount := validateSkipCount(page, perPage)
+	pageSize := tmmath.MinInt(perPage, totalCount-skipCount)
+
+	txs := make([]*coretypes.ResultMempoolTx, 0, pageSize)
+	for _, entry := range entries[skipCount : skipCount+pageSize] {
+		txs = append(txs, makeResultMempoolTx(entry))
+	}
+
+	return &coretypes.ResultMempoolTxs{
+		Count: len(txs),
+		Total: totalCount,
+		Txs:   txs,
+	}, nil
+}
+
+// MempoolTx gets the transaction in the mempool with the given key, along with
+// its metadata.
+func (env *Environment) MempoolTx(ctx context.Context, req *coretypes.RequestMempoolTx) (*coretypes.ResultMempoolTx, error) {
+	entry, ok := env.Mempool.GetTxEntry(req.TxKey)
+	if !ok {
+		return nil, fmt.Errorf("tx %X not found in mempool", req.TxKey[:])
+	}
+	return makeResultMempoolTx(entry), nil
+}
+
+func makeResultMempoolTx(entry mempool.TxEntry) *coretypes.ResultMempoolTx {
+	return &coretypes.ResultMempoolTx{
+		Tx:        entry.Tx,
+		Hash:      entry.Tx.Hash(),
+		Priority:  entry.Priority,
+		Sender:    entry.Sender,
+		Nonce:     entry.Nonce,
+		GasWanted: entry.GasWanted,
+		Height:    entry.Height,
+		Timestamp: entry.Timestamp,
+		Peers:     entry.Peers,
+		Pending:   entry.Pending,
+		Evictable: entry.Evictable,
+	}
+}
+
 // CheckTx checks the transaction without executing it. The transaction won't
 // be added to the mempool either.
 // More: https://docs.tendermint.com/master/rpc/#/Tx/check_tx
@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/ari-anchor/sei-tendermint/internal/blocksync"
 	"github.com/ari-anchor/sei-tendermint/rpc/coretypes"
+	"github.com/ari-anchor/sei-tendermint/types"
 )
 
 // NetInfo returns network info.
@@ -13,6 +16,11 @@ import (
 func (env *Environment) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error) {
 	peerList := env.PeerManager.Peers()
 
+	var blockSyncStats map[types.NodeID]blocksync.PeerStats
+	if env.BlockSyncReactor != nil {
+		blockSyncStats = env.BlockSyncReactor.GetPeerStats()
+	}
+
 	peers := make([]coretypes.Peer, 0, len(peerList))
 	peerConnections := make([]coretypes.PeerConnection, 0, len(peerList))
 	for _, peer := range peerList {
@@ -25,11 +33,21 @@ func (env *Environment) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo,
 			ID:  peer,
 			URL: addrs[0].String(),
 		})
-		peerConnections = append(peerConnections, coretypes.PeerConnection{
+		conn := coretypes.PeerConnection{
 			ID:    peer,
 			State: env.PeerManager.State(peer),
 			Score: env.PeerManager.Score(peer),
-		})
+		}
+		if stats, ok := blockSyncStats[peer]; ok {
+			conn.BlockSync = &coretypes.PeerBlockSyncInfo{
+				Height:      stats.Height,
+				NumPending:  stats.NumPending,
+				NumReceived: stats.NumReceived,
+				LatencyMs:   float64(stats.Latency) / float64(time.Millisecond),
+				Throughput:  stats.Throughput,
+			}
+		}
+		peerConnections = append(peerConnections, conn)
 	}
 
 	return &coretypes.ResultNetInfo{
@@ -57,6 +57,8 @@ func NewRoutesMap(svc RPCService, opts *RouteOptions) RoutesMap {
 		"consensus_params":     rpc.NewRPCFunc(svc.ConsensusParams),
 		"unconfirmed_txs":      rpc.NewRPCFunc(svc.UnconfirmedTxs),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(svc.NumUnconfirmedTxs),
+		"mempool_txs":          rpc.NewRPCFunc(svc.MempoolTxs),
+		"mempool_tx":           rpc.NewRPCFunc(svc.MempoolTx),
 
 		// tx broadcast API
 		"broadcast_tx": rpc.NewRPCFunc(svc.BroadcastTx),
@@ -105,6 +107,8 @@ type RPCService interface {
 	Header(ctx context.Context, req *coretypes.RequestBlockInfo) (*coretypes.ResultHeader, error)
 	HeaderByHash(ctx context.Context, req *coretypes.RequestBlockByHash) (*coretypes.ResultHeader, error)
 	Health(ctx context.Context) (*coretypes.ResultHealth, error)
+	MempoolTx(ctx context.Context, req *coretypes.RequestMempoolTx) (*coretypes.ResultMempoolTx, error)
+	MempoolTxs(ctx context.Context, req *coretypes.RequestMempoolTxs) (*coretypes.ResultMempoolTxs, error)
 	NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error)
 	NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfirmedTxs, error)
 	RemoveTx(ctx context.Context, req *coretypes.RequestRemoveTx) error
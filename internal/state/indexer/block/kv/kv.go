@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -77,6 +78,10 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 // of height queries, i.e. block.height=H, if the height is indexed, that height
 // alone will be returned. An error and nil slice is returned. Otherwise, a
 // non-nil slice and nil error is returned.
+//
+// Queries using OR and NOT are planned by indexer.ExprSearcher, which searches
+// each conjunction of conditions separately and merges or subtracts the
+// resulting heights.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -86,22 +91,67 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions := q.Syntax()
+	if q == nil {
+		return results, nil
+	}
 
-	// If there is an exact height query, return the result immediately
-	// (if it exists).
-	height, ok := lookForHeight(conditions)
-	if ok {
-		ok, err := idx.Has(height)
+	searcher := indexer.ExprSearcher{
+		Match: idx.matchConditions,
+		All:   idx.matchAll,
+	}
+	filteredHeights, err := searcher.Search(ctx, q.Expr())
+	if err != nil {
+		return nil, err
+	}
+
+	// fetch matching heights
+	results = make([]int64, 0, len(filteredHeights))
+heights:
+	for _, hBz := range filteredHeights {
+		h := int64FromBytes(hBz)
+
+		ok, err := idx.Has(h)
 		if err != nil {
 			return nil, err
 		}
-
 		if ok {
-			return []int64{height}, nil
+			results = append(results, h)
 		}
 
-		return results, nil
+		select {
+		case <-ctx.Done():
+			break heights
+
+		default:
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// matchConditions returns the heights of the blocks matching all of the given
+// conditions.
+func (idx *BlockerIndexer) matchConditions(ctx context.Context, conditions []syntax.Condition) (indexer.MatchSet, error) {
+	// If there is an exact height query, return the result immediately
+	// (if it exists).
+	heights, ok := lookForHeights(conditions)
+	if ok {
+		matches := make(indexer.MatchSet)
+		for _, height := range heights {
+			ok, err := idx.Has(height)
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				heightBz := int64ToBytes(height)
+				matches[string(heightBz)] = heightBz
+			}
+		}
+
+		return matches, nil
 	}
 
 	var heightsInitialized bool
@@ -176,31 +226,13 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-heights:
-	for _, hBz := range filteredHeights {
-		h := int64FromBytes(hBz)
-
-		ok, err := idx.Has(h)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			results = append(results, h)
-		}
-
-		select {
-		case <-ctx.Done():
-			break heights
-
-		default:
-		}
-	}
-
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+	return filteredHeights, nil
+}
 
-	return results, nil
+// matchAll returns the heights of all the indexed blocks.
+func (idx *BlockerIndexer) matchAll(ctx context.Context) (indexer.MatchSet, error) {
+	c := syntax.Condition{Tag: types.BlockHeightKey, Op: syntax.TExists}
+	return idx.match(ctx, c, nil, nil, true)
 }
 
 // matchRange returns all matching block heights that match a given QueryRange
@@ -415,6 +447,88 @@ func (idx *BlockerIndexer) match(
 			return nil, err
 		}
 
+	case c.Op == syntax.TStartsWith:
+		prefix, err := valuePrefixKey(c.Tag, c.Arg.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		it, err := dbm.IteratePrefix(idx.store, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create prefix iterator: %w", err)
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			tmpHeights[string(it.Value())] = it.Value()
+
+			if err := ctx.Err(); err != nil {
+				break
+			}
+		}
+
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+
+	case c.Op == syntax.TMatches:
+		re, err := regexp.Compile(c.Arg.Value())
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+
+		prefix, err := orderedcode.Append(nil, c.Tag)
+		if err != nil {
+			return nil, err
+		}
+
+		it, err := dbm.IteratePrefix(idx.store, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create prefix iterator: %w", err)
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			eventValue, err := parseValueFromEventKey(it.Key())
+			if err != nil {
+				continue
+			}
+
+			if re.MatchString(eventValue) {
+				tmpHeights[string(it.Value())] = it.Value()
+			}
+
+			if err := ctx.Err(); err != nil {
+				break
+			}
+		}
+
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+
+	case c.Op == syntax.TIn:
+		// Each value is looked up as an equality condition.
+		for _, arg := range c.List {
+			if err := ctx.Err(); err != nil {
+				break
+			}
+
+			startKey, err := orderedcode.Append(nil, c.Tag, arg.Value())
+			if err != nil {
+				return nil, err
+			}
+
+			cond := syntax.Condition{Tag: c.Tag, Op: syntax.TEq, Arg: arg}
+			m, err := idx.match(ctx, cond, startKey, nil, true)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range m {
+				tmpHeights[k] = v
+			}
+		}
+
 	default:
 		return nil, errors.New("other operators should be handled already")
 	}
@@ -120,6 +120,46 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustCompile(`finalize_event1.proposer CONTAINS 'FCAA001'`),
 			results: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
 		},
+		"block.height = 3 OR finalize_event2.foo >= 100": {
+			q:       query.MustCompile(`block.height = 3 OR finalize_event2.foo >= 100`),
+			results: []int64{1, 3},
+		},
+		"finalize_event2.foo <= 4 OR finalize_event2.foo >= 10": {
+			q:       query.MustCompile(`finalize_event2.foo <= 4 OR finalize_event2.foo >= 10`),
+			results: []int64{1, 2, 4, 10},
+		},
+		"NOT finalize_event2.foo EXISTS": {
+			q:       query.MustCompile(`NOT finalize_event2.foo EXISTS`),
+			results: []int64{3, 5, 7, 9, 11},
+		},
+		"block.height > 2 AND NOT (finalize_event2.foo <= 6 OR block.height >= 10)": {
+			q:       query.MustCompile(`block.height > 2 AND NOT (finalize_event2.foo <= 6 OR block.height >= 10)`),
+			results: []int64{3, 5, 7, 8, 9},
+		},
+		"block.height IN (3, 5, 100)": {
+			q:       query.MustCompile(`block.height IN (3, 5, 100)`),
+			results: []int64{3, 5},
+		},
+		"finalize_event2.foo IN (4, 8, 9)": {
+			q:       query.MustCompile(`finalize_event2.foo IN (4, 8, 9)`),
+			results: []int64{4, 8},
+		},
+		"finalize_event2.foo STARTS_WITH '1'": {
+			q:       query.MustCompile(`finalize_event2.foo STARTS_WITH '1'`),
+			results: []int64{1, 10},
+		},
+		"finalize_event1.proposer STARTS_WITH 'FCAB'": {
+			q:       query.MustCompile(`finalize_event1.proposer STARTS_WITH 'FCAB'`),
+			results: []int64{},
+		},
+		"finalize_event2.foo MATCHES '^[2-4]$'": {
+			q:       query.MustCompile(`finalize_event2.foo MATCHES '^[2-4]$'`),
+			results: []int64{2, 4},
+		},
+		"(block.height < 3 OR block.height > 9) AND finalize_event1.proposer = 'FCAA001'": {
+			q:       query.MustCompile(`(block.height < 3 OR block.height > 9) AND finalize_event1.proposer = 'FCAA001'`),
+			results: []int64{1, 2, 10, 11},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -86,12 +86,39 @@ func parseValueFromEventKey(key []byte) (string, error) {
 	return eventValue, nil
 }
 
-func lookForHeight(conditions []syntax.Condition) (int64, bool) {
+// lookForHeights returns the heights to look up if there is an exact height
+// condition, such as "block.height = 5" or "block.height IN (5, 6)".
+func lookForHeights(conditions []syntax.Condition) ([]int64, bool) {
 	for _, c := range conditions {
-		if c.Tag == types.BlockHeightKey && c.Op == syntax.TEq {
-			return int64(c.Arg.Number()), true
+		if c.Tag != types.BlockHeightKey {
+			continue
+		}
+		switch c.Op {
+		case syntax.TEq:
+			return []int64{int64(c.Arg.Number())}, true
+		case syntax.TIn:
+			heights := make([]int64, len(c.List))
+			for i, arg := range c.List {
+				heights[i] = int64(arg.Number())
+			}
+			return heights, true
 		}
 	}
 
-	return 0, false
+	return nil, false
+}
+
+// valuePrefixKey returns the prefix of the event keys whose values start with
+// valuePrefix. Strings are encoded byte by byte, followed by a terminator, so
+// the encoding of valuePrefix without its terminator is a prefix of the
+// encoding of every such value.
+func valuePrefixKey(compositeKey, valuePrefix string) ([]byte, error) {
+	key, err := orderedcode.Append(nil, compositeKey, valuePrefix)
+	if err != nil {
+		return nil, err
+	}
+	return key[:len(key)-len(stringTerminator)], nil
 }
+
+// stringTerminator is the suffix orderedcode appends to encoded strings.
+const stringTerminator = "\x00\x01"
@@ -1,10 +1,11 @@
 /*
 Package indexer defines Tendermint's block and transaction event indexing logic.
 
-Tendermint supports two primary means of block and transaction event indexing:
+Tendermint supports three primary means of block and transaction event indexing:
 
 1. A key-value sink via an embedded database with a proprietary query language.
 2. A Postgres-based sink.
+3. A SQLite-based sink, which stores the Postgres schema in an embedded database file.
 
 An ABCI application can emit events during block and transaction execution in the form
 
@@ -162,10 +162,29 @@ func KVSinkEnabled(sinks []EventSink) bool {
 // IndexingEnabled returns the given eventSinks is supporting the indexing services.
 func IndexingEnabled(sinks []EventSink) bool {
 	for _, sink := range sinks {
-		if sink.Type() == KV || sink.Type() == PSQL {
+		if sink.Type() == KV || sink.Type() == PSQL || sink.Type() == SQLITE {
 			return true
 		}
 	}
 
 	return false
 }
+
+// SearchSink returns the sink from the given eventSinks that serves the search
+// and lookup APIs, or nil if none of them does. The kv sink is preferred over
+// the SQL-backed psql and sqlite sinks when several are enabled.
+func SearchSink(sinks []EventSink) EventSink {
+	var found EventSink
+	for _, sink := range sinks {
+		switch sink.Type() {
+		case KV:
+			return sink
+		case PSQL, SQLITE:
+			if found == nil {
+				found = sink
+			}
+		}
+	}
+
+	return found
+}
@@ -52,6 +52,7 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 
 	assert.False(t, indexer.KVSinkEnabled([]indexer.EventSink{}))
 	assert.False(t, indexer.IndexingEnabled([]indexer.EventSink{}))
+	assert.Nil(t, indexer.SearchSink([]indexer.EventSink{}))
 
 	// event sink setup
 	pool := setupDB(t)
@@ -60,6 +61,8 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	eventSinks := []indexer.EventSink{kv.NewEventSink(store), pSink}
 	assert.True(t, indexer.KVSinkEnabled(eventSinks))
 	assert.True(t, indexer.IndexingEnabled(eventSinks))
+	assert.Equal(t, eventSinks[0], indexer.SearchSink(eventSinks))
+	assert.Equal(t, pSink, indexer.SearchSink([]indexer.EventSink{pSink}))
 
 	service := indexer.NewService(indexer.ServiceArgs{
 		Logger:   logger,
@@ -0,0 +1,147 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query/syntax"
+)
+
+// A MatchSet is a set of matching index entries, such as transaction hashes or
+// block heights, keyed by their string form.
+type MatchSet map[string][]byte
+
+// ExprSearcher evaluates query expressions over an index, given the means to
+// search it for conjunctions of conditions.
+type ExprSearcher struct {
+	// Match returns the entries matching all of the conditions.
+	Match func(ctx context.Context, conditions []syntax.Condition) (MatchSet, error)
+
+	// All returns every entry of the index. It is only used to evaluate
+	// negations that are not intersected with another operand.
+	All func(ctx context.Context) (MatchSet, error)
+}
+
+// Search returns the entries matching expr.
+//
+// The conditions directly combined by an AND are searched together with
+// Match, so that range conditions on the same key share a single scan, and
+// the result is intersected with the other operands, in order, until it is
+// empty. Negated operands of an AND are subtracted from the result of the
+// other operands, and the operands of an OR are searched separately and their
+// results merged. The full index is only scanned for negations with nothing
+// else to subtract from.
+//
+// A search interrupted by the cancellation of ctx may return partial results,
+// except for negations: since an interrupted subtraction would return entries
+// that do not match, ctx.Err() is returned instead.
+func (s ExprSearcher) Search(ctx context.Context, expr syntax.Expr) (MatchSet, error) {
+	if expr.Cond != nil {
+		return s.Match(ctx, []syntax.Condition{*expr.Cond})
+	}
+
+	switch expr.Op {
+	case syntax.TAnd:
+		return s.searchAnd(ctx, expr.Args)
+
+	case syntax.TOr:
+		matches := make(MatchSet)
+		for _, arg := range expr.Args {
+			m, err := s.Search(ctx, arg)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range m {
+				matches[k] = v
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		return matches, nil
+
+	case syntax.TNot:
+		all, err := s.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return s.subtract(ctx, all, expr.Args[0])
+
+	default:
+		return nil, fmt.Errorf("unknown expression operator %v", expr.Op)
+	}
+}
+
+func (s ExprSearcher) searchAnd(ctx context.Context, args []syntax.Expr) (MatchSet, error) {
+	var (
+		conds        []syntax.Condition
+		others, nots []syntax.Expr
+	)
+	for _, arg := range args {
+		switch {
+		case arg.Cond != nil:
+			conds = append(conds, *arg.Cond)
+		case arg.Op == syntax.TNot:
+			nots = append(nots, arg.Args[0])
+		default:
+			others = append(others, arg)
+		}
+	}
+
+	var (
+		matches MatchSet
+		err     error
+	)
+	switch {
+	case len(conds) > 0:
+		matches, err = s.Match(ctx, conds)
+	case len(others) > 0:
+		matches, err = s.Search(ctx, others[0])
+		others = others[1:]
+	default:
+		matches, err = s.All(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, arg := range others {
+		if len(matches) == 0 || ctx.Err() != nil {
+			return matches, nil
+		}
+		m, err := s.Search(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		for k := range matches {
+			if _, ok := m[k]; !ok {
+				delete(matches, k)
+			}
+		}
+	}
+	for _, arg := range nots {
+		if len(matches) == 0 {
+			return matches, nil
+		}
+		if matches, err = s.subtract(ctx, matches, arg); err != nil {
+			return nil, err
+		}
+	}
+	return matches, nil
+}
+
+// subtract removes the entries matching expr from matches. It fails if ctx is
+// canceled, since expr may then have been matched only in part.
+func (s ExprSearcher) subtract(ctx context.Context, matches MatchSet, expr syntax.Expr) (MatchSet, error) {
+	m, err := s.Search(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for k := range m {
+		delete(matches, k)
+	}
+	return matches, nil
+}
@@ -0,0 +1,41 @@
+package indexer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query"
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query/syntax"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+)
+
+func TestExprSearcherCanceledNegation(t *testing.T) {
+	var cancel context.CancelFunc
+	all := indexer.MatchSet{"1": []byte("1"), "2": []byte("2")}
+	searcher := indexer.ExprSearcher{
+		// The search of the negated condition is interrupted before it
+		// finds anything.
+		Match: func(ctx context.Context, conds []syntax.Condition) (indexer.MatchSet, error) {
+			if conds[0].Tag == "tx.sender" {
+				cancel()
+				return indexer.MatchSet{}, nil
+			}
+			return all, nil
+		},
+		All: func(ctx context.Context) (indexer.MatchSet, error) { return all, nil },
+	}
+
+	for _, q := range []string{
+		`NOT tx.sender = 'a'`,
+		`tx.height > 0 AND NOT tx.sender = 'a'`,
+	} {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		matches, err := searcher.Search(ctx, query.MustCompile(q).Expr())
+		cancel()
+		require.ErrorIs(t, err, context.Canceled, q)
+		require.Nil(t, matches, q)
+	}
+}
@@ -19,7 +19,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query"
 	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/sqlsink"
 	"github.com/ari-anchor/sei-tendermint/types"
 
 	// Register the Postgres database driver.
@@ -34,7 +36,8 @@ var (
 		"If true, pause the test until interrupted at shutdown, to allow debugging")
 
 	// A hook that test cases can call to obtain the shared database instance
-	// used for testing the sink. This is initialized in TestMain (see below).
+	// used for testing the sink. This is initialized in TestMain (see below),
+	// unless Docker is unavailable.
 	testDB func() *sql.DB
 )
 
@@ -58,10 +61,11 @@ func TestMain(m *testing.M) {
 		log.Fatalf("Creating docker pool: %v", err)
 	}
 
-	// If docker is unavailable, log and exit without reporting failure.
+	// If docker is unavailable, log and run only the test cases that do not
+	// require a database; the others are skipped (see requireDB).
 	if _, err := pool.Client.Info(); err != nil {
-		log.Printf("WARNING: Docker is not available: %v [skipping this test]", err)
-		return
+		log.Printf("WARNING: Docker is not available: %v [skipping database tests]", err)
+		os.Exit(m.Run())
 	}
 
 	// Start a container running PostgreSQL.
@@ -147,7 +151,7 @@ func TestMain(m *testing.M) {
 }
 
 func TestType(t *testing.T) {
-	psqlSink := &EventSink{store: testDB(), chainID: chainID}
+	psqlSink := newEventSink(requireDB(t), chainID)
 	assert.Equal(t, indexer.PSQL, psqlSink.Type())
 }
 
@@ -156,33 +160,37 @@ func TestIndexing(t *testing.T) {
 	defer cancel()
 
 	t.Run("IndexBlockEvents", func(t *testing.T) {
-		indexer := &EventSink{store: testDB(), chainID: chainID}
+		indexer := newEventSink(requireDB(t), chainID)
 		require.NoError(t, indexer.IndexBlockEvents(newTestBlockHeader()))
 
 		verifyBlock(t, 1)
 		verifyBlock(t, 2)
 
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
+		ok, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, ok)
 
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(ctx, nil)
-			return v != nil, err
-		})
+		ok, err = indexer.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, ok)
 
-		require.NoError(t, verifyTimeStamp(tableBlocks))
+		heights, err := indexer.SearchBlockEvents(ctx, query.MustCompile(`my_event.foo = 100`))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, heights)
+
+		require.NoError(t, verifyTimeStamp(sqlsink.TableBlocks))
 
 		// Attempting to reindex the same events should gracefully succeed.
 		require.NoError(t, indexer.IndexBlockEvents(newTestBlockHeader()))
 	})
 
 	t.Run("IndexTxEvents", func(t *testing.T) {
-		indexer := &EventSink{store: testDB(), chainID: chainID}
+		indexer := newEventSink(requireDB(t), chainID)
 
 		txResult := txResultWithEvents([]abci.Event{
-			makeIndexedEvent("account.number", "1"),
-			makeIndexedEvent("account.owner", "Ivan"),
-			makeIndexedEvent("account.owner", "Yulieta"),
+			sqlsink.MakeIndexedEvent("account.number", "1"),
+			sqlsink.MakeIndexedEvent("account.owner", "Ivan"),
+			sqlsink.MakeIndexedEvent("account.owner", "Yulieta"),
 
 			{Type: "", Attributes: []abci.EventAttribute{{Key: []byte("not_allowed"), Value: []byte("Vlad"), Index: true}}},
 		})
@@ -192,17 +200,21 @@ func TestIndexing(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, txResult, txr)
 
-		require.NoError(t, verifyTimeStamp(tableTxResults))
+		require.NoError(t, verifyTimeStamp(sqlsink.TableTxResults))
 		require.NoError(t, verifyTimeStamp(viewTxEvents))
 
-		verifyNotImplemented(t, "getTxByHash", func() (bool, error) {
-			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
-			return txr != nil, err
-		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(ctx, nil)
-			return txr != nil, err
-		})
+		txr, err = indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+
+		txr, err = indexer.GetTxByHash(types.Tx("missing").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+
+		results, err := indexer.SearchTxEvents(ctx, query.MustCompile(`account.owner = 'Ivan'`))
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, txResult, results[0])
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
@@ -210,11 +222,130 @@ func TestIndexing(t *testing.T) {
 	})
 }
 
+func TestSearch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	indexer := newEventSink(requireDB(t), chainID)
+
+	for _, h := range []int64{10, 11, 12} {
+		require.NoError(t, indexer.IndexBlockEvents(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: h},
+			ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+				Events: []abci.Event{
+					sqlsink.MakeIndexedEvent("search_block.size", fmt.Sprint(h*100)),
+					sqlsink.MakeIndexedEvent("search_block.day", fmt.Sprintf("2022-01-%02d", h)),
+					sqlsink.MakeIndexedEvent("search_block.bad_day", fmt.Sprintf("2022-13-%02d", h+30)),
+				},
+			},
+		}))
+	}
+
+	newTx := func(height int64, index uint32, events ...abci.Event) *abci.TxResult {
+		return &abci.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     types.Tx(fmt.Sprintf("search-tx-%d-%d", height, index)),
+			Result: abci.ExecTxResult{Code: abci.CodeTypeOK, Events: events},
+		}
+	}
+	txs := []*abci.TxResult{
+		newTx(10, 0,
+			sqlsink.MakeIndexedEvent("search_tx.amount", "5stake"),
+			sqlsink.MakeIndexedEvent("search_tx.sender", "alice"),
+			sqlsink.MakeIndexedEvent("search_tx.time", "2022-01-10T10:00:00Z")),
+		newTx(10, 1,
+			sqlsink.MakeIndexedEvent("search_tx.amount", "50"),
+			sqlsink.MakeIndexedEvent("search_tx.sender", "bob")),
+		newTx(11, 0,
+			sqlsink.MakeIndexedEvent("search_tx.amount", "500"),
+			sqlsink.MakeIndexedEvent("search_tx.sender", "alice"),
+			sqlsink.MakeIndexedEvent("search_tx.time", "2022-01-11T10:00:00Z"),
+			sqlsink.MakeIndexedEvent("search_tx.memo", "hello world")),
+	}
+	require.NoError(t, indexer.IndexTxEvents(txs))
+
+	t.Run("Txs", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []*abci.TxResult
+		}{
+			{`search_tx.sender = 'alice'`, []*abci.TxResult{txs[0], txs[2]}},
+			{`search_tx.sender = 'alice' AND tx.height = 11`, []*abci.TxResult{txs[2]}},
+			{`search_tx.amount > 5`, []*abci.TxResult{txs[1], txs[2]}},
+			{`search_tx.amount >= 5 AND search_tx.amount <= 50`, []*abci.TxResult{txs[0], txs[1]}},
+			{`search_tx.amount = 5`, []*abci.TxResult{txs[0]}},
+			{`tx.height > 10`, []*abci.TxResult{txs[2]}},
+			{`search_tx.memo CONTAINS 'world'`, []*abci.TxResult{txs[2]}},
+			{`search_tx.memo EXISTS`, []*abci.TxResult{txs[2]}},
+			{`search_tx.time < TIME 2022-01-11T00:00:00Z`, []*abci.TxResult{txs[0]}},
+			{`search_tx.sender = 'carol'`, nil},
+			{`search_tx.missing EXISTS`, nil},
+			{`search_tx.sender IN ('bob', 'carol')`, []*abci.TxResult{txs[1]}},
+			{`search_tx.amount IN (5, 500)`, []*abci.TxResult{txs[0], txs[2]}},
+			{`search_tx.sender STARTS_WITH 'al'`, []*abci.TxResult{txs[0], txs[2]}},
+			{`search_tx.memo MATCHES '^hello w'`, []*abci.TxResult{txs[2]}},
+			{`search_tx.sender MATCHES '^b.b$'`, []*abci.TxResult{txs[1]}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				results, err := indexer.SearchTxEvents(ctx, query.MustCompile(tc.query))
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, results)
+			})
+		}
+	})
+
+	t.Run("Blocks", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []int64
+		}{
+			{`block.height = 11`, []int64{11}},
+			{`search_block.size >= 1100`, []int64{11, 12}},
+			{`search_block.size > 1000 AND search_block.size < 1200`, []int64{11}},
+			{`search_block.day <= DATE 2022-01-11`, []int64{10, 11}},
+			{`search_block.size EXISTS`, []int64{10, 11, 12}},
+			{`search_block.size = 1`, nil},
+			{`search_block.bad_day < DATE 2023-01-01`, nil},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				heights, err := indexer.SearchBlockEvents(ctx, query.MustCompile(tc.query))
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, heights)
+			})
+		}
+	})
+
+	t.Run("OtherChain", func(t *testing.T) {
+		other := newEventSink(requireDB(t), "other-chainID")
+
+		results, err := other.SearchTxEvents(ctx, query.MustCompile(`search_tx.sender = 'alice'`))
+		require.NoError(t, err)
+		assert.Empty(t, results)
+
+		ok, err := other.HasBlock(10)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
 func TestStop(t *testing.T) {
-	indexer := &EventSink{store: testDB()}
+	indexer := newEventSink(requireDB(t), "")
 	require.NoError(t, indexer.Stop())
 }
 
+// requireDB returns the shared database instance, or skips the test if it
+// is unavailable because Docker is not running.
+func requireDB(t *testing.T) *sql.DB {
+	t.Helper()
+	if testDB == nil {
+		t.Skip("Docker is not available")
+	}
+	return testDB()
+}
+
 // newTestBlockHeader constructs a fresh copy of a block header containing
 // known test values to exercise the indexer.
 func newTestBlockHeader() types.EventDataNewBlockHeader {
@@ -222,10 +353,10 @@ func newTestBlockHeader() types.EventDataNewBlockHeader {
 		Header: types.Header{Height: 1},
 		ResultFinalizeBlock: abci.ResponseFinalizeBlock{
 			Events: []abci.Event{
-				makeIndexedEvent("finalize_event.proposer", "FCAA001"),
-				makeIndexedEvent("thingy.whatzit", "O.O"),
-				makeIndexedEvent("my_event.foo", "100"),
-				makeIndexedEvent("thingy.whatzit", "-.O"),
+				sqlsink.MakeIndexedEvent("finalize_event.proposer", "FCAA001"),
+				sqlsink.MakeIndexedEvent("thingy.whatzit", "O.O"),
+				sqlsink.MakeIndexedEvent("my_event.foo", "100"),
+				sqlsink.MakeIndexedEvent("thingy.whatzit", "-.O"),
 			},
 		},
 	}
@@ -278,7 +409,7 @@ func loadTxResult(hash []byte) (*abci.TxResult, error) {
 	hashString := fmt.Sprintf("%X", hash)
 	var resultData []byte
 	if err := testDB().QueryRow(`
-SELECT tx_result FROM `+tableTxResults+` WHERE tx_hash = $1;
+SELECT tx_result FROM `+sqlsink.TableTxResults+` WHERE tx_hash = $1;
 `, hashString).Scan(&resultData); err != nil {
 		return nil, fmt.Errorf("lookup transaction for hash %q failed: %v", hashString, err)
 	}
@@ -302,7 +433,7 @@ SELECT DISTINCT %[1]s.created_at
 func verifyBlock(t *testing.T, height int64) {
 	// Check that the blocks table contains an entry for this height.
 	if err := testDB().QueryRow(`
-SELECT height FROM `+tableBlocks+` WHERE height = $1;
+SELECT height FROM `+sqlsink.TableBlocks+` WHERE height = $1;
 `, height).Err(); err == sql.ErrNoRows {
 		t.Errorf("No block found for height=%d", height)
 	} else if err != nil {
@@ -310,20 +441,6 @@ SELECT height FROM `+tableBlocks+` WHERE height = $1;
 	}
 }
 
-// verifyNotImplemented calls f and verifies that it returns both a
-// false-valued flag and a non-nil error whose string matching the expected
-// "not supported" message with label prefixed.
-func verifyNotImplemented(t *testing.T, label string, f func() (bool, error)) {
-	t.Helper()
-	t.Logf("Verifying that %q reports it is not implemented", label)
-
-	want := label + " is not supported via the postgres event sink"
-	ok, err := f()
-	assert.False(t, ok)
-	require.Error(t, err)
-	assert.Equal(t, want, err.Error())
-}
-
 // waitForInterrupt blocks until a SIGINT is received by the process.
 func waitForInterrupt() {
 	ch := make(chan os.Signal, 1)
@@ -0,0 +1,205 @@
+package psql
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query/syntax"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/sqlsink"
+)
+
+// Patterns used to recognize attribute values that can be compared as
+// numbers, dates, and timestamps. These mirror the parsing rules used by the
+// query package when matching events in memory, so that a query selects the
+// same records regardless of which sink serves it.
+//
+// The date and time patterns only admit values whose fields are in range, and
+// the day of the month is checked separately by validDateSQL, so that a value
+// such as "2022-13-45" does not match instead of failing the cast to a date
+// and with it the whole search.
+const (
+	numberPrefixPattern = `^\d+(?:\.\d+)?`
+	datePattern         = `^\d{4}-(0[1-9]|1[0-2])-(0[1-9]|[12]\d|3[01])`
+	timePattern         = `T([01]\d|2[0-3]):[0-5]\d:[0-5]\d(\.\d+)?(Z|[+-](0\d|1[0-5]):[0-5]\d)$`
+)
+
+// sqlOperators maps comparison operators of the query language to their
+// equivalent SQL operators.
+var sqlOperators = map[syntax.Token]string{
+	syntax.TEq:  "=",
+	syntax.TLt:  "<",
+	syntax.TLeq: "<=",
+	syntax.TGt:  ">",
+	syntax.TGeq: ">=",
+}
+
+// dialect is the PostgreSQL dialect of the shared SQL event sink.
+type dialect struct{}
+
+// Placeholder implements sqlsink.Dialect.
+func (dialect) Placeholder(i int) string { return fmt.Sprintf("$%d", i) }
+
+// ValuePredicate implements sqlsink.Dialect.
+func (dialect) ValuePredicate(b *sqlsink.QueryBuilder, cond syntax.Condition) (string, error) {
+	// IN matches a value equal to any of its arguments.
+	if cond.Op == syntax.TIn {
+		if len(cond.List) == 0 {
+			return "", fmt.Errorf("missing arguments for %v", cond.Op)
+		}
+		preds := make([]string, len(cond.List))
+		for i, arg := range cond.List {
+			pred, err := valuePredicate(b, syntax.TEq, arg)
+			if err != nil {
+				return "", err
+			}
+			preds[i] = pred
+		}
+		return "(" + strings.Join(preds, " OR ") + ")", nil
+	}
+
+	// All the other operators require an argument.
+	if cond.Arg == nil {
+		return "", fmt.Errorf("missing argument for %v", cond.Op)
+	}
+	return valuePredicate(b, cond.Op, cond.Arg)
+}
+
+// valuePredicate returns a SQL predicate comparing the value column with arg
+// using op.
+func valuePredicate(b *sqlsink.QueryBuilder, op syntax.Token, arg *syntax.Arg) (string, error) {
+	if op == syntax.TContains {
+		if arg.Type != syntax.TString {
+			return "", fmt.Errorf("invalid op/arg combination (%v, %v)", op, arg.Type)
+		}
+		return "strpos(value, " + b.Arg(arg.Value()) + ") > 0", nil
+	}
+	if op == syntax.TStartsWith {
+		if arg.Type != syntax.TString {
+			return "", fmt.Errorf("invalid op/arg combination (%v, %v)", op, arg.Type)
+		}
+		prefix := b.Arg(arg.Value())
+		return "left(value, char_length(" + prefix + ")) = " + prefix, nil
+	}
+	if op == syntax.TMatches {
+		if arg.Type != syntax.TString {
+			return "", fmt.Errorf("invalid op/arg combination (%v, %v)", op, arg.Type)
+		}
+		if err := checkPattern(arg.Value()); err != nil {
+			return "", err
+		}
+		return "value ~ " + b.Arg(arg.Value()), nil
+	}
+
+	sqlOp, ok := sqlOperators[op]
+	if !ok {
+		return "", fmt.Errorf("unsupported operator %v", op)
+	}
+
+	switch arg.Type {
+	case syntax.TString:
+		if op != syntax.TEq {
+			return "", fmt.Errorf("invalid op/arg combination (%v, %v)", op, arg.Type)
+		}
+		return "value = " + b.Arg(arg.Value()), nil
+
+	case syntax.TNumber:
+		v := arg.Number()
+		if math.IsNaN(v) {
+			return "", fmt.Errorf("invalid number %q", arg.Value())
+		}
+		// Values that do not begin with a number yield NULL, which never
+		// satisfies the comparison.
+		return fmt.Sprintf("substring(value from '%s')::numeric %s %s::numeric",
+			numberPrefixPattern, sqlOp, b.Arg(v)), nil
+
+	case syntax.TDate:
+		ts := arg.Time()
+		if ts.IsZero() {
+			return "", fmt.Errorf("invalid date %q", arg.Value())
+		}
+		// CASE guarantees the cast is only attempted on valid dates.
+		return fmt.Sprintf("CASE WHEN %s THEN value::date %s %s::date ELSE false END",
+			validDateSQL(datePattern+"$"), sqlOp, b.Arg(ts.Format("2006-01-02"))), nil
+
+	case syntax.TTime:
+		ts := arg.Time()
+		if ts.IsZero() {
+			return "", fmt.Errorf("invalid timestamp %q", arg.Value())
+		}
+		return fmt.Sprintf("CASE WHEN %s THEN value::timestamptz %s %s::timestamptz ELSE false END",
+			validDateSQL(datePattern+timePattern), sqlOp, b.Arg(ts)), nil
+
+	default:
+		return "", fmt.Errorf("unknown argument type %v", arg.Type)
+	}
+}
+
+// validDateSQL returns a SQL predicate that holds if value matches pattern,
+// which must begin with datePattern, and its date exists in the calendar. The
+// nested CASE ensures that the day of the month is only computed for values
+// that match the pattern, and that a year 0 is rejected, which PostgreSQL
+// does not accept as a date.
+func validDateSQL(pattern string) string {
+	return fmt.Sprintf("CASE WHEN value ~ '%s' AND left(value, 4) <> '0000' "+
+		"THEN substr(value, 9, 2)::int <= extract(day from "+
+		"make_date(left(value, 4)::int, substr(value, 6, 2)::int, 1) + interval '1 month - 1 day') "+
+		"ELSE false END", pattern)
+}
+
+// checkPattern reports an error if the MATCHES pattern uses a construct that
+// PostgreSQL regular expressions do not interpret like RE2, which the query
+// package uses to match events in memory. The remaining constructs, such as
+// anchors, groups, alternations, greedy repetitions, bracket expressions and
+// escaped punctuation, behave the same, except that "." also matches a newline
+// in PostgreSQL.
+func checkPattern(pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid MATCHES pattern %q: %w", pattern, err)
+	}
+	unsupported := func(what string) error {
+		return fmt.Errorf("MATCHES pattern %q: %s are not supported", pattern, what)
+	}
+
+	inBracket := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\':
+			// Escaped letters and digits name character classes, assertions
+			// and escapes that differ between the two syntaxes.
+			i++
+			if n := pattern[i]; 'a' <= n && n <= 'z' || 'A' <= n && n <= 'Z' || '0' <= n && n <= '9' {
+				return unsupported("escape sequences")
+			}
+		case inBracket:
+			if c == '[' && i+1 < len(pattern) && strings.IndexByte(":.=", pattern[i+1]) >= 0 {
+				// Only named character classes such as [:alpha:] are shared,
+				// not their negations, collating elements or equivalence
+				// classes.
+				end := strings.Index(pattern[i+2:], ":]")
+				if pattern[i+1] != ':' || end < 0 || strings.HasPrefix(pattern[i+2:], "^") {
+					return unsupported("nested bracket expressions other than class names")
+				}
+				i += end + 3
+			} else if c == ']' {
+				inBracket = false
+			}
+		case c == '[':
+			inBracket = true
+			// A leading ] (after an optional ^) is a literal.
+			if i+1 < len(pattern) && pattern[i+1] == '^' {
+				i++
+			}
+			if i+1 < len(pattern) && pattern[i+1] == ']' {
+				i++
+			}
+		case c == '(' && i+1 < len(pattern) && pattern[i+1] == '?':
+			return unsupported("flags and non-capturing groups")
+		case (c == '*' || c == '+' || c == '?' || c == '}') && i+1 < len(pattern) && pattern[i+1] == '?':
+			return unsupported("non-greedy repetitions")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package psql
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query/syntax"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/sqlsink"
+)
+
+// These tests check the SQL generated for queries, and do not require a
+// database.
+
+func TestConditionSQL(t *testing.T) {
+	testCases := []struct {
+		query    string
+		wantPred string
+		wantArgs []interface{}
+	}{
+		{`tx.sender = 'alice'`,
+			`composite_key = $1 AND value = $2`,
+			[]interface{}{"tx.sender", "alice"}},
+		{`tx.sender EXISTS`,
+			`composite_key = $1`,
+			[]interface{}{"tx.sender"}},
+		{`tx.memo CONTAINS 'hi'`,
+			`composite_key = $1 AND strpos(value, $2) > 0`,
+			[]interface{}{"tx.memo", "hi"}},
+		{`tx.sender STARTS_WITH 'al'`,
+			`composite_key = $1 AND left(value, char_length($2)) = $2`,
+			[]interface{}{"tx.sender", "al"}},
+		{`tx.sender MATCHES '^a'`,
+			`composite_key = $1 AND value ~ $2`,
+			[]interface{}{"tx.sender", "^a"}},
+		{`tx.sender MATCHES '^(sei|cosmos)1[[:alnum:]]+\.x{2,}$'`,
+			`composite_key = $1 AND value ~ $2`,
+			[]interface{}{"tx.sender", `^(sei|cosmos)1[[:alnum:]]+\.x{2,}$`}},
+		{`tx.amount > 5`,
+			`composite_key = $1 AND substring(value from '` + numberPrefixPattern + `')::numeric > $2::numeric`,
+			[]interface{}{"tx.amount", float64(5)}},
+		{`tx.sender IN ('a', 'b')`,
+			`composite_key = $1 AND (value = $2 OR value = $3)`,
+			[]interface{}{"tx.sender", "a", "b"}},
+		{`tx.day < DATE 2022-01-11`,
+			`composite_key = $1 AND CASE WHEN ` + validDateSQL(datePattern+"$") +
+				` THEN value::date < $2::date ELSE false END`,
+			[]interface{}{"tx.day", "2022-01-11"}},
+		{`tx.time >= TIME 2022-01-11T00:00:00Z`,
+			`composite_key = $1 AND CASE WHEN ` + validDateSQL(datePattern+timePattern) +
+				` THEN value::timestamptz >= $2::timestamptz ELSE false END`,
+			[]interface{}{"tx.time", time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC)}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			q, err := syntax.Parse(tc.query)
+			require.NoError(t, err)
+			require.Len(t, q, 1)
+
+			b := sqlsink.NewQueryBuilder(dialect{})
+			pred, err := b.Condition(q[0])
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPred, pred)
+			assert.Equal(t, tc.wantArgs, b.Args())
+		})
+	}
+
+	// The parser rejects these combinations of operators and arguments, so
+	// construct them from valid conditions.
+	for _, tc := range []struct {
+		query string
+		op    syntax.Token
+	}{
+		{`tx.amount = 'five'`, syntax.TGt},
+		{`tx.amount = 5`, syntax.TContains},
+		{`tx.sender = 5`, syntax.TStartsWith},
+		{`tx.sender = 5`, syntax.TMatches},
+	} {
+		t.Run(tc.op.String()+" "+tc.query, func(t *testing.T) {
+			q, err := syntax.Parse(tc.query)
+			require.NoError(t, err)
+			cond := q[0]
+			cond.Op = tc.op
+			_, err = sqlsink.NewQueryBuilder(dialect{}).Condition(cond)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCheckPattern(t *testing.T) {
+	// Patterns that PostgreSQL and RE2 interpret alike.
+	for _, pattern := range []string{
+		`^a`,
+		`^(ab|cd)*e+f?$`,
+		`x{2,3}`,
+		`[^a-z]\.`,
+		`[]a]`,
+		`[[:digit:]_]`,
+	} {
+		assert.NoError(t, checkPattern(pattern), pattern)
+	}
+
+	// Patterns that PostgreSQL would interpret differently, or not at all.
+	for _, pattern := range []string{
+		`\d+`,
+		`\bword\b`,
+		`(a)\1`,
+		`(?i)abc`,
+		`(?:ab)+`,
+		`a+?`,
+		`x{2,3}?`,
+		`[[:^digit:]]`,
+		`[[.a.]]`,
+		`(unclosed`,
+	} {
+		assert.Error(t, checkPattern(pattern), pattern)
+	}
+}
+
+func TestValidDatePattern(t *testing.T) {
+	// The SQL date check relies on the pattern to only admit values with
+	// months and days in range; the day of the month is checked in SQL.
+	dateRE := regexp.MustCompile(datePattern + "$")
+	for value, want := range map[string]bool{
+		"2022-01-31":  true,
+		"2022-12-01":  true,
+		"2022-13-01":  false,
+		"2022-00-10":  false,
+		"2022-13-45":  false,
+		"2022-01-32":  false,
+		"2022-01-00":  false,
+		"2022-1-1":    false,
+		"2022-01-011": false,
+	} {
+		assert.Equal(t, want, dateRE.MatchString(value), "date %q", value)
+	}
+
+	timeRE := regexp.MustCompile(datePattern + timePattern)
+	for value, want := range map[string]bool{
+		"2022-01-11T10:00:00Z":      true,
+		"2022-01-11T23:59:59.5Z":    true,
+		"2022-01-11T10:00:00+02:00": true,
+		"2022-01-11T24:00:00Z":      false,
+		"2022-01-11T10:60:00Z":      false,
+		"2022-13-11T10:00:00Z":      false,
+		"2022-01-11T10:00:00+16:00": false,
+		"2022-01-11T10:00:00":       false,
+	} {
+		assert.Equal(t, want, timeRE.MatchString(value), "time %q", value)
+	}
+}
+
+func TestSearchSQL(t *testing.T) {
+	q, err := syntax.Parse(`tx.height = 5 AND tx.sender = 'alice'`)
+	require.NoError(t, err)
+
+	sql, args, err := sqlsink.TxSearchSQL(dialect{}, "test-chain", q)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"test-chain", "tx.height", float64(5), "tx.sender", "alice"}, args)
+	assert.Contains(t, sql, sqlsink.TableBlocks+`.chain_id = $1`)
+	assert.Equal(t, 2, strings.Count(sql, "AND EXISTS"))
+	assert.Contains(t, sql, "ORDER BY "+sqlsink.TableBlocks+".height, "+sqlsink.TableTxResults+`."index"`)
+
+	sql, args, err = sqlsink.BlockSearchSQL(dialect{}, "test-chain", q)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"test-chain", "tx.height", float64(5), "tx.sender", "alice"}, args)
+	assert.Equal(t, 2, strings.Count(sql, sqlsink.ViewEventAttrs+".tx_id IS NULL"))
+	assert.Contains(t, sql, "ORDER BY "+sqlsink.TableBlocks+".height;")
+
+	q, err = syntax.Parse(`tx.amount = 'five'`)
+	require.NoError(t, err)
+	q[0].Op = syntax.TGt
+	_, _, err = sqlsink.TxSearchSQL(dialect{}, "test-chain", q)
+	assert.Error(t, err)
+}
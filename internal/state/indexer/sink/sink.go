@@ -54,6 +54,17 @@ func EventSinksFromConfig(cfg *config.Config, dbProvider config.DBProvider, chai
 				return nil, err
 			}
 			eventSinks = append(eventSinks, es)
+
+		case indexer.SQLITE:
+			if cfg.TxIndex.SqlitePath == "" {
+				return nil, errors.New("the sqlite path cannot be empty")
+			}
+
+			es, err := NewSQLiteEventSink(cfg.TxIndex.SqliteFile(), chainID)
+			if err != nil {
+				return nil, err
+			}
+			eventSinks = append(eventSinks, es)
 		default:
 			return nil, errors.New("unsupported event sink type")
 		}
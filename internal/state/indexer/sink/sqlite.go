@@ -0,0 +1,19 @@
+//go:build sqlite
+// +build sqlite
+
+package sink
+
+import (
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/sqlite"
+)
+
+// SQLiteAvailable reports whether the binary is built with the SQLite event
+// sink.
+const SQLiteAvailable = true
+
+// NewSQLiteEventSink constructs the event sink backed by the SQLite database
+// file at path.
+func NewSQLiteEventSink(path, chainID string) (indexer.EventSink, error) {
+	return sqlite.NewEventSink(path, chainID)
+}
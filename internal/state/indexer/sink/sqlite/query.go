@@ -0,0 +1,83 @@
+//go:build sqlite
+// +build sqlite
+
+package sqlite
+
+import (
+	"fmt"
+
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query"
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query/syntax"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/sqlsink"
+)
+
+// matchFunc is the name of the SQL function that applies a query condition to
+// an attribute value. SQLite has no regular expressions or lenient numeric
+// casts, so rather than approximating the query semantics in SQL, the sink
+// evaluates value comparisons with the same matchers the query package uses
+// for events in memory. The function is registered on every connection opened
+// by the sink (see driverName).
+const matchFunc = "tm_match"
+
+// maxCachedConditions bounds the number of compiled conditions each
+// connection keeps.
+const maxCachedConditions = 256
+
+// connMatcher implements matchFunc for a single connection. It compiles the
+// conditions passed to it once, and reuses them for the remaining rows and
+// later searches. SQLite calls it only from the goroutine running a statement
+// on the connection, and a connection runs one statement at a time, so it
+// needs no locking.
+type connMatcher struct {
+	conditions map[string]*query.Query
+}
+
+func newConnMatcher() *connMatcher {
+	return &connMatcher{conditions: make(map[string]*query.Query)}
+}
+
+// matchValue reports whether value satisfies the query condition cond, in
+// the syntax of the query language. Missing (NULL) values never match.
+func (m *connMatcher) matchValue(cond string, value interface{}) (bool, error) {
+	q, ok := m.conditions[cond]
+	if !ok {
+		var err error
+		if q, err = query.New(cond); err != nil {
+			return false, err
+		}
+		if len(m.conditions) >= maxCachedConditions {
+			m.conditions = make(map[string]*query.Query)
+		}
+		m.conditions[cond] = q
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return false, nil
+	}
+	return q.Matches([]abci.Event{sqlsink.MakeIndexedEvent(q.Syntax()[0].Tag, s)}), nil
+}
+
+// dialect is the SQLite dialect of the shared SQL event sink.
+type dialect struct{}
+
+// Placeholder implements sqlsink.Dialect.
+func (dialect) Placeholder(i int) string { return fmt.Sprintf("?%d", i) }
+
+// ValuePredicate implements sqlsink.Dialect. The condition is passed to
+// matchFunc in the syntax of the query language, which cannot express a
+// string containing a quote and so reproduces the condition exactly.
+func (dialect) ValuePredicate(b *sqlsink.QueryBuilder, cond syntax.Condition) (string, error) {
+	// Compiling the condition reports invalid operator and argument
+	// combinations before the query is run.
+	if _, err := query.Compile(syntax.Query{cond}); err != nil {
+		return "", err
+	}
+	return matchFunc + "(" + b.Arg(cond.String()) + ", value)", nil
+}
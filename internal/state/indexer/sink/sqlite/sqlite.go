@@ -0,0 +1,82 @@
+//go:build sqlite
+// +build sqlite
+
+// Package sqlite implements an event sink backed by an embedded SQLite
+// database file. The SQLite driver requires cgo, so the package is only built
+// with the sqlite build tag.
+package sqlite
+
+import (
+	"database/sql"
+	_ "embed" // for the schema
+	"fmt"
+	"net/url"
+	"path/filepath"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/sqlsink"
+	tmos "github.com/ari-anchor/sei-tendermint/libs/os"
+)
+
+// driverName is the SQLite driver variant used by the sink, which registers
+// matchFunc on each new connection.
+const driverName = "sqlite3_tendermint"
+
+// schema is the database schema, installed when the sink is opened.
+//
+//go:embed schema.sql
+var schema string
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			m := newConnMatcher()
+			return conn.RegisterFunc(matchFunc, m.matchValue, true)
+		},
+	})
+}
+
+// EventSink is an indexer backend providing the tx/block index services.  This
+// implementation stores records in a SQLite database file using the schema
+// defined in state/indexer/sink/sqlite/schema.sql, which mirrors the schema of
+// the psql event sink.
+type EventSink struct {
+	*sqlsink.EventSink
+}
+
+// NewEventSink constructs an event sink associated with the SQLite database
+// file at path, creating the file and installing the schema if necessary.
+// Events written to the sink are attributed to the specified chainID.
+func NewEventSink(path, chainID string) (*EventSink, error) {
+	if err := tmos.EnsureDir(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	// Transactions take the write lock up front, so that concurrent readers
+	// cannot make a transaction fail when it starts writing.
+	dsn := "file:" + (&url.URL{Path: path}).EscapedPath() +
+		"?_journal_mode=WAL&_busy_timeout=5000&_foreign_keys=1&_txlock=immediate"
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	} else if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("installing schema: %w", err)
+	}
+
+	return newEventSink(db, chainID), nil
+}
+
+// newEventSink constructs an event sink that stores records in db.
+func newEventSink(db *sql.DB, chainID string) *EventSink {
+	return &EventSink{
+		EventSink: sqlsink.NewEventSink(db, chainID, indexer.SQLITE, dialect{}),
+	}
+}
@@ -0,0 +1,294 @@
+//go:build sqlite
+// +build sqlite
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer/sink/sqlsink"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// Verify that the type satisfies the EventSink interface.
+var _ indexer.EventSink = (*EventSink)(nil)
+
+const chainID = "test-chainID"
+
+// newTestSink opens a sink backed by a fresh database file, which is closed
+// when the test ends.
+func newTestSink(t *testing.T) *EventSink {
+	t.Helper()
+	es, err := NewEventSink(filepath.Join(t.TempDir(), "data", "tx_index.sqlite"), chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = es.Stop() })
+	return es
+}
+
+func TestType(t *testing.T) {
+	sqliteSink := newTestSink(t)
+	assert.Equal(t, indexer.SQLITE, sqliteSink.Type())
+}
+
+func TestReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tx_index.sqlite")
+	es, err := NewEventSink(path, chainID)
+	require.NoError(t, err)
+	require.NoError(t, es.IndexBlockEvents(newTestBlockHeader()))
+	require.NoError(t, es.Stop())
+
+	// Installing the schema again preserves the indexed records.
+	es, err = NewEventSink(path, chainID)
+	require.NoError(t, err)
+	defer es.Stop()
+	ok, err := es.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestIndexing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	indexer := newTestSink(t)
+
+	t.Run("IndexBlockEvents", func(t *testing.T) {
+		require.NoError(t, indexer.IndexBlockEvents(newTestBlockHeader()))
+
+		verifyBlock(t, indexer.DB(), 1)
+
+		ok, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = indexer.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		heights, err := indexer.SearchBlockEvents(ctx, query.MustCompile(`my_event.foo = 100`))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, heights)
+
+		// Attempting to reindex the same events should gracefully succeed.
+		require.NoError(t, indexer.IndexBlockEvents(newTestBlockHeader()))
+	})
+
+	t.Run("IndexTxEvents", func(t *testing.T) {
+		txResult := txResultWithEvents([]abci.Event{
+			sqlsink.MakeIndexedEvent("account.number", "1"),
+			sqlsink.MakeIndexedEvent("account.owner", "Ivan"),
+			sqlsink.MakeIndexedEvent("account.owner", "Yulieta"),
+
+			{Type: "", Attributes: []abci.EventAttribute{{Key: []byte("not_allowed"), Value: []byte("Vlad"), Index: true}}},
+		})
+		require.NoError(t, indexer.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+
+		txr, err = indexer.GetTxByHash(types.Tx("missing").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+
+		results, err := indexer.SearchTxEvents(ctx, query.MustCompile(`account.owner = 'Ivan'`))
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, txResult, results[0])
+
+		// try to insert the duplicate tx events.
+		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
+		require.NoError(t, err)
+	})
+}
+
+func TestSearch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	indexer := newTestSink(t)
+
+	for _, h := range []int64{10, 11, 12} {
+		require.NoError(t, indexer.IndexBlockEvents(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: h},
+			ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+				Events: []abci.Event{
+					sqlsink.MakeIndexedEvent("search_block.size", fmt.Sprint(h*100)),
+					sqlsink.MakeIndexedEvent("search_block.day", fmt.Sprintf("2022-01-%02d", h)),
+				},
+			},
+		}))
+	}
+
+	newTx := func(height int64, index uint32, events ...abci.Event) *abci.TxResult {
+		return &abci.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     types.Tx(fmt.Sprintf("search-tx-%d-%d", height, index)),
+			Result: abci.ExecTxResult{Code: abci.CodeTypeOK, Events: events},
+		}
+	}
+	txs := []*abci.TxResult{
+		newTx(10, 0,
+			sqlsink.MakeIndexedEvent("search_tx.amount", "5stake"),
+			sqlsink.MakeIndexedEvent("search_tx.sender", "alice"),
+			sqlsink.MakeIndexedEvent("search_tx.time", "2022-01-10T10:00:00Z")),
+		newTx(10, 1,
+			sqlsink.MakeIndexedEvent("search_tx.amount", "50"),
+			sqlsink.MakeIndexedEvent("search_tx.sender", "bob")),
+		newTx(11, 0,
+			sqlsink.MakeIndexedEvent("search_tx.amount", "500"),
+			sqlsink.MakeIndexedEvent("search_tx.sender", "alice"),
+			sqlsink.MakeIndexedEvent("search_tx.time", "2022-01-11T10:00:00Z"),
+			sqlsink.MakeIndexedEvent("search_tx.memo", "hello world")),
+	}
+	require.NoError(t, indexer.IndexTxEvents(txs))
+
+	t.Run("Txs", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []*abci.TxResult
+		}{
+			{`search_tx.sender = 'alice'`, []*abci.TxResult{txs[0], txs[2]}},
+			{`search_tx.sender = 'alice' AND tx.height = 11`, []*abci.TxResult{txs[2]}},
+			{`search_tx.amount > 5`, []*abci.TxResult{txs[1], txs[2]}},
+			{`search_tx.amount >= 5 AND search_tx.amount <= 50`, []*abci.TxResult{txs[0], txs[1]}},
+			{`search_tx.amount = 5`, []*abci.TxResult{txs[0]}},
+			{`tx.height > 10`, []*abci.TxResult{txs[2]}},
+			{`search_tx.memo CONTAINS 'world'`, []*abci.TxResult{txs[2]}},
+			{`search_tx.memo EXISTS`, []*abci.TxResult{txs[2]}},
+			{`search_tx.time < TIME 2022-01-11T00:00:00Z`, []*abci.TxResult{txs[0]}},
+			{`search_tx.sender = 'carol'`, nil},
+			{`search_tx.missing EXISTS`, nil},
+			{`search_tx.sender IN ('bob', 'carol')`, []*abci.TxResult{txs[1]}},
+			{`search_tx.amount IN (5, 500)`, []*abci.TxResult{txs[0], txs[2]}},
+			{`search_tx.sender STARTS_WITH 'al'`, []*abci.TxResult{txs[0], txs[2]}},
+			{`search_tx.memo MATCHES '^hello w'`, []*abci.TxResult{txs[2]}},
+			{`search_tx.sender MATCHES '^b.b$'`, []*abci.TxResult{txs[1]}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				results, err := indexer.SearchTxEvents(ctx, query.MustCompile(tc.query))
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, results)
+			})
+		}
+	})
+
+	t.Run("Blocks", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []int64
+		}{
+			{`block.height = 11`, []int64{11}},
+			{`search_block.size >= 1100`, []int64{11, 12}},
+			{`search_block.size > 1000 AND search_block.size < 1200`, []int64{11}},
+			{`search_block.day <= DATE 2022-01-11`, []int64{10, 11}},
+			{`search_block.size EXISTS`, []int64{10, 11, 12}},
+			{`search_block.size = 1`, nil},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				heights, err := indexer.SearchBlockEvents(ctx, query.MustCompile(tc.query))
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, heights)
+			})
+		}
+	})
+
+	t.Run("Expressions", func(t *testing.T) {
+		q := query.MustCompile(`search_tx.sender = 'alice' OR search_tx.sender = 'bob'`)
+		_, err := indexer.SearchTxEvents(ctx, q)
+		assert.Error(t, err)
+		_, err = indexer.SearchBlockEvents(ctx, q)
+		assert.Error(t, err)
+	})
+
+	t.Run("OtherChain", func(t *testing.T) {
+		other := newEventSink(indexer.DB(), "other-chainID")
+
+		results, err := other.SearchTxEvents(ctx, query.MustCompile(`search_tx.sender = 'alice'`))
+		require.NoError(t, err)
+		assert.Empty(t, results)
+
+		ok, err := other.HasBlock(10)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestConnMatcher(t *testing.T) {
+	m := newConnMatcher()
+	for _, tc := range []struct {
+		cond  string
+		value interface{}
+		want  bool
+	}{
+		{`tx.amount > 5`, "50stake", true},
+		{`tx.amount > 5`, []byte("4"), false},
+		{`tx.sender MATCHES '^al'`, "alice", true},
+		{`tx.sender IN ('bob', 'carol')`, "alice", false},
+		{`tx.sender = 'alice'`, nil, false},
+	} {
+		ok, err := m.matchValue(tc.cond, tc.value)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, ok, "%s on %v", tc.cond, tc.value)
+	}
+	assert.Len(t, m.conditions, 4)
+
+	_, err := m.matchValue(`tx.amount >`, "5")
+	assert.Error(t, err)
+}
+
+// newTestBlockHeader constructs a fresh copy of a block header containing
+// known test values to exercise the indexer.
+func newTestBlockHeader() types.EventDataNewBlockHeader {
+	return types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 1},
+		ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+			Events: []abci.Event{
+				sqlsink.MakeIndexedEvent("finalize_event.proposer", "FCAA001"),
+				sqlsink.MakeIndexedEvent("thingy.whatzit", "O.O"),
+				sqlsink.MakeIndexedEvent("my_event.foo", "100"),
+				sqlsink.MakeIndexedEvent("thingy.whatzit", "-.O"),
+			},
+		},
+	}
+}
+
+// txResultWithEvents constructs a fresh transaction result with fixed values
+// for testing, that includes the specified events.
+func txResultWithEvents(events []abci.Event) *abci.TxResult {
+	return &abci.TxResult{
+		Height: 1,
+		Index:  0,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ExecTxResult{
+			Data:   []byte{0},
+			Code:   abci.CodeTypeOK,
+			Log:    "",
+			Events: events,
+		},
+	}
+}
+
+func verifyBlock(t *testing.T, db *sql.DB, height int64) {
+	// Check that the blocks table contains an entry for this height.
+	if err := db.QueryRow(`
+SELECT height FROM `+sqlsink.TableBlocks+` WHERE height = ?1;
+`, height).Err(); err == sql.ErrNoRows {
+		t.Errorf("No block found for height=%d", height)
+	} else if err != nil {
+		t.Fatalf("Database query failed: %v", err)
+	}
+}
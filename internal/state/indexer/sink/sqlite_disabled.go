@@ -0,0 +1,20 @@
+//go:build !sqlite
+// +build !sqlite
+
+package sink
+
+import (
+	"errors"
+
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+)
+
+// SQLiteAvailable reports whether the binary is built with the SQLite event
+// sink.
+const SQLiteAvailable = false
+
+// NewSQLiteEventSink reports that the SQLite event sink is not available. It
+// requires cgo, and is only built with the sqlite build tag.
+func NewSQLiteEventSink(path, chainID string) (indexer.EventSink, error) {
+	return nil, errors.New("the sqlite indexer is not available: build with the sqlite build tag (TENDERMINT_BUILD_OPTIONS=sqlite)")
+}
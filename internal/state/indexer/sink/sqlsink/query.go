@@ -0,0 +1,130 @@
+package sqlsink
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query/syntax"
+)
+
+// Dialect describes the SQL that differs between the databases backing the
+// event sinks.
+type Dialect interface {
+	// Placeholder returns the placeholder for the i-th argument of a query,
+	// counting from 1.
+	Placeholder(i int) string
+
+	// ValuePredicate returns a SQL predicate over the value column of the
+	// event_attributes view that holds for the values satisfying cond. The
+	// operator of cond is never EXISTS. The arguments of the predicate are
+	// added to the query with b.Arg.
+	ValuePredicate(b *QueryBuilder, cond syntax.Condition) (string, error)
+}
+
+// BlockSearchSQL returns the SQL query in dialect d, and its arguments, to
+// select the heights of the blocks of chainID whose events match all the
+// conditions of q.
+func BlockSearchSQL(d Dialect, chainID string, q syntax.Query) (string, []interface{}, error) {
+	b := NewQueryBuilder(d)
+	chainArg := b.Arg(chainID)
+	preds, err := b.Conditions(q)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`
+SELECT ` + TableBlocks + `.height FROM ` + TableBlocks + `
+  WHERE ` + TableBlocks + `.chain_id = ` + chainArg)
+	for _, pred := range preds {
+		sb.WriteString(`
+  AND EXISTS (SELECT 1 FROM ` + ViewEventAttrs + `
+    WHERE ` + ViewEventAttrs + `.block_id = ` + TableBlocks + `.rowid
+      AND ` + ViewEventAttrs + `.tx_id IS NULL
+      AND ` + pred + `)`)
+	}
+	sb.WriteString(`
+  ORDER BY ` + TableBlocks + `.height;
+`)
+	return sb.String(), b.Args(), nil
+}
+
+// TxSearchSQL returns the SQL query in dialect d, and its arguments, to
+// select the results of the transactions of chainID whose events match all
+// the conditions of q.
+func TxSearchSQL(d Dialect, chainID string, q syntax.Query) (string, []interface{}, error) {
+	b := NewQueryBuilder(d)
+	chainArg := b.Arg(chainID)
+	preds, err := b.Conditions(q)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`
+SELECT ` + TableTxResults + `.tx_result FROM ` + TableTxResults + `
+  JOIN ` + TableBlocks + ` ON (` + TableBlocks + `.rowid = ` + TableTxResults + `.block_id)
+  WHERE ` + TableBlocks + `.chain_id = ` + chainArg)
+	for _, pred := range preds {
+		sb.WriteString(`
+  AND EXISTS (SELECT 1 FROM ` + ViewEventAttrs + `
+    WHERE ` + ViewEventAttrs + `.tx_id = ` + TableTxResults + `.rowid
+      AND ` + pred + `)`)
+	}
+	sb.WriteString(`
+  ORDER BY ` + TableBlocks + `.height, ` + TableTxResults + `."index";
+`)
+	return sb.String(), b.Args(), nil
+}
+
+// QueryBuilder accumulates the positional arguments for a SQL query while
+// its predicates are being constructed.
+type QueryBuilder struct {
+	dialect Dialect
+	args    []interface{}
+}
+
+// NewQueryBuilder returns a builder for a query in dialect d.
+func NewQueryBuilder(d Dialect) *QueryBuilder {
+	return &QueryBuilder{dialect: d}
+}
+
+// Arg adds v to the argument list and returns its positional placeholder.
+func (b *QueryBuilder) Arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return b.dialect.Placeholder(len(b.args))
+}
+
+// Args returns the arguments added to the query so far.
+func (b *QueryBuilder) Args() []interface{} { return b.args }
+
+// Conditions translates each condition of q into a SQL predicate over the
+// columns of the event_attributes view. A record matches the query when, for
+// every predicate, at least one of its attributes satisfies that predicate.
+func (b *QueryBuilder) Conditions(q syntax.Query) ([]string, error) {
+	preds := make([]string, 0, len(q))
+	for _, cond := range q {
+		pred, err := b.Condition(cond)
+		if err != nil {
+			return nil, fmt.Errorf("condition %q: %w", cond, err)
+		}
+		preds = append(preds, pred)
+	}
+	return preds, nil
+}
+
+// Condition translates a single query condition into a SQL predicate.
+func (b *QueryBuilder) Condition(cond syntax.Condition) (string, error) {
+	keyPred := "composite_key = " + b.Arg(cond.Tag)
+
+	// Existence checks only constrain the attribute key.
+	if cond.Op == syntax.TExists {
+		return keyPred, nil
+	}
+
+	valuePred, err := b.dialect.ValuePredicate(b, cond)
+	if err != nil {
+		return "", err
+	}
+	return keyPred + " AND " + valuePred, nil
+}
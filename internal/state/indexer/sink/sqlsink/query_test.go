@@ -0,0 +1,69 @@
+package sqlsink
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query/syntax"
+)
+
+// testDialect compares values for equality with the text of the argument of
+// a condition, and uses numbered placeholders.
+type testDialect struct{}
+
+func (testDialect) Placeholder(i int) string { return fmt.Sprintf(":%d", i) }
+
+func (testDialect) ValuePredicate(b *QueryBuilder, cond syntax.Condition) (string, error) {
+	if cond.Op != syntax.TEq {
+		return "", fmt.Errorf("unsupported operator %v", cond.Op)
+	}
+	return "value = " + b.Arg(cond.Arg.Value()), nil
+}
+
+func TestCondition(t *testing.T) {
+	q, err := syntax.Parse(`tx.sender = 'alice' AND tx.memo EXISTS`)
+	require.NoError(t, err)
+
+	b := NewQueryBuilder(testDialect{})
+	preds, err := b.Conditions(q)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`composite_key = :1 AND value = :2`, `composite_key = :3`}, preds)
+	assert.Equal(t, []interface{}{"tx.sender", "alice", "tx.memo"}, b.Args())
+
+	q, err = syntax.Parse(`tx.amount > 5`)
+	require.NoError(t, err)
+	_, err = NewQueryBuilder(testDialect{}).Conditions(q)
+	assert.Error(t, err)
+}
+
+func TestSearchSQL(t *testing.T) {
+	q, err := syntax.Parse(`tx.height = 5 AND tx.sender = 'alice'`)
+	require.NoError(t, err)
+
+	sql, args, err := TxSearchSQL(testDialect{}, "test-chain", q)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"test-chain", "tx.height", "5", "tx.sender", "alice"}, args)
+	assert.Contains(t, sql, TableBlocks+`.chain_id = :1`)
+	assert.Equal(t, 2, strings.Count(sql, "AND EXISTS"))
+	assert.Contains(t, sql, "ORDER BY "+TableBlocks+".height, "+TableTxResults+`."index"`)
+
+	sql, args, err = BlockSearchSQL(testDialect{}, "test-chain", q)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"test-chain", "tx.height", "5", "tx.sender", "alice"}, args)
+	assert.Equal(t, 2, strings.Count(sql, ViewEventAttrs+".tx_id IS NULL"))
+	assert.Contains(t, sql, "ORDER BY "+TableBlocks+".height;")
+
+	q, err = syntax.Parse(`tx.amount > 5`)
+	require.NoError(t, err)
+	_, _, err = TxSearchSQL(testDialect{}, "test-chain", q)
+	assert.Error(t, err)
+}
+
+func TestSQLPlaceholders(t *testing.T) {
+	assert.Equal(t, "VALUES (:1, :2) WHERE x = :1",
+		sqlf(testDialect{}, "VALUES (%[1]s, %[2]s) WHERE x = %[1]s", 2))
+}
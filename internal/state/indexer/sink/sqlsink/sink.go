@@ -0,0 +1,373 @@
+// Package sqlsink implements the parts of the event sinks backed by SQL
+// databases that do not depend on the database. The sinks share a relational
+// schema, described in state/indexer/sink/psql/schema.sql, and differ only in
+// the SQL dialect they speak (see Dialect).
+package sqlsink
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/internal/pubsub/query"
+	"github.com/ari-anchor/sei-tendermint/internal/state/indexer"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// The tables and views of the schema shared by the SQL event sinks.
+const (
+	TableBlocks     = "blocks"
+	TableTxResults  = "tx_results"
+	TableEvents     = "events"
+	TableAttributes = "attributes"
+	ViewEventAttrs  = "event_attributes"
+)
+
+// EventSink is an indexer backend providing the tx/block index services on
+// top of a SQL database with the shared schema. The event sinks of the
+// individual databases wrap it with a Dialect.
+type EventSink struct {
+	store    *sql.DB
+	chainID  string
+	sinkType indexer.EventSinkType
+	dialect  Dialect
+}
+
+// NewEventSink constructs an event sink of the given type that stores records
+// in db, which must have the shared schema installed, using the SQL dialect of
+// the database. Events written to the sink are attributed to the specified
+// chainID.
+func NewEventSink(db *sql.DB, chainID string, sinkType indexer.EventSinkType, dialect Dialect) *EventSink {
+	return &EventSink{
+		store:    db,
+		chainID:  chainID,
+		sinkType: sinkType,
+		dialect:  dialect,
+	}
+}
+
+// DB returns the underlying database connection used by the sink.
+// This is exported to support testing.
+func (es *EventSink) DB() *sql.DB { return es.store }
+
+// Type returns the structure type for this sink.
+func (es *EventSink) Type() indexer.EventSinkType { return es.sinkType }
+
+// runInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// queryWithID executes the specified SQL query with the given arguments,
+// expecting a single-row, single-column result containing an ID. If the query
+// succeeds, the ID from the result is returned.
+func queryWithID(tx *sql.Tx, query string, args ...interface{}) (int64, error) {
+	var id int64
+	if err := tx.QueryRow(query, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// placeholders returns the placeholders for the first n arguments of a query
+// in dialect d.
+func placeholders(d Dialect, n int) []interface{} {
+	ps := make([]interface{}, n)
+	for i := range ps {
+		ps[i] = d.Placeholder(i + 1)
+	}
+	return ps
+}
+
+// sqlf formats a SQL query whose %[i]s verbs stand for the placeholder of its
+// i-th argument in dialect d.
+func sqlf(d Dialect, format string, numArgs int) string {
+	return fmt.Sprintf(format, placeholders(d, numArgs)...)
+}
+
+// insertEvents inserts a slice of events and any indexed attributes of those
+// events into the database associated with dbtx.
+//
+// If txID > 0, the event is attributed to the Tendermint transaction with that
+// ID; otherwise it is recorded as a block event.
+func (es *EventSink) insertEvents(dbtx *sql.Tx, blockID, txID int64, evts []abci.Event) error {
+	// Populate the transaction ID field iff one is defined (> 0).
+	var txIDArg interface{}
+	if txID > 0 {
+		txIDArg = txID
+	}
+
+	insertEvent := sqlf(es.dialect, `
+INSERT INTO `+TableEvents+` (block_id, tx_id, type) VALUES (%[1]s, %[2]s, %[3]s)
+  RETURNING rowid;
+`, 3)
+	insertAttr := sqlf(es.dialect, `
+INSERT INTO `+TableAttributes+` (event_id, key, composite_key, value)
+  VALUES (%[1]s, %[2]s, %[3]s, %[4]s);
+`, 4)
+
+	// Add each event to the events table, and retrieve its row ID to use when
+	// adding any attributes the event provides.
+	for _, evt := range evts {
+		// Skip events with an empty type.
+		if evt.Type == "" {
+			continue
+		}
+
+		eid, err := queryWithID(dbtx, insertEvent, blockID, txIDArg, evt.Type)
+		if err != nil {
+			return err
+		}
+
+		// Add any attributes flagged for indexing.
+		for _, attr := range evt.Attributes {
+			if !attr.Index {
+				continue
+			}
+			compositeKey := evt.Type + "." + string(attr.Key)
+			if _, err := dbtx.Exec(insertAttr, eid, string(attr.Key), compositeKey, string(attr.Value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MakeIndexedEvent constructs an event from the specified composite key and
+// value. If the key has the form "type.name", the event will have a single
+// attribute with that name and the value; otherwise the event will have only
+// a type and no attributes.
+func MakeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return abci.Event{Type: compositeKey}
+	}
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: []byte(compositeKey[i+1:]), Value: []byte(value), Index: true},
+	}}
+}
+
+// IndexBlockEvents indexes the specified block header, part of the
+// indexer.EventSink interface.
+func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockHeader) error {
+	ts := time.Now().UTC()
+
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		// Add the block to the blocks table and report back its row ID for use
+		// in indexing the events for the block.
+		blockID, err := queryWithID(dbtx, sqlf(es.dialect, `
+INSERT INTO `+TableBlocks+` (height, chain_id, created_at)
+  VALUES (%[1]s, %[2]s, %[3]s)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, 3), h.Header.Height, es.chainID, ts)
+		if err == sql.ErrNoRows {
+			return nil // we already saw this block; quietly succeed
+		} else if err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		}
+
+		// Insert the special block meta-event for height.
+		if err := es.insertEvents(dbtx, blockID, 0, []abci.Event{
+			MakeIndexedEvent(types.BlockHeightKey, fmt.Sprint(h.Header.Height)),
+		}); err != nil {
+			return fmt.Errorf("block meta-events: %w", err)
+		}
+		// Insert all the block events. Order is important here,
+		if err := es.insertEvents(dbtx, blockID, 0, h.ResultFinalizeBlock.Events); err != nil {
+			return fmt.Errorf("finalize-block events: %w", err)
+		}
+		return nil
+	})
+}
+
+func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
+	ts := time.Now().UTC()
+
+	for _, txr := range txrs {
+		// Encode the result message in protobuf wire format for indexing.
+		resultData, err := proto.Marshal(txr)
+		if err != nil {
+			return fmt.Errorf("marshaling tx_result: %w", err)
+		}
+
+		// Index the hash of the underlying transaction as a hex string.
+		txHash := fmt.Sprintf("%X", types.Tx(txr.Tx).Hash())
+
+		if err := runInTransaction(es.store, func(dbtx *sql.Tx) error {
+			// Find the block associated with this transaction. The block header
+			// must have been indexed prior to the transactions belonging to it.
+			blockID, err := queryWithID(dbtx, sqlf(es.dialect, `
+SELECT rowid FROM `+TableBlocks+` WHERE height = %[1]s AND chain_id = %[2]s;
+`, 2), txr.Height, es.chainID)
+			if err != nil {
+				return fmt.Errorf("finding block ID: %w", err)
+			}
+
+			// Insert a record for this tx_result and capture its ID for indexing events.
+			txID, err := queryWithID(dbtx, sqlf(es.dialect, `
+INSERT INTO `+TableTxResults+` (block_id, "index", created_at, tx_hash, tx_result)
+  VALUES (%[1]s, %[2]s, %[3]s, %[4]s, %[5]s)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, 5), blockID, txr.Index, ts, txHash, resultData)
+			if err == sql.ErrNoRows {
+				return nil // we already saw this transaction; quietly succeed
+			} else if err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			}
+
+			// Insert the special transaction meta-events for hash and height.
+			if err := es.insertEvents(dbtx, blockID, txID, []abci.Event{
+				MakeIndexedEvent(types.TxHashKey, txHash),
+				MakeIndexedEvent(types.TxHeightKey, fmt.Sprint(txr.Height)),
+			}); err != nil {
+				return fmt.Errorf("indexing transaction meta-events: %w", err)
+			}
+			// Index any events packaged with the transaction.
+			if err := es.insertEvents(dbtx, blockID, txID, txr.Result.Events); err != nil {
+				return fmt.Errorf("indexing transaction events: %w", err)
+			}
+			return nil
+
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SearchBlockEvents returns the heights of the blocks whose events match all
+// the conditions of q, in increasing order. It is part of the
+// indexer.EventSink interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	if q == nil {
+		return nil, errors.New("block search requires a query")
+	}
+	if q.Syntax() == nil {
+		return nil, errors.New("block search: queries using OR and NOT are not supported")
+	}
+
+	sqlQuery, args, err := BlockSearchSQL(es.dialect, es.chainID, q.Syntax())
+	if err != nil {
+		return nil, fmt.Errorf("block search: %w", err)
+	}
+
+	rows, err := es.store.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("block search: %w", err)
+	}
+	defer rows.Close()
+
+	var heights []int64
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, fmt.Errorf("block search: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("block search: %w", err)
+	}
+	return heights, nil
+}
+
+// SearchTxEvents returns the results of the transactions whose events match
+// all the conditions of q, ordered by height and then by index within the
+// block. It is part of the indexer.EventSink interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	if q == nil {
+		return nil, errors.New("tx search requires a query")
+	}
+	if q.Syntax() == nil {
+		return nil, errors.New("tx search: queries using OR and NOT are not supported")
+	}
+
+	sqlQuery, args, err := TxSearchSQL(es.dialect, es.chainID, q.Syntax())
+	if err != nil {
+		return nil, fmt.Errorf("tx search: %w", err)
+	}
+
+	rows, err := es.store.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("tx search: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*abci.TxResult
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, fmt.Errorf("tx search: %w", err)
+		}
+		txr, err := unmarshalTxResult(resultData)
+		if err != nil {
+			return nil, fmt.Errorf("tx search: %w", err)
+		}
+		results = append(results, txr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("tx search: %w", err)
+	}
+	return results, nil
+}
+
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if no such transaction has been indexed. It is part of the
+// indexer.EventSink interface.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	var resultData []byte
+	err := es.store.QueryRow(sqlf(es.dialect, `
+SELECT `+TableTxResults+`.tx_result FROM `+TableTxResults+`
+  JOIN `+TableBlocks+` ON (`+TableBlocks+`.rowid = `+TableTxResults+`.block_id)
+  WHERE `+TableTxResults+`.tx_hash = %[1]s AND `+TableBlocks+`.chain_id = %[2]s;
+`, 2), fmt.Sprintf("%X", hash), es.chainID).Scan(&resultData)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting tx by hash: %w", err)
+	}
+	return unmarshalTxResult(resultData)
+}
+
+// HasBlock reports whether the block header at height h has been indexed. It
+// is part of the indexer.EventSink interface.
+func (es *EventSink) HasBlock(h int64) (bool, error) {
+	var found bool
+	if err := es.store.QueryRow(sqlf(es.dialect, `
+SELECT EXISTS (SELECT 1 FROM `+TableBlocks+` WHERE height = %[1]s AND chain_id = %[2]s);
+`, 2), h, es.chainID).Scan(&found); err != nil {
+		return false, fmt.Errorf("checking block: %w", err)
+	}
+	return found, nil
+}
+
+// unmarshalTxResult decodes a tx_result column value.
+func unmarshalTxResult(data []byte) (*abci.TxResult, error) {
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(data, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
+}
+
+// Stop closes the underlying database.
+func (es *EventSink) Stop() error { return es.store.Close() }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -135,8 +136,12 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 // performing a full scan. Results from querying indexes are then intersected
 // and returned to the caller, in no particular order.
 //
+// Queries using OR and NOT are planned by indexer.ExprSearcher, which searches
+// each conjunction of conditions as above and merges or subtracts the results.
+//
 // Search will exit early and return any result fetched so far,
-// when a message is received on the context chan.
+// when a message is received on the context chan, unless the query negates
+// conditions, in which case the error of the context is returned.
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
 	select {
 	case <-ctx.Done():
@@ -145,26 +150,61 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
+	if q == nil {
+		return make([]*abci.TxResult, 0), nil
+	}
+
+	searcher := indexer.ExprSearcher{
+		Match: txi.matchConditions,
+		All:   txi.matchAll,
+	}
+	filteredHashes, err := searcher.Search(ctx, q.Expr())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(filteredHashes))
+hashes:
+	for _, h := range filteredHashes {
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		results = append(results, res)
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break hashes
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// matchConditions returns the hashes of the txs matching all of the given
+// conditions.
+func (txi *TxIndex) matchConditions(ctx context.Context, conditions []syntax.Condition) (indexer.MatchSet, error) {
 	var hashesInitialized bool
 	filteredHashes := make(map[string][]byte)
 
-	// get a list of conditions (like "tx.height > 5")
-	conditions := q.Syntax()
-
 	// if there is a hash condition, return the result immediately
-	hash, ok, err := lookForHash(conditions)
+	hashes, ok, err := lookForHashes(conditions)
 	if err != nil {
 		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
 	} else if ok {
-		res, err := txi.Get(hash)
-		switch {
-		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+		matches := make(indexer.MatchSet)
+		for _, hash := range hashes {
+			res, err := txi.Get(hash)
+			if err != nil {
+				return nil, fmt.Errorf("error while retrieving the result: %w", err)
+			}
+			if res != nil {
+				matches[string(hash)] = hash
+			}
 		}
+		return matches, nil
 	}
 
 	// conditions to skip because they're handled before "everything else"
@@ -216,32 +256,35 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-hashes:
-	for _, h := range filteredHashes {
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		results = append(results, res)
-
-		// Potentially exit early.
-		select {
-		case <-ctx.Done():
-			break hashes
-		default:
-		}
-	}
+	return filteredHashes, nil
+}
 
-	return results, nil
+// matchAll returns the hashes of all the indexed txs, which are all indexed by
+// height.
+func (txi *TxIndex) matchAll(ctx context.Context) (indexer.MatchSet, error) {
+	c := syntax.Condition{Tag: types.TxHeightKey, Op: syntax.TExists}
+	return txi.match(ctx, c, nil, nil, true), nil
 }
 
-func lookForHash(conditions []syntax.Condition) (hash []byte, ok bool, err error) {
+// lookForHashes returns the hashes of the txs to look up if there is a hash
+// condition, such as "tx.hash = 'X'" or "tx.hash IN ('X', 'Y')".
+func lookForHashes(conditions []syntax.Condition) (hashes [][]byte, ok bool, err error) {
 	for _, c := range conditions {
-		if c.Tag == types.TxHashKey {
-			decoded, err := hex.DecodeString(c.Arg.Value())
-			return decoded, true, err
+		if c.Tag != types.TxHashKey {
+			continue
+		}
+		args := c.List
+		if c.Op != syntax.TIn {
+			args = []*syntax.Arg{c.Arg}
 		}
+		for _, arg := range args {
+			decoded, err := hex.DecodeString(arg.Value())
+			if err != nil {
+				return nil, true, err
+			}
+			hashes = append(hashes, decoded)
+		}
+		return hashes, true, nil
 	}
 	return
 }
@@ -353,6 +396,70 @@ func (txi *TxIndex) match(
 		if err := it.Error(); err != nil {
 			panic(err)
 		}
+	case c.Op == syntax.TStartsWith:
+		it, err := dbm.IteratePrefix(txi.store, prefixFromCompositeKeyAndValuePrefix(c.Tag, c.Arg.Value()))
+		if err != nil {
+			panic(err)
+		}
+		defer it.Close()
+
+	iterStartsWith:
+		for ; it.Valid(); it.Next() {
+			tmpHashes[string(it.Value())] = it.Value()
+
+			// Potentially exit early.
+			select {
+			case <-ctx.Done():
+				break iterStartsWith
+			default:
+			}
+		}
+		if err := it.Error(); err != nil {
+			panic(err)
+		}
+
+	case c.Op == syntax.TMatches:
+		// The pattern was validated when the query was compiled.
+		re := regexp.MustCompile(c.Arg.Value())
+		it, err := dbm.IteratePrefix(txi.store, prefixFromCompositeKey(c.Tag))
+		if err != nil {
+			panic(err)
+		}
+		defer it.Close()
+
+	iterMatches:
+		for ; it.Valid(); it.Next() {
+			value, err := parseValueFromKey(it.Key())
+			if err != nil {
+				continue
+			}
+			if re.MatchString(value) {
+				tmpHashes[string(it.Value())] = it.Value()
+			}
+
+			// Potentially exit early.
+			select {
+			case <-ctx.Done():
+				break iterMatches
+			default:
+			}
+		}
+		if err := it.Error(); err != nil {
+			panic(err)
+		}
+
+	case c.Op == syntax.TIn:
+		// Each value is looked up as an equality condition.
+		for _, arg := range c.List {
+			if ctx.Err() != nil {
+				break
+			}
+			cond := syntax.Condition{Tag: c.Tag, Op: syntax.TEq, Arg: arg}
+			for k, v := range txi.match(ctx, cond, prefixFromCompositeKeyAndValue(c.Tag, arg.Value()), nil, true) {
+				tmpHashes[k] = v
+			}
+		}
+
 	default:
 		panic("other operators should be handled already")
 	}
@@ -574,6 +681,18 @@ func prefixFromCompositeKeyAndValue(compositeKey, value string) []byte {
 	return key
 }
 
+// prefixFromCompositeKeyAndValuePrefix returns the prefix of the keys whose
+// values start with valuePrefix. Strings are encoded byte by byte, followed by
+// a terminator, so the encoding of valuePrefix without its terminator is a
+// prefix of the encoding of every such value.
+func prefixFromCompositeKeyAndValuePrefix(compositeKey, valuePrefix string) []byte {
+	key := prefixFromCompositeKeyAndValue(compositeKey, valuePrefix)
+	return key[:len(key)-len(stringTerminator)]
+}
+
+// stringTerminator is the suffix orderedcode appends to encoded strings.
+const stringTerminator = "\x00\x01"
+
 // a small utility function for getting a keys prefix based on a condition and a height
 func prefixForCondition(c syntax.Condition, height int64) []byte {
 	key := prefixFromCompositeKeyAndValue(c.Tag, c.Arg.Value())
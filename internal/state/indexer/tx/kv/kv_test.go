@@ -3,6 +3,7 @@ package kv
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -82,6 +83,8 @@ func TestTxSearch(t *testing.T) {
 	}{
 		// search by hash
 		{fmt.Sprintf("tx.hash = '%X'", hash), 1},
+		{fmt.Sprintf("tx.hash IN ('%X', 'AB')", hash), 1},
+		{"tx.hash IN ('AB', 'CD')", 0},
 		// search by exact match (one key)
 		{"account.number = 1", 1},
 		// search by exact match (two keys)
@@ -316,6 +319,71 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchExpressions(t *testing.T) {
+	indexer := NewTxIndex(dbm.NewMemDB())
+
+	owners := []string{"Ivan", "Vlad", "Anna", "Igor"}
+	for i, owner := range owners {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: []byte("number"), Value: []byte(fmt.Sprintf("%d", i+1)), Index: true},
+				{Key: []byte("owner"), Value: []byte(owner), Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(owner + "'s account")
+		txResult.Height = int64(i/2 + 1)
+		txResult.Index = uint32(i % 2)
+		if i == 0 {
+			txResult.Result.Events = append(txResult.Result.Events, abci.Event{
+				Type: "slash", Attributes: []abci.EventAttribute{{Key: []byte("reason"), Value: []byte("missed"), Index: true}},
+			})
+		}
+		require.NoError(t, indexer.Index([]*abci.TxResult{txResult}))
+	}
+
+	testCases := []struct {
+		q    string
+		want []string
+	}{
+		{"account.owner = 'Ivan' OR account.owner = 'Anna'", []string{"Ivan", "Anna"}},
+		{"account.number <= 1 OR account.number >= 4", []string{"Ivan", "Igor"}},
+		{"account.owner = 'Ivan' OR account.owner = 'Boris'", []string{"Ivan"}},
+		{"NOT slash.reason EXISTS", []string{"Vlad", "Anna", "Igor"}},
+		{"NOT account.number EXISTS", nil},
+		{"account.number >= 2 AND NOT account.owner CONTAINS 'a'", []string{"Igor"}},
+		{"tx.height = 1 AND (account.owner = 'Vlad' OR account.owner = 'Anna')", []string{"Vlad"}},
+		{"(account.number < 2 OR tx.height = 2) AND NOT slash.reason EXISTS", []string{"Anna", "Igor"}},
+		{"NOT (account.number > 1 AND account.number < 4)", []string{"Ivan", "Igor"}},
+		{"NOT account.owner = 'Ivan' AND NOT account.owner = 'Igor'", []string{"Vlad", "Anna"}},
+		{"account.owner IN ('Ivan', 'Anna', 'Boris')", []string{"Ivan", "Anna"}},
+		{"account.number IN (2, 4) AND tx.height = 2", []string{"Igor"}},
+		{"tx.height IN (2)", []string{"Anna", "Igor"}},
+		{"account.owner STARTS_WITH 'I'", []string{"Ivan", "Igor"}},
+		{"account.owner STARTS_WITH 'Iv'", []string{"Ivan"}},
+		{"account.owner STARTS_WITH 'Ivan'", []string{"Ivan"}},
+		{"account.owner STARTS_WITH 'Ivana'", nil},
+		{"account.owner STARTS_WITH ''", []string{"Ivan", "Vlad", "Anna", "Igor"}},
+		{"account.owner MATCHES '^[AV]'", []string{"Vlad", "Anna"}},
+		{"account.owner MATCHES 'a.$' OR account.number MATCHES '^4$'", []string{"Ivan", "Vlad", "Igor"}},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(ctx, query.MustCompile(tc.q))
+			require.NoError(t, err)
+
+			var got []string
+			for _, txr := range results {
+				got = append(got, strings.TrimSuffix(string(txr.Tx), "'s account"))
+			}
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
@@ -0,0 +1,215 @@
+package statesync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ari-anchor/sei-tendermint/config"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	ssproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/statesync"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// Reasons for dropping a request of a peer, as reported by the
+// ServeRequestsDropped metric.
+const (
+	dropReasonRateLimited = "rate_limited"
+	dropReasonQueueFull   = "queue_full"
+)
+
+// servePeer is the request budget and the queue of chunk requests of a peer.
+type servePeer struct {
+	tokens     float64
+	lastRefill time.Time
+	queue      []*ssproto.ChunkRequest
+}
+
+// chunkServer serves the snapshot chunks requested by peers. Each peer has a
+// budget of requests per second, replenished as a token bucket, and a bounded
+// queue of chunk requests; requests beyond either are dropped, and the peer
+// will request the chunk again, possibly from another node. The queued
+// requests are served by a fixed number of workers, which bounds the number
+// of concurrent LoadSnapshotChunk calls to the application, and which take
+// the requests of the peers in turn, so a peer with many requests queued
+// does not hold back the others.
+type chunkServer struct {
+	logger    log.Logger
+	metrics   *Metrics
+	workers   int
+	queueSize int
+	rate      float64
+	serve     func(context.Context, types.NodeID, *ssproto.ChunkRequest)
+
+	mtx       sync.Mutex
+	peers     map[types.NodeID]*servePeer
+	ready     []types.NodeID // peers with queued requests, in serving order
+	numQueued int
+	wakeCh    chan struct{}
+}
+
+func newChunkServer(
+	logger log.Logger,
+	cfg config.StateSyncConfig,
+	metrics *Metrics,
+	serve func(context.Context, types.NodeID, *ssproto.ChunkRequest),
+) *chunkServer {
+	return &chunkServer{
+		logger:    logger,
+		metrics:   metrics,
+		workers:   cfg.ChunkServeConcurrency,
+		queueSize: cfg.ChunkServeQueueSize,
+		rate:      cfg.ChunkServeRate,
+		serve:     serve,
+		peers:     make(map[types.NodeID]*servePeer),
+		wakeCh:    make(chan struct{}, 1),
+	}
+}
+
+// allow takes a request from the budget of the peer, and reports whether
+// there was one left. Requests that are not allowed should be dropped.
+func (cs *chunkServer) allow(peerID types.NodeID) bool {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	return cs.takeToken(peerID, time.Now())
+}
+
+// takeToken takes a request from the budget of the peer. The caller must
+// hold the lock.
+func (cs *chunkServer) takeToken(peerID types.NodeID, now time.Time) bool {
+	peer := cs.peer(peerID, now)
+	if cs.rate == 0 {
+		return true
+	}
+
+	peer.tokens += now.Sub(peer.lastRefill).Seconds() * cs.rate
+	if max := float64(cs.queueSize); peer.tokens > max {
+		peer.tokens = max
+	}
+	peer.lastRefill = now
+	if peer.tokens < 1 {
+		cs.logger.Debug("dropping request of peer over its rate limit", "peer", peerID)
+		cs.metrics.ServeRequestsDropped.With("reason", dropReasonRateLimited).Add(1)
+		return false
+	}
+	peer.tokens--
+	return true
+}
+
+// peer returns the state of the peer, starting it with a full budget if the
+// peer is new. The caller must hold the lock.
+func (cs *chunkServer) peer(peerID types.NodeID, now time.Time) *servePeer {
+	peer, ok := cs.peers[peerID]
+	if !ok {
+		peer = &servePeer{tokens: float64(cs.queueSize), lastRefill: now}
+		cs.peers[peerID] = peer
+	}
+	return peer
+}
+
+// enqueue queues the chunk request of the peer to be served, unless the peer
+// is over its budget or its queue is full.
+func (cs *chunkServer) enqueue(peerID types.NodeID, req *ssproto.ChunkRequest) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	if !cs.takeToken(peerID, time.Now()) {
+		return
+	}
+	peer := cs.peers[peerID]
+	if len(peer.queue) >= cs.queueSize {
+		cs.logger.Debug("dropping chunk request of peer with a full queue", "peer", peerID)
+		cs.metrics.ServeRequestsDropped.With("reason", dropReasonQueueFull).Add(1)
+		return
+	}
+
+	if len(peer.queue) == 0 {
+		cs.ready = append(cs.ready, peerID)
+	}
+	peer.queue = append(peer.queue, req)
+	cs.numQueued++
+	cs.metrics.ChunkRequestsQueued.Set(float64(cs.numQueued))
+	cs.wake()
+}
+
+// removePeer drops the requests and the budget of the peer.
+func (cs *chunkServer) removePeer(peerID types.NodeID) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	peer, ok := cs.peers[peerID]
+	if !ok {
+		return
+	}
+	delete(cs.peers, peerID)
+	if len(peer.queue) == 0 {
+		return
+	}
+	cs.numQueued -= len(peer.queue)
+	cs.metrics.ChunkRequestsQueued.Set(float64(cs.numQueued))
+	for i, id := range cs.ready {
+		if id == peerID {
+			cs.ready = append(cs.ready[:i], cs.ready[i+1:]...)
+			break
+		}
+	}
+}
+
+// next pops the next request to serve, taking the peers in turn.
+func (cs *chunkServer) next() (types.NodeID, *ssproto.ChunkRequest, bool) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	if len(cs.ready) == 0 {
+		return "", nil, false
+	}
+	peerID := cs.ready[0]
+	cs.ready = cs.ready[1:]
+	peer := cs.peers[peerID]
+	req := peer.queue[0]
+	peer.queue = peer.queue[1:]
+	if len(peer.queue) > 0 {
+		cs.ready = append(cs.ready, peerID)
+	}
+	cs.numQueued--
+	cs.metrics.ChunkRequestsQueued.Set(float64(cs.numQueued))
+
+	// Let another worker pick up the remaining requests.
+	if len(cs.ready) > 0 {
+		cs.wake()
+	}
+	return peerID, req, true
+}
+
+// wake signals a waiting worker that there are requests to serve.
+func (cs *chunkServer) wake() {
+	select {
+	case cs.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// run serves the queued requests until the context is canceled.
+func (cs *chunkServer) run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < cs.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				peerID, req, ok := cs.next()
+				if !ok {
+					select {
+					case <-cs.wakeCh:
+						continue
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				cs.serve(ctx, peerID, req)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,142 @@
+package statesync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ari-anchor/sei-tendermint/config"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	ssproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/statesync"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+func newTestChunkServer(
+	concurrency, queueSize int,
+	rate float64,
+	serve func(context.Context, types.NodeID, *ssproto.ChunkRequest),
+) *chunkServer {
+	cfg := config.DefaultStateSyncConfig()
+	cfg.ChunkServeConcurrency = concurrency
+	cfg.ChunkServeQueueSize = queueSize
+	cfg.ChunkServeRate = rate
+	return newChunkServer(log.NewNopLogger(), *cfg, NopMetrics(), serve)
+}
+
+func TestChunkServerFairness(t *testing.T) {
+	cs := newTestChunkServer(1, 10, 0, nil)
+	for i := uint32(0); i < 3; i++ {
+		cs.enqueue("a", &ssproto.ChunkRequest{Index: i})
+	}
+	cs.enqueue("b", &ssproto.ChunkRequest{Index: 0})
+	cs.enqueue("c", &ssproto.ChunkRequest{Index: 0})
+
+	// The peers are served in turn, in the order of their first request.
+	var (
+		order  []types.NodeID
+		indexA uint32
+	)
+	for {
+		peerID, req, ok := cs.next()
+		if !ok {
+			break
+		}
+		// The requests of a peer are served in order.
+		if peerID == "a" {
+			assert.Equal(t, indexA, req.Index)
+			indexA++
+		}
+		order = append(order, peerID)
+	}
+	assert.Equal(t, []types.NodeID{"a", "b", "c", "a", "a"}, order)
+	assert.Zero(t, cs.numQueued)
+}
+
+func TestChunkServerBudgets(t *testing.T) {
+	// The queue of a peer is bounded.
+	cs := newTestChunkServer(1, 2, 0, nil)
+	for i := uint32(0); i < 3; i++ {
+		cs.enqueue("a", &ssproto.ChunkRequest{Index: i})
+	}
+	cs.enqueue("b", &ssproto.ChunkRequest{})
+	assert.Len(t, cs.peers["a"].queue, 2)
+	assert.Len(t, cs.peers["b"].queue, 1)
+
+	// Dropping a peer drops its requests.
+	cs.removePeer("a")
+	assert.Equal(t, []types.NodeID{"b"}, cs.ready)
+	assert.Equal(t, 1, cs.numQueued)
+
+	// The requests of a peer are limited to a burst of the queue size, and
+	// then to the rate.
+	cs = newTestChunkServer(1, 2, 1, nil)
+	assert.True(t, cs.allow("a"))
+	assert.True(t, cs.allow("a"))
+	assert.False(t, cs.allow("a"))
+	assert.True(t, cs.allow("b"))
+
+	cs.peers["a"].lastRefill = cs.peers["a"].lastRefill.Add(-time.Second)
+	assert.True(t, cs.allow("a"))
+	assert.False(t, cs.allow("a"))
+	cs.enqueue("a", &ssproto.ChunkRequest{})
+	assert.Empty(t, cs.peers["a"].queue)
+}
+
+func TestChunkServerConcurrency(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const concurrency = 2
+	var (
+		mtx     sync.Mutex
+		running int
+		maxRun  int
+		served  = make(chan types.NodeID, 10)
+		release = make(chan struct{})
+	)
+	cs := newTestChunkServer(concurrency, 10, 0, func(ctx context.Context, peerID types.NodeID, req *ssproto.ChunkRequest) {
+		mtx.Lock()
+		running++
+		if running > maxRun {
+			maxRun = running
+		}
+		mtx.Unlock()
+
+		<-release
+
+		mtx.Lock()
+		running--
+		mtx.Unlock()
+		served <- peerID
+	})
+	go cs.run(ctx)
+
+	peers := []types.NodeID{"a", "b", "c", "d", "e"}
+	for _, peerID := range peers {
+		cs.enqueue(peerID, &ssproto.ChunkRequest{})
+	}
+	require.Eventually(t, func() bool {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return running == concurrency
+	}, time.Second, 10*time.Millisecond)
+
+	close(release)
+	var got []types.NodeID
+	for range peers {
+		select {
+		case peerID := <-served:
+			got = append(got, peerID)
+		case <-time.After(time.Second):
+			t.Fatal("not all requests were served")
+		}
+	}
+	assert.ElementsMatch(t, peers, got)
+	mtx.Lock()
+	assert.Equal(t, concurrency, maxRun)
+	mtx.Unlock()
+}
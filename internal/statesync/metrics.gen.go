@@ -56,17 +56,47 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "back_fill_blocks_total",
 			Help:      "The total number of blocks that need to be back-filled.",
 		}, labels).With(labelsAndValues...),
+		ChunkRequestsQueued: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_requests_queued",
+			Help:      "The number of chunk requests of peers waiting to be served.",
+		}, labels).With(labelsAndValues...),
+		ChunksServed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunks_served",
+			Help:      "The number of chunks served to peers.",
+		}, labels).With(labelsAndValues...),
+		ServeRequestsDropped: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "serve_requests_dropped",
+			Help:      "The number of snapshot and chunk requests of peers that were dropped, because the peer exceeded its request rate or its queue was full.",
+		}, append(labels, "reason")).With(labelsAndValues...),
+		ChunkLoadDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_load_duration",
+			Help:      "Time in seconds taken to load a chunk from the application to serve a peer.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.01, 10, 8),
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		TotalSnapshots:      discard.NewCounter(),
-		ChunkProcessAvgTime: discard.NewGauge(),
-		SnapshotHeight:      discard.NewGauge(),
-		SnapshotChunk:       discard.NewCounter(),
-		SnapshotChunkTotal:  discard.NewGauge(),
-		BackFilledBlocks:    discard.NewCounter(),
-		BackFillBlocksTotal: discard.NewGauge(),
+		TotalSnapshots:       discard.NewCounter(),
+		ChunkProcessAvgTime:  discard.NewGauge(),
+		SnapshotHeight:       discard.NewGauge(),
+		SnapshotChunk:        discard.NewCounter(),
+		SnapshotChunkTotal:   discard.NewGauge(),
+		BackFilledBlocks:     discard.NewCounter(),
+		BackFillBlocksTotal:  discard.NewGauge(),
+		ChunkRequestsQueued:  discard.NewGauge(),
+		ChunksServed:         discard.NewCounter(),
+		ServeRequestsDropped: discard.NewCounter(),
+		ChunkLoadDuration:    discard.NewHistogram(),
 	}
 }
@@ -27,4 +27,14 @@ type Metrics struct {
 	BackFilledBlocks metrics.Counter
 	// The total number of blocks that need to be back-filled.
 	BackFillBlocksTotal metrics.Gauge
+	// The number of chunk requests of peers waiting to be served.
+	ChunkRequestsQueued metrics.Gauge
+	// The number of chunks served to peers.
+	ChunksServed metrics.Counter
+	// The number of snapshot and chunk requests of peers that were dropped,
+	// because the peer exceeded its request rate or its queue was full.
+	ServeRequestsDropped metrics.Counter `metrics_labels:"reason"`
+	// Time in seconds taken to load a chunk from the application to serve a
+	// peer.
+	ChunkLoadDuration metrics.Histogram `metrics_buckettype:"exprange" metrics_bucketsizes:"0.01, 10, 8"`
 }
@@ -157,6 +157,9 @@ type Reactor struct {
 	dispatcher *light.Dispatcher
 	peers      *light.PeerList
 
+	// chunkServer serves the snapshot chunks requested by peers.
+	chunkServer *chunkServer
+
 	// These will only be set when a state sync is in progress. It is used to feed
 	// received snapshots and chunks into the syncer and manage incoming and outgoing
 	// providers.
@@ -227,6 +230,7 @@ func NewReactor(
 		restartCh:                     restartCh,
 		restartNoAvailablePeersWindow: time.Duration(selfRemediationConfig.StatesyncNoPeersRestartWindowSeconds) * time.Second,
 	}
+	r.chunkServer = newChunkServer(logger, cfg, ssMetrics, r.serveChunk)
 
 	r.BaseService = *service.NewBaseService(logger, "StateSync", r)
 	return r
@@ -251,7 +255,9 @@ func (r *Reactor) SetParamsChannel(ch *p2p.Channel) {
 // OnStart starts separate go routines for each p2p Channel and listens for
 // envelopes on each. In addition, it also listens for peer updates and handles
 // messages on that p2p channel accordingly. Note, we do not launch a go-routine to
-// handle individual envelopes as to not have to deal with bounding workers or pools.
+// handle individual envelopes as to not have to deal with bounding workers or pools,
+// except for chunk requests, which are served by the bounded workers of the chunk
+// server.
 // The caller must be sure to execute OnStop to ensure the outbound p2p Channels are
 // closed. No error is returned.
 func (r *Reactor) OnStart(ctx context.Context) error {
@@ -334,6 +340,7 @@ func (r *Reactor) OnStart(ctx context.Context) error {
 		ParamsChannel:     r.paramsChannel,
 	})
 	go r.processPeerUpdates(ctx, r.peerEvents(ctx))
+	go r.chunkServer.run(ctx)
 
 	if r.needsStateSync {
 		r.logger.Info("starting state sync")
@@ -638,6 +645,9 @@ func (r *Reactor) handleSnapshotMessage(ctx context.Context, envelope *p2p.Envel
 
 	switch msg := envelope.Message.(type) {
 	case *ssproto.SnapshotsRequest:
+		if !r.chunkServer.allow(envelope.From) {
+			return nil
+		}
 		snapshots, err := r.recentSnapshots(ctx, recentSnapshots)
 		if err != nil {
 			logger.Error("failed to fetch snapshots", "err", err)
@@ -702,6 +712,53 @@ func (r *Reactor) handleSnapshotMessage(ctx context.Context, envelope *p2p.Envel
 	return nil
 }
 
+// serveChunk loads the requested chunk from the application and sends it to
+// the peer. It is called by the chunk server.
+func (r *Reactor) serveChunk(ctx context.Context, peerID types.NodeID, msg *ssproto.ChunkRequest) {
+	start := time.Now()
+	resp, err := r.conn.LoadSnapshotChunk(ctx, &abci.RequestLoadSnapshotChunk{
+		Height: msg.Height,
+		Format: msg.Format,
+		Chunk:  msg.Index,
+	})
+	r.metrics.ChunkLoadDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		r.logger.Error(
+			"failed to load chunk",
+			"height", msg.Height,
+			"format", msg.Format,
+			"chunk", msg.Index,
+			"err", err,
+			"peer", peerID,
+		)
+		return
+	}
+
+	r.logger.Debug(
+		"sending chunk",
+		"height", msg.Height,
+		"format", msg.Format,
+		"chunk", msg.Index,
+		"peer", peerID,
+	)
+	if err := r.chunkChannel.Send(ctx, p2p.Envelope{
+		To: peerID,
+		Message: &ssproto.ChunkResponse{
+			Height:  msg.Height,
+			Format:  msg.Format,
+			Index:   msg.Index,
+			Chunk:   resp.Chunk,
+			Missing: resp.Chunk == nil,
+		},
+	}); err != nil {
+		if ctx.Err() == nil {
+			r.logger.Error("failed to send chunk", "peer", peerID, "err", err)
+		}
+		return
+	}
+	r.metrics.ChunksServed.Add(1)
+}
+
 // handleChunkMessage handles envelopes sent from peers on the ChunkChannel.
 // It returns an error only if the Envelope.Message is unknown for this channel.
 // This should never be called outside of handleMessage.
@@ -715,42 +772,7 @@ func (r *Reactor) handleChunkMessage(ctx context.Context, envelope *p2p.Envelope
 			"chunk", msg.Index,
 			"peer", envelope.From,
 		)
-		resp, err := r.conn.LoadSnapshotChunk(ctx, &abci.RequestLoadSnapshotChunk{
-			Height: msg.Height,
-			Format: msg.Format,
-			Chunk:  msg.Index,
-		})
-		if err != nil {
-			r.logger.Error(
-				"failed to load chunk",
-				"height", msg.Height,
-				"format", msg.Format,
-				"chunk", msg.Index,
-				"err", err,
-				"peer", envelope.From,
-			)
-			return nil
-		}
-
-		r.logger.Debug(
-			"sending chunk",
-			"height", msg.Height,
-			"format", msg.Format,
-			"chunk", msg.Index,
-			"peer", envelope.From,
-		)
-		if err := chunkCh.Send(ctx, p2p.Envelope{
-			To: envelope.From,
-			Message: &ssproto.ChunkResponse{
-				Height:  msg.Height,
-				Format:  msg.Format,
-				Index:   msg.Index,
-				Chunk:   resp.Chunk,
-				Missing: resp.Chunk == nil,
-			},
-		}); err != nil {
-			return err
-		}
+		r.chunkServer.enqueue(envelope.From, msg)
 
 	case *ssproto.ChunkResponse:
 		r.mtx.RLock()
@@ -992,6 +1014,7 @@ func (r *Reactor) processPeerUpdate(ctx context.Context, peerUpdate p2p.PeerUpda
 		}
 	case p2p.PeerStatusDown:
 		r.peers.Remove(peerUpdate.NodeID)
+		r.chunkServer.removePeer(peerUpdate.NodeID)
 	}
 
 	r.mtx.Lock()
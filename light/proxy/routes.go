@@ -116,6 +116,14 @@ func (p proxyService) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultU
 	return p.Client.NumUnconfirmedTxs(ctx)
 }
 
+func (p proxyService) MempoolTxs(ctx context.Context, req *coretypes.RequestMempoolTxs) (*coretypes.ResultMempoolTxs, error) {
+	return p.Client.MempoolTxs(ctx, req)
+}
+
+func (p proxyService) MempoolTx(ctx context.Context, req *coretypes.RequestMempoolTx) (*coretypes.ResultMempoolTx, error) {
+	return p.Client.MempoolTx(ctx, req.TxKey)
+}
+
 func (p proxyService) RemoveTx(ctx context.Context, req *coretypes.RequestRemoveTx) error {
 	return p.Client.RemoveTx(ctx, req.TxKey)
 }
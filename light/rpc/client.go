@@ -8,8 +8,6 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
-
 	abci "github.com/ari-anchor/sei-tendermint/abci/types"
 	"github.com/ari-anchor/sei-tendermint/crypto/merkle"
 	tmbytes "github.com/ari-anchor/sei-tendermint/libs/bytes"
@@ -43,6 +41,7 @@ var _ rpcclient.Client = (*Client)(nil)
 // returned by ABCI#Query.
 type Client struct {
 	service.BaseService
+	logger log.Logger
 
 	next rpcclient.Client
 	lc   LightClient
@@ -92,9 +91,10 @@ func DefaultMerkleKeyPathFn() KeyPathFunc {
 // NewClient returns a new client.
 func NewClient(logger log.Logger, next rpcclient.Client, lc LightClient, opts ...Option) *Client {
 	c := &Client{
-		next: next,
-		lc:   lc,
-		prt:  merkle.DefaultProofRuntime(),
+		logger: logger,
+		next:   next,
+		lc:     lc,
+		prt:    merkle.DefaultProofRuntime(),
 	}
 	c.BaseService = *service.NewBaseService(logger, "Client", c)
 	for _, o := range opts {
@@ -242,6 +242,14 @@ func (c *Client) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconf
 	return c.next.NumUnconfirmedTxs(ctx)
 }
 
+func (c *Client) MempoolTxs(ctx context.Context, req *coretypes.RequestMempoolTxs) (*coretypes.ResultMempoolTxs, error) {
+	return c.next.MempoolTxs(ctx, req)
+}
+
+func (c *Client) MempoolTx(ctx context.Context, txKey types.TxKey) (*coretypes.ResultMempoolTx, error) {
+	return c.next.MempoolTx(ctx, txKey)
+}
+
 func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*coretypes.ResultCheckTx, error) {
 	return c.next.CheckTx(ctx, tx)
 }
@@ -355,30 +363,9 @@ func (c *Client) Block(ctx context.Context, height *int64) (*coretypes.ResultBlo
 		return nil, err
 	}
 
-	// Validate res.
-	if err := res.BlockID.ValidateBasic(); err != nil {
-		return nil, err
-	}
-	if err := res.Block.ValidateBasic(); err != nil {
+	if err := c.verifyBlock(ctx, res); err != nil {
 		return nil, err
 	}
-	if bmH, bH := res.BlockID.Hash, res.Block.Hash(); !bytes.Equal(bmH, bH) {
-		return nil, fmt.Errorf("blockID %X does not match with block %X",
-			bmH, bH)
-	}
-
-	// Update the light client if we're behind.
-	l, err := c.updateLightClientIfNeededTo(ctx, &res.Block.Height)
-	if err != nil {
-		return nil, err
-	}
-
-	// Verify block.
-	if bH, tH := res.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
-		return nil, fmt.Errorf("block header %X does not match with trusted header %X",
-			bH, tH)
-	}
-
 	return res, nil
 }
 
@@ -389,31 +376,39 @@ func (c *Client) BlockByHash(ctx context.Context, hash tmbytes.HexBytes) (*coret
 		return nil, err
 	}
 
+	if err := c.verifyBlock(ctx, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// verifyBlock verifies the block of res against the trusted header at its
+// height.
+func (c *Client) verifyBlock(ctx context.Context, res *coretypes.ResultBlock) error {
 	// Validate res.
 	if err := res.BlockID.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 	if err := res.Block.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 	if bmH, bH := res.BlockID.Hash, res.Block.Hash(); !bytes.Equal(bmH, bH) {
-		return nil, fmt.Errorf("blockID %X does not match with block %X",
+		return fmt.Errorf("blockID %X does not match with block %X",
 			bmH, bH)
 	}
 
 	// Update the light client if we're behind.
 	l, err := c.updateLightClientIfNeededTo(ctx, &res.Block.Height)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Verify block.
 	if bH, tH := res.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
-		return nil, fmt.Errorf("block header %X does not match with trusted header %X",
+		return fmt.Errorf("block header %X does not match with trusted header %X",
 			bH, tH)
 	}
-
-	return res, nil
+	return nil
 }
 
 // BlockResults returns the block results for the given height. If no height is
@@ -436,41 +431,41 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*coretypes.Re
 	if err != nil {
 		return nil, err
 	}
+	if err := c.verifyBlockResults(ctx, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
+// verifyBlockResults verifies the block results of res against the results
+// hash of the trusted header that follows their height, which commits to the
+// results of the transactions only.
+func (c *Client) verifyBlockResults(ctx context.Context, res *coretypes.ResultBlockResults) error {
 	// Validate res.
 	if res.Height <= 0 {
-		return nil, coretypes.ErrZeroOrNegativeHeight
+		return coretypes.ErrZeroOrNegativeHeight
 	}
 
 	// Update the light client if we're behind.
-	nextHeight := h + 1
+	nextHeight := res.Height + 1
 	trustedBlock, err := c.updateLightClientIfNeededTo(ctx, &nextHeight)
 	if err != nil {
-		return nil, err
-	}
-
-	// proto-encode FinalizeBlock events
-	bbeBytes, err := proto.Marshal(&abci.ResponseFinalizeBlock{
-		Events: res.FinalizeBlockEvents,
-	})
-	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Build a Merkle tree out of the slice.
+	// Build a Merkle tree out of the tx results, as the block executor does.
 	rs, err := abci.MarshalTxResults(res.TxsResults)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	mh := merkle.HashFromByteSlices(append([][]byte{bbeBytes}, rs...))
+	mh := merkle.HashFromByteSlices(rs)
 
 	// Verify block results.
 	if !bytes.Equal(mh, trustedBlock.LastResultsHash) {
-		return nil, fmt.Errorf("last results %X does not match with trusted last results %X",
+		return fmt.Errorf("last results %X does not match with trusted last results %X",
 			mh, trustedBlock.LastResultsHash)
 	}
-
-	return res, nil
+	return nil
 }
 
 // Header fetches and verifies the header directly via the light client
@@ -528,22 +523,43 @@ func (c *Client) Tx(ctx context.Context, hash tmbytes.HexBytes, prove bool) (*co
 	if err != nil || !prove {
 		return res, err
 	}
+	return res, c.verifyTx(ctx, res)
+}
 
+// verifyTx verifies the inclusion proof of the transaction of res against the
+// data hash of the trusted header at its height.
+func (c *Client) verifyTx(ctx context.Context, res *coretypes.ResultTx) error {
 	// Validate res.
 	if res.Height <= 0 {
-		return nil, coretypes.ErrZeroOrNegativeHeight
+		return coretypes.ErrZeroOrNegativeHeight
+	}
+	if len(res.Proof.RootHash) == 0 || res.Proof.Proof.Total == 0 {
+		return errors.New("missing tx proof")
+	}
+	if !bytes.Equal(res.Proof.Data, res.Tx) {
+		return errors.New("proof is for a different tx")
+	}
+	if tH := res.Tx.Hash(); !bytes.Equal(res.Hash, tH) {
+		return fmt.Errorf("tx hash %X does not match with tx %X", res.Hash, tH)
+	}
+	if res.Proof.Proof.Index != int64(res.Index) {
+		return fmt.Errorf("proof index %d does not match with tx index %d",
+			res.Proof.Proof.Index, res.Index)
 	}
 
 	// Update the light client if we're behind.
 	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Validate the proof.
-	return res, res.Proof.Validate(l.DataHash)
+	return res.Proof.Validate(l.DataHash)
 }
 
+// TxSearch calls rpcclient#TxSearch with proofs and then verifies the
+// inclusion of each returned transaction. The proofs are stripped from the
+// results unless requested.
 func (c *Client) TxSearch(
 	ctx context.Context,
 	query string,
@@ -551,16 +567,67 @@ func (c *Client) TxSearch(
 	page, perPage *int,
 	orderBy string,
 ) (*coretypes.ResultTxSearch, error) {
-	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	res, err := c.next.TxSearch(ctx, query, true, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range res.Txs {
+		if err := c.verifyTx(ctx, tx); err != nil {
+			return nil, fmt.Errorf("verifying tx %X: %w", tx.Hash, err)
+		}
+		if !prove {
+			tx.Proof = types.TxProof{}
+		}
+	}
+	return res, nil
 }
 
+// BlockSearch calls rpcclient#BlockSearch and then verifies the header of
+// each returned block, along with the block results at its height. Since the
+// results of a block are committed by the next header, the search waits for
+// the next block to be committed if it returns the latest block.
 func (c *Client) BlockSearch(
 	ctx context.Context,
 	query string,
 	page, perPage *int,
 	orderBy string,
 ) (*coretypes.ResultBlockSearch, error) {
-	return c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	res, err := c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxHeight int64
+	for _, block := range res.Blocks {
+		if block.Block != nil && block.Block.Height > maxHeight {
+			maxHeight = block.Block.Height
+		}
+	}
+	if len(res.Blocks) > 0 {
+		if err := c.waitForHeight(ctx, maxHeight+1); err != nil {
+			return nil, fmt.Errorf("waiting for the results of block %d: %w", maxHeight, err)
+		}
+	}
+
+	for _, block := range res.Blocks {
+		if err := c.verifyBlock(ctx, block); err != nil {
+			return nil, fmt.Errorf("verifying block %X: %w", block.BlockID.Hash, err)
+		}
+
+		height := block.Block.Height
+		results, err := c.next.BlockResults(ctx, &height)
+		if err != nil {
+			return nil, fmt.Errorf("fetching results of block %d: %w", height, err)
+		}
+		if results.Height != height {
+			return nil, fmt.Errorf("results of block %d are for height %d", height, results.Height)
+		}
+		if err := c.verifyBlockResults(ctx, results); err != nil {
+			return nil, fmt.Errorf("verifying results of block %d: %w", height, err)
+		}
+	}
+	return res, nil
 }
 
 // Validators fetches and verifies validators.
@@ -633,8 +700,10 @@ func (c *Client) RegisterOpDecoder(typ string, dec merkle.OpDecoder) {
 }
 
 // SubscribeWS subscribes for events using the given query and remote address as
-// a subscriber, but does not verify responses (UNSAFE)!
-// TODO: verify data
+// a subscriber. NewBlock, NewBlockHeader and Tx events are verified before
+// they are delivered, and dropped if verification fails. Tx events are
+// delivered once the next block commits their results. Other events are not
+// verified (UNSAFE)!
 func (c *Client) SubscribeWS(ctx context.Context, query string) (*coretypes.ResultSubscribe, error) {
 	bctx, bcancel := context.WithCancel(context.Background())
 	c.closers = append(c.closers, bcancel)
@@ -645,18 +714,9 @@ func (c *Client) SubscribeWS(ctx context.Context, query string) (*coretypes.Resu
 		return nil, err
 	}
 
-	go func() {
-		for {
-			select {
-			case resultEvent := <-out:
-				// We should have a switch here that performs a validation
-				// depending on the event's type.
-				callInfo.WSConn.TryWriteRPCResponse(bctx, callInfo.RPCRequest.MakeResponse(resultEvent))
-			case <-bctx.Done():
-				return
-			}
-		}
-	}()
+	go c.verifyEvents(bctx, out, func(resultEvent coretypes.ResultEvent) {
+		callInfo.WSConn.TryWriteRPCResponse(bctx, callInfo.RPCRequest.MakeResponse(resultEvent))
+	})
 
 	return &coretypes.ResultSubscribe{}, nil
 }
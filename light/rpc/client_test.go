@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/crypto"
+	"github.com/ari-anchor/sei-tendermint/crypto/merkle"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	lcmock "github.com/ari-anchor/sei-tendermint/light/rpc/mocks"
+	rpcmock "github.com/ari-anchor/sei-tendermint/rpc/client/mocks"
+	"github.com/ari-anchor/sei-tendermint/rpc/coretypes"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// makeTestBlock returns a block at the given height with the given txs, along
+// with the light block that trusts it.
+func makeTestBlock(height int64, txs types.Txs, lastResultsHash []byte) (*types.Block, *types.LightBlock) {
+	block := types.MakeBlock(height, txs, &types.Commit{}, nil)
+	block.ChainID = "test-chain"
+	block.ProposerAddress = crypto.AddressHash([]byte("proposer"))
+	block.ValidatorsHash = crypto.Checksum([]byte("validators"))
+	block.LastResultsHash = lastResultsHash
+	return block, &types.LightBlock{SignedHeader: &types.SignedHeader{Header: &block.Header}}
+}
+
+func TestTxSearch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	txs := types.Txs{types.Tx("a"), types.Tx("b")}
+	_, lb := makeTestBlock(5, txs, nil)
+
+	newResult := func() *coretypes.ResultTxSearch {
+		res := &coretypes.ResultTxSearch{TotalCount: len(txs)}
+		for i, tx := range txs {
+			res.Txs = append(res.Txs, &coretypes.ResultTx{
+				Hash:   tx.Hash(),
+				Height: 5,
+				Index:  uint32(i),
+				Tx:     tx,
+				Proof:  txs.Proof(i),
+			})
+		}
+		return res
+	}
+
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).Return(lb, nil)
+	c := NewClient(log.NewNopLogger(), next, lc)
+
+	// Proofs are requested upstream, and stripped unless requested.
+	next.On("TxSearch", mock.Anything, "tx.height=5", true, (*int)(nil), (*int)(nil), "").
+		Return(newResult(), nil).Twice()
+	res, err := c.TxSearch(ctx, "tx.height=5", true, nil, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, newResult(), res)
+
+	res, err = c.TxSearch(ctx, "tx.height=5", false, nil, nil, "")
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	for _, tx := range res.Txs {
+		assert.Empty(t, tx.Proof)
+	}
+
+	// A tx that was not included in the trusted block fails the search.
+	forged := newResult()
+	forged.Txs[1].Tx = types.Tx("c")
+	forged.Txs[1].Hash = forged.Txs[1].Tx.Hash()
+	next.On("TxSearch", mock.Anything, "tx.height=5", true, (*int)(nil), (*int)(nil), "").
+		Return(forged, nil).Once()
+	_, err = c.TxSearch(ctx, "tx.height=5", false, nil, nil, "")
+	assert.Error(t, err)
+
+	// So does a tx without a proof.
+	unproven := newResult()
+	unproven.Txs[0].Proof = types.TxProof{}
+	next.On("TxSearch", mock.Anything, "tx.height=5", true, (*int)(nil), (*int)(nil), "").
+		Return(unproven, nil).Once()
+	_, err = c.TxSearch(ctx, "tx.height=5", false, nil, nil, "")
+	assert.Error(t, err)
+
+	next.AssertExpectations(t)
+}
+
+func TestBlockSearch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	results := &coretypes.ResultBlockResults{
+		Height:              5,
+		TxsResults:          []*abci.ExecTxResult{{Code: abci.CodeTypeOK, Data: []byte{1}}},
+		FinalizeBlockEvents: []abci.Event{{Type: "block"}},
+	}
+	// The results hash commits to the tx results only, as in the block
+	// executor.
+	rs, err := abci.MarshalTxResults(results.TxsResults)
+	require.NoError(t, err)
+	resultsHash := merkle.HashFromByteSlices(rs)
+
+	block, lb := makeTestBlock(5, types.Txs{types.Tx("a")}, nil)
+	_, nextLB := makeTestBlock(6, nil, resultsHash)
+	blockID := types.BlockID{Hash: block.Hash()}
+
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).Return(lb, nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).Return(nextLB, nil)
+	c := NewClient(log.NewNopLogger(), next, lc)
+
+	// The block is the latest at first, so the search waits for the next
+	// block, which commits its results.
+	status := func(height int64) *coretypes.ResultStatus {
+		return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: height}}
+	}
+	next.On("Status", mock.Anything).Return(status(5), nil).Once()
+	next.On("Status", mock.Anything).Return(status(6), nil)
+
+	want := &coretypes.ResultBlockSearch{
+		Blocks:     []*coretypes.ResultBlock{{BlockID: blockID, Block: block}},
+		TotalCount: 1,
+	}
+	next.On("BlockSearch", mock.Anything, "block.height=5", (*int)(nil), (*int)(nil), "").
+		Return(want, nil)
+	next.On("BlockResults", mock.Anything, mock.MatchedBy(func(h *int64) bool { return *h == 5 })).
+		Return(results, nil).Once()
+	res, err := c.BlockSearch(ctx, "block.height=5", nil, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, want, res)
+
+	// Results that do not match the trusted results hash fail the search.
+	forged := *results
+	forged.TxsResults = []*abci.ExecTxResult{{Code: abci.CodeTypeOK, Data: []byte{2}}}
+	next.On("BlockResults", mock.Anything, mock.Anything).Return(&forged, nil).Once()
+	_, err = c.BlockSearch(ctx, "block.height=5", nil, nil, "")
+	assert.Error(t, err)
+
+	// So does a block that does not match the trusted header.
+	_, otherLB := makeTestBlock(5, types.Txs{types.Tx("b")}, nil)
+	lc = &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).Return(otherLB, nil)
+	c = NewClient(log.NewNopLogger(), next, lc)
+	_, err = c.BlockSearch(ctx, "block.height=5", nil, nil, "")
+	assert.Error(t, err)
+
+	next.AssertExpectations(t)
+}
@@ -0,0 +1,218 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/rpc/coretypes"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// nextBlockPollInterval is how often the primary is polled while waiting for
+// the block that commits the results of a transaction event.
+const nextBlockPollInterval = 100 * time.Millisecond
+
+// maxPendingEvents is the number of events of a subscription that may be
+// verified concurrently, so that an event waiting for the next block does not
+// hold up the events that follow it.
+const maxPendingEvents = 100
+
+// pendingEvent is an event of a subscription under verification.
+type pendingEvent struct {
+	event    coretypes.ResultEvent
+	verified chan error
+}
+
+// verifyEvents verifies the events received from in concurrently, and
+// delivers those that pass verification in the order they were received,
+// until ctx is done or in is closed.
+func (c *Client) verifyEvents(ctx context.Context, in <-chan coretypes.ResultEvent, deliver func(coretypes.ResultEvent)) {
+	pending := make(chan pendingEvent, maxPendingEvents)
+	go func() {
+		defer close(pending)
+		for {
+			select {
+			case event, ok := <-in:
+				if !ok {
+					return
+				}
+				p := pendingEvent{event: event, verified: make(chan error, 1)}
+				select {
+				case pending <- p:
+				case <-ctx.Done():
+					return
+				}
+				go func() { p.verified <- c.verifyEvent(ctx, p.event) }()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for p := range pending {
+		var err error
+		select {
+		case err = <-p.verified:
+		case <-ctx.Done():
+			return
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Error("dropping event that failed verification",
+				"query", p.event.Query, "err", err)
+			continue
+		}
+		deliver(p.event)
+	}
+}
+
+// verifyEvent verifies the data of a NewBlock, NewBlockHeader or Tx event
+// against the light client. The data of other events cannot be verified and
+// are accepted as is.
+func (c *Client) verifyEvent(ctx context.Context, event coretypes.ResultEvent) error {
+	switch data := event.Data.(type) {
+	case *types.LegacyEventDataNewBlock:
+		return c.verifyEventBlock(ctx, data.Block)
+	case types.LegacyEventDataNewBlock:
+		return c.verifyEventBlock(ctx, data.Block)
+	case *types.EventDataNewBlockHeader:
+		return c.verifyHeader(ctx, data.Header)
+	case types.EventDataNewBlockHeader:
+		return c.verifyHeader(ctx, data.Header)
+	case *types.LegacyEventDataTx:
+		return c.verifyEventTx(ctx, data.TxResult)
+	case types.LegacyEventDataTx:
+		return c.verifyEventTx(ctx, data.TxResult)
+	}
+	return nil
+}
+
+// verifyHeader verifies the header against the trusted header at its height.
+func (c *Client) verifyHeader(ctx context.Context, h types.Header) error {
+	if err := h.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &h.Height)
+	if err != nil {
+		return err
+	}
+
+	if hH, tH := h.Hash(), l.Hash(); !bytes.Equal(hH, tH) {
+		return fmt.Errorf("header %X does not match with trusted header %X", hH, tH)
+	}
+	return nil
+}
+
+// verifyEventBlock verifies the block of a NewBlock event against the trusted
+// header at its height.
+func (c *Client) verifyEventBlock(ctx context.Context, lb *types.LegacyBlock) error {
+	if lb == nil {
+		return errors.New("nil block")
+	}
+	block := &types.Block{
+		Header:     lb.Header,
+		Data:       lb.Data,
+		Evidence:   lb.Evidence.Evidence,
+		LastCommit: lb.LastCommit,
+	}
+	if err := block.ValidateBasic(); err != nil {
+		return err
+	}
+	return c.verifyHeader(ctx, block.Header)
+}
+
+// verifyEventTx verifies the inclusion of the transaction of a Tx event in the
+// trusted block at its height, and its result against the results hash of the
+// next trusted header, which is computed over the tx results of the block. It
+// waits for the next block to be committed.
+func (c *Client) verifyEventTx(ctx context.Context, txr types.LegacyTxResult) error {
+	height, err := strconv.ParseInt(txr.Height, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid height %q: %w", txr.Height, err)
+	}
+	tx := types.Tx(txr.Tx)
+
+	// By the time the next block is committed, the transaction is indexed as
+	// well.
+	if err := c.waitForHeight(ctx, height+1); err != nil {
+		return err
+	}
+
+	res, err := c.next.Tx(ctx, tx.Hash(), true)
+	if err != nil {
+		return fmt.Errorf("fetching tx proof: %w", err)
+	}
+	if res.Height != height || res.Index != txr.Index {
+		return fmt.Errorf("tx was included at %d/%d, not %d/%d", res.Height, res.Index, height, txr.Index)
+	}
+	if err := c.verifyTx(ctx, res); err != nil {
+		return err
+	}
+
+	results, err := c.next.BlockResults(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("fetching block results: %w", err)
+	}
+	if results.Height != height {
+		return fmt.Errorf("block results are for height %d, not %d", results.Height, height)
+	}
+	if err := c.verifyBlockResults(ctx, results); err != nil {
+		return err
+	}
+	if int(txr.Index) >= len(results.TxsResults) {
+		return fmt.Errorf("tx index %d is out of range of %d results", txr.Index, len(results.TxsResults))
+	}
+
+	// The legacy encoding of the event omits some fields of the result, so
+	// the result is compared as the subscriber receives it.
+	trusted := types.EventDataTx{TxResult: abci.TxResult{
+		Height: height,
+		Index:  txr.Index,
+		Tx:     tx,
+		Result: *results.TxsResults[txr.Index],
+	}}.ToLegacy().(types.LegacyEventDataTx)
+	want, err := json.Marshal(trusted.TxResult)
+	if err != nil {
+		return err
+	}
+	got, err := json.Marshal(txr)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(want, got) {
+		return errors.New("tx result does not match with trusted result")
+	}
+	return nil
+}
+
+// waitForHeight waits until the primary reports a block at the given height.
+func (c *Client) waitForHeight(ctx context.Context, height int64) error {
+	ticker := time.NewTicker(nextBlockPollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := c.next.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("can't get latest height: %w", err)
+		}
+		if status.SyncInfo.LatestBlockHeight >= height {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
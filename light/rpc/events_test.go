@@ -0,0 +1,104 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/crypto/merkle"
+	"github.com/ari-anchor/sei-tendermint/libs/log"
+	lcmock "github.com/ari-anchor/sei-tendermint/light/rpc/mocks"
+	rpcmock "github.com/ari-anchor/sei-tendermint/rpc/client/mocks"
+	"github.com/ari-anchor/sei-tendermint/rpc/coretypes"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+func TestVerifyEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	txs := types.Txs{types.Tx("a"), types.Tx("b")}
+	txResults := []*abci.ExecTxResult{
+		{Code: abci.CodeTypeOK, Log: "a", GasUsed: 1},
+		{Code: abci.CodeTypeOK, Log: "b", GasUsed: 2},
+	}
+	// The results hash commits to the tx results only, as in the block
+	// executor.
+	rs, err := abci.MarshalTxResults(txResults)
+	require.NoError(t, err)
+	resultsHash := merkle.HashFromByteSlices(rs)
+
+	block, lb := makeTestBlock(5, txs, nil)
+	_, nextLB := makeTestBlock(6, nil, resultsHash)
+
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).Return(lb, nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).Return(nextLB, nil)
+	next.On("Status", mock.Anything).Return(&coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 6},
+	}, nil)
+	next.On("Tx", mock.Anything, mock.Anything, true).Return(&coretypes.ResultTx{
+		Hash:     txs[1].Hash(),
+		Height:   5,
+		Index:    1,
+		TxResult: *txResults[1],
+		Tx:       txs[1],
+		Proof:    txs.Proof(1),
+	}, nil)
+	next.On("BlockResults", mock.Anything, mock.Anything).Return(&coretypes.ResultBlockResults{
+		Height:     5,
+		TxsResults: txResults,
+	}, nil)
+	c := NewClient(log.NewNopLogger(), next, lc)
+
+	txEvent := func(result *abci.ExecTxResult) types.LegacyEventData {
+		return types.EventDataTx{TxResult: abci.TxResult{
+			Height: 5,
+			Index:  1,
+			Tx:     txs[1],
+			Result: *result,
+		}}.ToLegacy()
+	}
+	forgedHeader := block.Header
+	forgedHeader.AppHash = []byte("forged")
+
+	testCases := []struct {
+		name  string
+		data  types.LegacyEventData
+		valid bool
+	}{
+		{"NewBlock", types.EventDataNewBlock{Block: block}.ToLegacy(), true},
+		{"NewBlockHeader", types.EventDataNewBlockHeader{Header: block.Header}, true},
+		{"ForgedNewBlockHeader", types.EventDataNewBlockHeader{Header: forgedHeader}, false},
+		{"Tx", txEvent(txResults[1]), true},
+		{"ForgedTx", txEvent(&abci.ExecTxResult{Code: abci.CodeTypeOK, Log: "c", GasUsed: 2}), false},
+		{"Vote", types.EventDataVote{}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := c.verifyEvent(ctx, coretypes.ResultEvent{Data: tc.data})
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	// Events are delivered in order, without those that fail verification.
+	in := make(chan coretypes.ResultEvent, len(testCases))
+	for _, tc := range testCases {
+		in <- coretypes.ResultEvent{Query: tc.name, Data: tc.data}
+	}
+	close(in)
+	var delivered []string
+	c.verifyEvents(ctx, in, func(event coretypes.ResultEvent) {
+		delivered = append(delivered, event.Query)
+	})
+	assert.Equal(t, []string{"NewBlock", "NewBlockHeader", "Tx", "Vote"}, delivered)
+}
@@ -71,6 +71,7 @@ type nodeImpl struct {
 	stateStore     sm.Store
 	blockStore     *store.BlockStore // store the blockchain to disk
 	evPool         *evidence.Pool
+	mempool        *mempool.TxMempool
 	indexerService *indexer.Service
 	services       []service.Service
 	rpcListeners   []net.Listener // rpc servers
@@ -111,7 +112,7 @@ func newDefaultNode(
 			DefaultMetricsProvider(cfg.Instrumentation)(cfg.ChainID()),
 		)
 	}
-	pval, err := makeDefaultPrivval(cfg)
+	pval, err := makeDefaultPrivval(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -178,12 +179,24 @@ func makeNode(
 
 	var eventLog *eventlog.Log
 	if w := cfg.RPC.EventLogWindowSize; w > 0 {
-		var err error
-		eventLog, err = eventlog.New(eventlog.LogSettings{
+		settings := eventlog.LogSettings{
 			WindowSize: w,
 			MaxItems:   cfg.RPC.EventLogMaxItems,
 			Metrics:    nodeMetrics.eventlog,
-		})
+		}
+		if cfg.RPC.EventLogPersist {
+			eventLogDB, err := dbProvider(&config.DBContext{ID: "eventlog", Config: cfg})
+			if err != nil {
+				return nil, combineCloseError(fmt.Errorf("unable to initialize event log db: %w", err), makeCloser(closers))
+			}
+			closers = append(closers, eventLogDB.Close)
+			settings.DB = eventLogDB
+			settings.Retention = cfg.RPC.EventLogRetention
+			settings.MaxBytes = cfg.RPC.EventLogMaxBytes
+		}
+
+		var err error
+		eventLog, err = eventlog.New(settings)
 		if err != nil {
 			return nil, combineCloseError(fmt.Errorf("initializing event log: %w", err), makeCloser(closers))
 		}
@@ -288,13 +301,18 @@ func makeNode(
 	}
 	shoulddbsync := cfg.DBSync.Enable && info.LastBlockHeight == 0
 
-	mpReactor, mp := createMempoolReactor(logger, cfg, proxyApp, stateStore, nodeMetrics.mempool,
-		peerManager.Subscribe, peerManager)
+	mpReactor, mp, mdbCloser, err := createMempoolReactor(logger, cfg, dbProvider, proxyApp, stateStore,
+		nodeMetrics.mempool, peerManager.Subscribe, peerManager)
+	closers = append(closers, mdbCloser)
+	if err != nil {
+		return nil, combineCloseError(err, makeCloser(closers))
+	}
 	node.router.AddChDescToBeAdded(mempool.GetChannelDescriptor(cfg.Mempool), mpReactor.SetChannel)
 	if !shoulddbsync {
 		mpReactor.MarkReadyToStart()
 	}
 	node.rpcEnv.Mempool = mp
+	node.mempool = mp
 	node.services = append(node.services, mpReactor)
 
 	// make block executor for consensus and blockchain reactors to execute blocks
@@ -355,6 +373,7 @@ func makeNode(
 	node.router.AddChDescToBeAdded(consensus.GetDataChannelDescriptor(), csReactor.SetDataChannel)
 	node.router.AddChDescToBeAdded(consensus.GetVoteChannelDescriptor(), csReactor.SetVoteChannel)
 	node.router.AddChDescToBeAdded(consensus.GetVoteSetChannelDescriptor(), csReactor.SetVoteSetChannel)
+	node.router.AddChDescToBeAdded(consensus.GetTxChannelDescriptor(), csReactor.SetTxChannel)
 	node.services = append(node.services, csReactor)
 	node.rpcEnv.ConsensusReactor = csReactor
 
@@ -442,10 +461,19 @@ func makeNode(
 	node.router.AddChDescToBeAdded(statesync.GetLightBlockChannelDescriptor(), ssReactor.SetLightBlockChannel)
 	node.router.AddChDescToBeAdded(statesync.GetParamsChannelDescriptor(), ssReactor.SetParamsChannel)
 
+	var snapshotHook dbsync.SnapshotHook
+	if coordinator, ok := client.(dbsync.SnapshotCoordinator); ok {
+		snapshotHook = coordinator.CoordinateSnapshot
+	} else if cfg.DBSync.SnapshotInterval > 0 && cfg.DBSync.SnapshotDirectory != "" {
+		return nil, combineCloseError(
+			errors.New("db-sync.snapshot-interval requires an in-process application that coordinates snapshots"),
+			makeCloser(closers))
+	}
 	dbsyncReactor := dbsync.NewReactor(
 		logger.With("module", "dbsync"),
 		*cfg.DBSync,
 		cfg.BaseConfig,
+		client,
 		peerManager.Subscribe,
 		stateStore,
 		blockStore,
@@ -454,12 +482,10 @@ func makeNode(
 		eventBus,
 		shoulddbsync,
 		func(ctx context.Context, state sm.State) error {
-			if _, err := client.LoadLatest(ctx, &abci.RequestLoadLatest{}); err != nil {
-				return err
-			}
 			mpReactor.MarkReadyToStart()
 			return postSyncHook(ctx, state)
 		},
+		snapshotHook,
 	)
 	node.services = append(node.services, dbsyncReactor)
 	node.router.AddChDescToBeAdded(dbsync.GetMetadataChannelDescriptor(), dbsyncReactor.SetMetadataChannel)
@@ -520,6 +546,14 @@ func (n *nodeImpl) OnStart(ctx context.Context) error {
 
 	logNodeStartupInfo(state, n.rpcEnv.PubKey, n.logger, n.config.Mode)
 
+	// State and db sync start from an application without state, which cannot
+	// validate the journaled transactions.
+	if n.shouldHandshake {
+		if err := n.mempool.RestoreJournal(ctx, state.LastBlockHeight); err != nil {
+			n.logger.Error("failed to restore mempool journal", "err", err)
+		}
+	}
+
 	// TODO: Fetch and provide real options and do proper p2p bootstrapping.
 	// TODO: Use a persistent peer database.
 	n.nodeInfo, err = makeNodeInfo(n.config, n.nodeKey, n.eventSinks, n.genesisDoc, state.Version.Consensus)
@@ -217,6 +217,39 @@ func TestPrivValidatorListenAddrNoProtocol(t *testing.T) {
 	}
 }
 
+func TestNodeSnapshotIntervalRequiresCoordinator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg, err := config.ResetTestRoot(t.TempDir(), "node_snapshot_interval_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(cfg.RootDir)
+	// the kvstore application does not coordinate snapshots
+	cfg.DBSync.SnapshotInterval = 10
+	cfg.DBSync.SnapshotDirectory = t.TempDir()
+
+	n, err := newDefaultNode(ctx, cfg, log.NewNopLogger(), make(chan struct{}))
+	assert.ErrorContains(t, err, "snapshot-interval")
+
+	if n != nil && n.IsRunning() {
+		cancel()
+		n.Wait()
+	}
+}
+
+func TestMakeLastSignStateStore(t *testing.T) {
+	cfg, err := config.ResetTestRoot(t.TempDir(), "node_last_sign_state_store_test")
+	require.NoError(t, err)
+
+	_, ok := makeLastSignStateStore(cfg).(*privval.FileLastSignStateStore)
+	assert.True(t, ok)
+
+	cfg.PrivValidator.StateKVAddr = "http://127.0.0.1:8500"
+	cfg.PrivValidator.StateKVKey = "validator/state"
+	_, ok = makeLastSignStateStore(cfg).(*privval.KVLastSignStateStore)
+	assert.True(t, ok)
+}
+
 func TestNodeSetPrivValIPC(t *testing.T) {
 	tmpfile := "/tmp/kms." + tmrand.Str(6) + ".sock"
 	defer os.Remove(tmpfile) // clean up
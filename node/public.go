@@ -58,7 +58,7 @@ func New(
 
 	switch conf.Mode {
 	case config.ModeFull, config.ModeValidator:
-		pval, err := privval.LoadOrGenFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile())
+		pval, err := loadOrGenFilePV(ctx, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -92,3 +92,27 @@ func New(
 		return nil, fmt.Errorf("%q is not a valid mode", conf.Mode)
 	}
 }
+
+// NewPrivValidator constructs the private validator configured for the node,
+// outside of a running node: the FilePV, which must exist, or the client of
+// the remote signers, connected to them. It is meant for maintenance commands,
+// while the node is stopped.
+func NewPrivValidator(ctx context.Context, conf *config.Config, logger log.Logger) (types.PrivValidator, error) {
+	genDoc, err := defaultGenesisDocProviderFunc(conf)()
+	if err != nil {
+		return nil, err
+	}
+
+	var filePV *privval.FilePV
+	if len(conf.PrivValidator.ThresholdListenAddrs) == 0 && conf.PrivValidator.ListenAddr == "" {
+		if conf.PrivValidator.StateKVAddr == "" {
+			filePV, err = privval.LoadFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile())
+		} else {
+			filePV, err = privval.LoadFilePVWithStore(ctx, conf.PrivValidator.KeyFile(), makeLastSignStateStore(conf))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return createPrivval(ctx, logger, conf, genDoc, filePV)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -141,22 +142,45 @@ func onlyValidatorIsUs(state sm.State, pubKey crypto.PubKey) bool {
 func createMempoolReactor(
 	logger log.Logger,
 	cfg *config.Config,
+	dbProvider config.DBProvider,
 	appClient abciclient.Client,
 	store sm.Store,
 	memplMetrics *mempool.Metrics,
 	peerEvents p2p.PeerEventSubscriber,
 	peerManager *p2p.PeerManager,
-) (*mempool.Reactor, mempool.Mempool) {
+) (*mempool.Reactor, *mempool.TxMempool, closer, error) {
 	logger = logger.With("module", "mempool")
 
-	mp := mempool.NewTxMempool(
+	options := []mempool.TxMempoolOption{
+		mempool.WithMetrics(memplMetrics),
+		mempool.WithPreCheck(sm.TxPreCheckFromStore(store)),
+		mempool.WithPostCheck(sm.TxPostCheckFromStore(store)),
+	}
+	var mp *mempool.TxMempool
+	dbCloser := func() error { return nil }
+	if cfg.Mempool.Journal {
+		mempoolDB, err := dbProvider(&config.DBContext{ID: "mempool", Config: cfg})
+		if err != nil {
+			return nil, nil, dbCloser, fmt.Errorf("unable to initialize mempool db: %w", err)
+		}
+		options = append(options, mempool.WithJournal(mempoolDB))
+		dbCloser = func() error {
+			// The journal is written once per block, so the changes since the
+			// last block are written before closing.
+			flushErr := mp.FlushJournal()
+			if err := mempoolDB.Close(); err != nil {
+				return err
+			}
+			return flushErr
+		}
+	}
+
+	mp = mempool.NewTxMempool(
 		logger,
 		cfg.Mempool,
 		appClient,
 		peerManager,
-		mempool.WithMetrics(memplMetrics),
-		mempool.WithPreCheck(sm.TxPreCheckFromStore(store)),
-		mempool.WithPostCheck(sm.TxPostCheckFromStore(store)),
+		options...,
 	)
 
 	reactor := mempool.NewReactor(
@@ -170,7 +194,7 @@ func createMempoolReactor(
 		mp.EnableTxsAvailable()
 	}
 
-	return reactor, mp
+	return reactor, mp, dbCloser, nil
 }
 
 func createEvidenceReactor(
@@ -465,9 +489,9 @@ func createAndStartPrivValidatorGRPCClient(
 	return pvsc, nil
 }
 
-func makeDefaultPrivval(conf *config.Config) (*privval.FilePV, error) {
+func makeDefaultPrivval(ctx context.Context, conf *config.Config) (*privval.FilePV, error) {
 	if conf.Mode == config.ModeValidator {
-		pval, err := privval.LoadOrGenFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile())
+		pval, err := loadOrGenFilePV(ctx, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -477,7 +501,72 @@ func makeDefaultPrivval(conf *config.Config) (*privval.FilePV, error) {
 	return nil, nil
 }
 
+// loadOrGenFilePV loads the FilePV of the node, or generates it if its key
+// file does not exist. If the last sign state is kept in a KV store, the key
+// is shared with other validators, so it is not generated.
+func loadOrGenFilePV(ctx context.Context, conf *config.Config) (*privval.FilePV, error) {
+	if conf.PrivValidator.StateKVAddr == "" {
+		return privval.LoadOrGenFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile())
+	}
+	return privval.LoadFilePVWithStore(ctx, conf.PrivValidator.KeyFile(), makeLastSignStateStore(conf))
+}
+
+// makeLastSignStateStore returns the store of the last sign state of the
+// node: the state file, unless a KV store is configured.
+func makeLastSignStateStore(conf *config.Config) privval.LastSignStateStore {
+	if conf.PrivValidator.StateKVAddr == "" {
+		return privval.NewFileLastSignStateStore(conf.PrivValidator.StateFile())
+	}
+	kv := privval.NewConsulKV(conf.PrivValidator.StateKVAddr, os.Getenv("CONSUL_HTTP_TOKEN"))
+	return privval.NewKVLastSignStateStore(kv, conf.PrivValidator.StateKVKey)
+}
+
+func createAndStartPrivValidatorThresholdSigner(
+	ctx context.Context,
+	cfg *config.Config,
+	chainID string,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	signers := make([]types.PrivValidator, 0, len(cfg.PrivValidator.ThresholdListenAddrs))
+	for _, listenAddr := range cfg.PrivValidator.ThresholdListenAddrs {
+		var (
+			signer types.PrivValidator
+			err    error
+		)
+		if protocol, _ := tmnet.ProtocolAndAddress(listenAddr); protocol == "grpc" {
+			pvCfg := *cfg.PrivValidator
+			pvCfg.ListenAddr = listenAddr
+			signer, err = createAndStartPrivValidatorGRPCClient(
+				ctx,
+				&config.Config{PrivValidator: &pvCfg, Instrumentation: cfg.Instrumentation},
+				chainID,
+				logger,
+			)
+		} else {
+			signer, err = createAndStartPrivValidatorSocketClient(ctx, listenAddr, chainID, logger)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("signer at %s: %w", listenAddr, err)
+		}
+		signers = append(signers, signer)
+	}
+
+	return privval.NewThresholdSigner(
+		ctx,
+		signers,
+		cfg.PrivValidator.Threshold,
+		makeLastSignStateStore(cfg),
+	)
+}
+
 func createPrivval(ctx context.Context, logger log.Logger, conf *config.Config, genDoc *types.GenesisDoc, defaultPV *privval.FilePV) (types.PrivValidator, error) {
+	if len(conf.PrivValidator.ThresholdListenAddrs) > 0 {
+		privValidator, err := createAndStartPrivValidatorThresholdSigner(ctx, conf, genDoc.ChainID, logger)
+		if err != nil {
+			return nil, fmt.Errorf("error with private validator threshold signer: %w", err)
+		}
+		return privValidator, nil
+	}
 	if conf.PrivValidator.ListenAddr != "" {
 		protocol, _ := tmnet.ProtocolAndAddress(conf.PrivValidator.ListenAddr)
 		// FIXME: we should return un-started services and
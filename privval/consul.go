@@ -0,0 +1,119 @@
+package privval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ConsulKV is a ConsensusKV backed by the KV store of a Consul cluster, whose
+// HTTP API offers linearizable reads and check-and-set writes.
+type ConsulKV struct {
+	addr   string
+	token  string
+	client *http.Client
+}
+
+var _ ConsensusKV = (*ConsulKV)(nil)
+
+// NewConsulKV returns a ConsensusKV backed by the Consul agent at the given
+// HTTP address, like http://127.0.0.1:8500, authenticated by the given ACL
+// token if it is not empty.
+func NewConsulKV(addr, token string) *ConsulKV {
+	return &ConsulKV{
+		addr:   strings.TrimSuffix(addr, "/"),
+		token:  token,
+		client: http.DefaultClient,
+	}
+}
+
+// Get implements ConsensusKV.
+func (kv *ConsulKV) Get(ctx context.Context, key string) ([]byte, error) {
+	value, _, err := kv.get(ctx, key)
+	return value, err
+}
+
+// CompareAndSwap implements ConsensusKV. Consul compares the index of the
+// last modification of the key, so the value read at that index is compared
+// with old first.
+func (kv *ConsulKV) CompareAndSwap(ctx context.Context, key string, old, value []byte) (bool, error) {
+	cur, index, err := kv.get(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if (cur != nil) != (old != nil) || !bytes.Equal(cur, old) {
+		return false, nil
+	}
+
+	// An index of 0 only sets a key that does not exist.
+	query := url.Values{"cas": {strconv.FormatUint(index, 10)}}
+	resp, err := kv.do(ctx, http.MethodPut, key, query, value)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("consul: setting %s: %s: %s", key, resp.Status, body)
+	}
+	return strconv.ParseBool(strings.TrimSpace(string(body)))
+}
+
+// get returns the value of key and the index of its last modification, or a
+// nil value and a zero index if the key is not set.
+func (kv *ConsulKV) get(ctx context.Context, key string) ([]byte, uint64, error) {
+	// Consistent reads are linearizable with the check-and-set writes.
+	resp, err := kv.do(ctx, http.MethodGet, key, url.Values{"consistent": {""}}, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, 0, nil
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return nil, 0, fmt.Errorf("consul: getting %s: %s: %s", key, resp.Status, body)
+	}
+
+	var entries []struct {
+		ModifyIndex uint64
+		Value       []byte
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, 0, fmt.Errorf("consul: decoding %s: %w", key, err)
+	}
+	if len(entries) != 1 {
+		return nil, 0, fmt.Errorf("consul: got %d entries for %s", len(entries), key)
+	}
+	// A key set to an empty value is still set.
+	value := entries[0].Value
+	if value == nil {
+		value = []byte{}
+	}
+	return value, entries[0].ModifyIndex, nil
+}
+
+func (kv *ConsulKV) do(ctx context.Context, method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := kv.addr + "/v1/kv/" + strings.TrimPrefix(key, "/") + "?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if kv.token != "" {
+		req.Header.Set("X-Consul-Token", kv.token)
+	}
+	return kv.client.Do(req)
+}
@@ -0,0 +1,98 @@
+package privval
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConsul serves the subset of the Consul KV HTTP API used by ConsulKV.
+type fakeConsul struct {
+	mtx     sync.Mutex
+	index   uint64
+	values  map[string][]byte
+	indexes map[string]uint64
+}
+
+func (c *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	switch r.Method {
+	case http.MethodGet:
+		value, ok := c.values[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"Key": key, "ModifyIndex": c.indexes[key], "Value": value},
+		})
+	case http.MethodPut:
+		cas, err := strconv.ParseUint(r.URL.Query().Get("cas"), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		value, _ := io.ReadAll(r.Body)
+		if cas != c.indexes[key] {
+			_, _ = io.WriteString(w, "false")
+			return
+		}
+		c.index++
+		c.values[key] = value
+		c.indexes[key] = c.index
+		_, _ = io.WriteString(w, "true")
+	}
+}
+
+func TestConsulKV(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consul := &fakeConsul{values: make(map[string][]byte), indexes: make(map[string]uint64)}
+	srv := httptest.NewServer(consul)
+	defer srv.Close()
+	kv := NewConsulKV(srv.URL, "")
+
+	value, err := kv.Get(ctx, "validator/state")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	// An unset key is only set from nil.
+	ok, err := kv.CompareAndSwap(ctx, "validator/state", []byte("a"), []byte("b"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = kv.CompareAndSwap(ctx, "validator/state", nil, []byte("a"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = kv.CompareAndSwap(ctx, "validator/state", nil, []byte("b"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = kv.CompareAndSwap(ctx, "validator/state", []byte("a"), []byte("b"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	value, err = kv.Get(ctx, "validator/state")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("b"), value)
+
+	// It backs a last sign state store.
+	store := NewKVLastSignStateStore(kv, "validator/lss")
+	zero, err := store.Load(ctx)
+	require.NoError(t, err)
+	next := FilePVLastSignState{Height: 10, Round: 1, Step: stepPrecommit}
+	require.NoError(t, store.CompareAndSwap(ctx, zero, next))
+	assert.ErrorIs(t, store.CompareAndSwap(ctx, zero, next), ErrLastSignStateChanged)
+}
@@ -25,5 +25,21 @@ SignerClient handles remote validator connections that provide signing services.
 In production, it's recommended to wrap it with RetrySignerClient to avoid
 termination in case of temporary errors.
 
+ThresholdSigner
+
+ThresholdSigner fans out signing requests to several signers holding the same
+key, like SignerClients connected to redundant KMS processes, and only accepts
+a signature once a majority threshold of them agree on it. It keeps its own
+high-water mark of the last height, round and step signed, so it never signs
+a regression or conflicting data even if the signers do.
+
+Sign state interchange
+
+The last sign state of the private validators implementing SignStateKeeper,
+including the SignerClients whose remote signer does, can be exported to and
+imported from a SignStateInterchange, to move the signing history of a
+validator between backends. An import never lowers the last height, round and
+step signed.
+
 */
 package privval
@@ -187,12 +187,19 @@ func (lss *FilePVLastSignState) Save() error {
 // NOTE: the directories containing pv.Key.filePath and pv.LastSignState.filePath must already exist.
 // It includes the LastSignature and LastSignBytes so we don't lose the signature
 // if the process crashes after signing but before the resulting consensus message is processed.
+// The last sign state is persisted to a FileLastSignStateStore, unless the
+// FilePV is loaded with another LastSignStateStore.
 type FilePV struct {
 	Key           FilePVKey
 	LastSignState FilePVLastSignState
+
+	stateStore LastSignStateStore
 }
 
-var _ types.PrivValidator = (*FilePV)(nil)
+var (
+	_ types.PrivValidator = (*FilePV)(nil)
+	_ SignStateKeeper     = (*FilePV)(nil)
+)
 
 // NewFilePV generates a new validator from the given key and paths.
 func NewFilePV(privKey crypto.PrivKey, keyFilePath, stateFilePath string) *FilePV {
@@ -207,6 +214,7 @@ func NewFilePV(privKey crypto.PrivKey, keyFilePath, stateFilePath string) *FileP
 			Step:     stepNone,
 			filePath: stateFilePath,
 		},
+		stateStore: NewFileLastSignStateStore(stateFilePath),
 	}
 }
 
@@ -236,22 +244,49 @@ func LoadFilePVEmptyState(keyFilePath, stateFilePath string) (*FilePV, error) {
 	return loadFilePV(keyFilePath, stateFilePath, false)
 }
 
-// If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
-func loadFilePV(keyFilePath, stateFilePath string, loadState bool) (*FilePV, error) {
-	keyJSONBytes, err := os.ReadFile(keyFilePath)
+// LoadFilePVWithStore loads a FilePV from the given keyFilePath, and its
+// LastSignState from the given store, which persists it from then on. If the
+// keyFilePath does not exist, the program will exit.
+func LoadFilePVWithStore(ctx context.Context, keyFilePath string, store LastSignStateStore) (*FilePV, error) {
+	pvKey, err := loadFilePVKey(keyFilePath)
 	if err != nil {
 		return nil, err
 	}
+	pvState, err := store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading PrivValidator state: %w", err)
+	}
+	return &FilePV{
+		Key:           pvKey,
+		LastSignState: pvState,
+		stateStore:    store,
+	}, nil
+}
+
+func loadFilePVKey(keyFilePath string) (FilePVKey, error) {
+	keyJSONBytes, err := os.ReadFile(keyFilePath)
+	if err != nil {
+		return FilePVKey{}, err
+	}
 	pvKey := FilePVKey{}
 	err = tmjson.Unmarshal(keyJSONBytes, &pvKey)
 	if err != nil {
-		return nil, fmt.Errorf("error reading PrivValidator key from %v: %w", keyFilePath, err)
+		return FilePVKey{}, fmt.Errorf("error reading PrivValidator key from %v: %w", keyFilePath, err)
 	}
 
 	// overwrite pubkey and address for convenience
 	pvKey.PubKey = pvKey.PrivKey.PubKey()
 	pvKey.Address = pvKey.PubKey.Address()
 	pvKey.filePath = keyFilePath
+	return pvKey, nil
+}
+
+// If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
+func loadFilePV(keyFilePath, stateFilePath string, loadState bool) (*FilePV, error) {
+	pvKey, err := loadFilePVKey(keyFilePath)
+	if err != nil {
+		return nil, err
+	}
 
 	pvState := FilePVLastSignState{}
 
@@ -271,6 +306,7 @@ func loadFilePV(keyFilePath, stateFilePath string, loadState bool) (*FilePV, err
 	return &FilePV{
 		Key:           pvKey,
 		LastSignState: pvState,
+		stateStore:    NewFileLastSignStateStore(stateFilePath),
 	}, nil
 }
 
@@ -311,7 +347,7 @@ func (pv *FilePV) GetPubKey(ctx context.Context) (crypto.PubKey, error) {
 // SignVote signs a canonical representation of the vote, along with the
 // chainID. Implements PrivValidator.
 func (pv *FilePV) SignVote(ctx context.Context, chainID string, vote *tmproto.Vote) error {
-	if err := pv.signVote(chainID, vote); err != nil {
+	if err := pv.signVote(ctx, chainID, vote); err != nil {
 		return fmt.Errorf("error signing vote: %w", err)
 	}
 	return nil
@@ -320,23 +356,30 @@ func (pv *FilePV) SignVote(ctx context.Context, chainID string, vote *tmproto.Vo
 // SignProposal signs a canonical representation of the proposal, along with
 // the chainID. Implements PrivValidator.
 func (pv *FilePV) SignProposal(ctx context.Context, chainID string, proposal *tmproto.Proposal) error {
-	if err := pv.signProposal(chainID, proposal); err != nil {
+	if err := pv.signProposal(ctx, chainID, proposal); err != nil {
 		return fmt.Errorf("error signing proposal: %w", err)
 	}
 	return nil
 }
 
-// Save persists the FilePV to disk.
+// Save persists the FilePV to disk. The LastSignState is only saved if it is
+// stored in a file, since other stores are only updated by signing.
 func (pv *FilePV) Save() error {
 	if err := pv.Key.Save(); err != nil {
 		return err
 	}
+	if _, ok := pv.stateStore.(*FileLastSignStateStore); !ok && pv.stateStore != nil {
+		return nil
+	}
 	return pv.LastSignState.Save()
 }
 
 // Reset resets all fields in the FilePV.
 // NOTE: Unsafe!
 func (pv *FilePV) Reset() error {
+	if _, ok := pv.stateStore.(*FileLastSignStateStore); !ok && pv.stateStore != nil {
+		return errors.New("cannot reset a last sign state that is not stored in a file")
+	}
 	pv.LastSignState.reset()
 	return pv.Save()
 }
@@ -357,7 +400,7 @@ func (pv *FilePV) String() string {
 // signVote checks if the vote is good to sign and sets the vote signature.
 // It may need to set the timestamp as well if the vote is otherwise the same as
 // a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
-func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
+func (pv *FilePV) signVote(ctx context.Context, chainID string, vote *tmproto.Vote) error {
 	step, err := voteToStep(vote)
 	if err != nil {
 		return err
@@ -422,7 +465,7 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 	if err != nil {
 		return err
 	}
-	if err := pv.saveSigned(height, round, step, signBytes, sig); err != nil {
+	if err := pv.saveSigned(ctx, height, round, step, signBytes, sig); err != nil {
 		return err
 	}
 	vote.Signature = sig
@@ -432,7 +475,7 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 }
 
 // signProposal checks if the proposal is good to sign and sets the proposal signature.
-func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error {
+func (pv *FilePV) signProposal(ctx context.Context, chainID string, proposal *tmproto.Proposal) error {
 	height, round, step := proposal.Height, proposal.Round, stepPropose
 
 	lss := pv.LastSignState
@@ -460,21 +503,57 @@ func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error
 	if err != nil {
 		return err
 	}
-	if err := pv.saveSigned(height, round, step, signBytes, sig); err != nil {
+	if err := pv.saveSigned(ctx, height, round, step, signBytes, sig); err != nil {
 		return err
 	}
 	proposal.Signature = sig
 	return nil
 }
 
-// Persist height/round/step and signature
-func (pv *FilePV) saveSigned(height int64, round int32, step int8, signBytes []byte, sig []byte) error {
-	pv.LastSignState.Height = height
-	pv.LastSignState.Round = round
-	pv.LastSignState.Step = step
-	pv.LastSignState.Signature = sig
-	pv.LastSignState.SignBytes = signBytes
-	return pv.LastSignState.Save()
+// Persist height/round/step and signature. If another signer sharing the
+// store advanced the last sign state, it is reloaded and an error is
+// returned, so the next attempt is checked against the new state.
+func (pv *FilePV) saveSigned(ctx context.Context, height int64, round int32, step int8, signBytes []byte, sig []byte) error {
+	next := pv.LastSignState
+	next.Height = height
+	next.Round = round
+	next.Step = step
+	next.Signature = sig
+	next.SignBytes = signBytes
+	return pv.swapLastSignState(ctx, next)
+}
+
+// swapLastSignState replaces the LastSignState with next in its store.
+func (pv *FilePV) swapLastSignState(ctx context.Context, next FilePVLastSignState) error {
+	store := pv.stateStore
+	if store == nil {
+		store = NewFileLastSignStateStore(pv.LastSignState.filePath)
+	}
+	if err := store.CompareAndSwap(ctx, pv.LastSignState, next); err != nil {
+		if errors.Is(err, ErrLastSignStateChanged) {
+			if lss, lerr := store.Load(ctx); lerr == nil {
+				pv.LastSignState = lss
+			}
+		}
+		return err
+	}
+	pv.LastSignState = next
+	return nil
+}
+
+// ExportSignState returns the LastSignState. Implements SignStateKeeper.
+func (pv *FilePV) ExportSignState(ctx context.Context) (FilePVLastSignState, error) {
+	return pv.LastSignState, nil
+}
+
+// ImportSignState replaces the LastSignState with the given state, unless it
+// would lower the watermark. Implements SignStateKeeper.
+func (pv *FilePV) ImportSignState(ctx context.Context, state FilePVLastSignState) error {
+	if err := checkSignStateImport(pv.LastSignState, state); err != nil {
+		return err
+	}
+	state.filePath = pv.LastSignState.filePath
+	return pv.swapLastSignState(ctx, state)
 }
 
 //-----------------------------------------------------------------------------------------
@@ -9,6 +9,7 @@ import (
 	"github.com/ari-anchor/sei-tendermint/crypto"
 	"github.com/ari-anchor/sei-tendermint/crypto/encoding"
 	"github.com/ari-anchor/sei-tendermint/libs/log"
+	"github.com/ari-anchor/sei-tendermint/privval"
 	privvalproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/privval"
 	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
 	"github.com/ari-anchor/sei-tendermint/types"
@@ -24,7 +25,10 @@ type SignerClient struct {
 	chainID string
 }
 
-var _ types.PrivValidator = (*SignerClient)(nil)
+var (
+	_ types.PrivValidator     = (*SignerClient)(nil)
+	_ privval.SignStateKeeper = (*SignerClient)(nil)
+)
 
 // NewSignerClient returns an instance of SignerClient.
 // it will start the endpoint (if not already started)
@@ -99,3 +103,30 @@ func (sc *SignerClient) SignProposal(ctx context.Context, chainID string, propos
 
 	return nil
 }
+
+// ExportSignState requests the last sign state of a remote signer
+func (sc *SignerClient) ExportSignState(ctx context.Context) (privval.FilePVLastSignState, error) {
+	resp, err := sc.client.ExportSignState(ctx, &privvalproto.ExportSignStateRequest{ChainId: sc.chainID})
+	if err != nil {
+		errStatus, _ := status.FromError(err)
+		sc.logger.Error("SignerClient::ExportSignState", "err", errStatus.Message())
+		return privval.FilePVLastSignState{}, errStatus.Err()
+	}
+
+	return privval.SignStateFromProto(&resp.SignState)
+}
+
+// ImportSignState requests a remote signer to import a last sign state
+func (sc *SignerClient) ImportSignState(ctx context.Context, state privval.FilePVLastSignState) error {
+	pb := privval.SignStateToProto(state)
+	_, err := sc.client.ImportSignState(
+		ctx, &privvalproto.ImportSignStateRequest{ChainId: sc.chainID, SignState: &pb})
+
+	if err != nil {
+		errStatus, _ := status.FromError(err)
+		sc.logger.Error("SignerClient::ImportSignState", "err", errStatus.Message())
+		return errStatus.Err()
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package grpc_test
 import (
 	"context"
 	"net"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -13,8 +14,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ari-anchor/sei-tendermint/crypto"
+	"github.com/ari-anchor/sei-tendermint/crypto/ed25519"
 	"github.com/ari-anchor/sei-tendermint/libs/log"
 	tmrand "github.com/ari-anchor/sei-tendermint/libs/rand"
+	"github.com/ari-anchor/sei-tendermint/privval"
 	tmgrpc "github.com/ari-anchor/sei-tendermint/privval/grpc"
 	privvalproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/privval"
 	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
@@ -169,3 +172,37 @@ func TestSignerClient_SignProposal(t *testing.T) {
 
 	assert.Equal(t, pbWant.Signature, pbHave.Signature)
 }
+
+func TestSignerClient_SignState(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	filePV := privval.NewFilePV(ed25519.GenPrivKey(), "", filepath.Join(t.TempDir(), "state.json"))
+	logger := log.NewTestingLogger(t)
+	srv, dialer := dialer(t, filePV, logger)
+	defer srv.Stop()
+
+	conn, err := grpc.DialContext(ctx, "",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client, err := tmgrpc.NewSignerClient(conn, chainID, logger)
+	require.NoError(t, err)
+
+	state := privval.FilePVLastSignState{Height: 10, Round: 1, Step: 2}
+	require.NoError(t, client.ImportSignState(ctx, state))
+	assert.Equal(t, int64(10), filePV.LastSignState.Height)
+
+	have, err := client.ExportSignState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, state.Height, have.Height)
+	assert.Equal(t, state.Round, have.Round)
+	assert.Equal(t, state.Step, have.Step)
+
+	// The watermark cannot be lowered.
+	state.Round = 0
+	assert.Error(t, client.ImportSignState(ctx, state))
+}
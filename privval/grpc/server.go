@@ -9,6 +9,7 @@ import (
 	"github.com/ari-anchor/sei-tendermint/crypto"
 	"github.com/ari-anchor/sei-tendermint/crypto/encoding"
 	"github.com/ari-anchor/sei-tendermint/libs/log"
+	"github.com/ari-anchor/sei-tendermint/privval"
 	privvalproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/privval"
 	"github.com/ari-anchor/sei-tendermint/types"
 )
@@ -81,3 +82,44 @@ func (ss *SignerServer) SignProposal(ctx context.Context, req *privvalproto.Sign
 
 	return &privvalproto.SignedProposalResponse{Proposal: *proposal}, nil
 }
+
+// ExportSignState receives a request for the last sign state
+// returns ExportSignStateResponse on success and error on failure
+func (ss *SignerServer) ExportSignState(ctx context.Context, req *privvalproto.ExportSignStateRequest) (
+	*privvalproto.ExportSignStateResponse, error) {
+	keeper, ok := ss.privVal.(privval.SignStateKeeper)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%T does not support exporting its sign state", ss.privVal)
+	}
+
+	lss, err := keeper.ExportSignState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error exporting sign state: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: ExportSignState Success", "height", lss.Height)
+
+	return &privvalproto.ExportSignStateResponse{SignState: privval.SignStateToProto(lss)}, nil
+}
+
+// ImportSignState receives a request to import a last sign state
+// returns ImportSignStateResponse on success and error on failure
+func (ss *SignerServer) ImportSignState(ctx context.Context, req *privvalproto.ImportSignStateRequest) (
+	*privvalproto.ImportSignStateResponse, error) {
+	keeper, ok := ss.privVal.(privval.SignStateKeeper)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%T does not support importing a sign state", ss.privVal)
+	}
+
+	lss, err := privval.SignStateFromProto(req.SignState)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sign state: %v", err)
+	}
+	if err := keeper.ImportSignState(ctx, lss); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error importing sign state: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: ImportSignState Success", "height", lss.Height)
+
+	return &privvalproto.ImportSignStateResponse{}, nil
+}
@@ -0,0 +1,196 @@
+package privval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ari-anchor/sei-tendermint/crypto"
+	"github.com/ari-anchor/sei-tendermint/internal/jsontypes"
+	privvalproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/privval"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// SignStateInterchangeVersion is the version of the SignStateInterchange
+// format.
+const SignStateInterchangeVersion = "1"
+
+// SignStateKeeper is implemented by the private validators whose last sign
+// state can be exported and imported, to move the signing history of a
+// validator between backends.
+type SignStateKeeper interface {
+	// ExportSignState returns the last sign state.
+	ExportSignState(ctx context.Context) (FilePVLastSignState, error)
+
+	// ImportSignState replaces the last sign state with the given one. It
+	// returns an error if the given state is below the last sign state, or
+	// conflicts with it.
+	ImportSignState(ctx context.Context, state FilePVLastSignState) error
+}
+
+// SignStateInterchange is the interchange format of the last sign state of a
+// validator. It is encoded in JSON as follows, where the last sign state has
+// the format of the state file of a FilePV:
+//
+//	{
+//	  "version": "1",
+//	  "pub_key": {"type": "tendermint/PubKeyEd25519", "value": "<base64>"},
+//	  "last_sign_state": {
+//	    "height": "<decimal>",
+//	    "round": <number>,
+//	    "step": <0: none, 1: propose, 2: prevote, 3: precommit>,
+//	    "signature": "<base64>",
+//	    "signbytes": "<hex>"
+//	  }
+//	}
+type SignStateInterchange struct {
+	Version       string
+	PubKey        crypto.PubKey
+	LastSignState FilePVLastSignState
+}
+
+type signStateInterchangeJSON struct {
+	Version       string              `json:"version"`
+	PubKey        json.RawMessage     `json:"pub_key"`
+	LastSignState FilePVLastSignState `json:"last_sign_state"`
+}
+
+func (ic SignStateInterchange) MarshalJSON() ([]byte, error) {
+	pubk, err := jsontypes.Marshal(ic.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(signStateInterchangeJSON{
+		Version: ic.Version, PubKey: pubk, LastSignState: ic.LastSignState,
+	})
+}
+
+func (ic *SignStateInterchange) UnmarshalJSON(data []byte) error {
+	var v signStateInterchangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if err := jsontypes.Unmarshal(v.PubKey, &ic.PubKey); err != nil {
+		return fmt.Errorf("decoding PubKey: %w", err)
+	}
+	ic.Version = v.Version
+	ic.LastSignState = v.LastSignState
+	return nil
+}
+
+// ExportSignStateInterchange exports the last sign state of the given private
+// validator, which must implement SignStateKeeper.
+func ExportSignStateInterchange(ctx context.Context, pv types.PrivValidator) (SignStateInterchange, error) {
+	keeper, ok := pv.(SignStateKeeper)
+	if !ok {
+		return SignStateInterchange{}, fmt.Errorf("%T does not support exporting its sign state", pv)
+	}
+	pubKey, err := pv.GetPubKey(ctx)
+	if err != nil {
+		return SignStateInterchange{}, fmt.Errorf("can't get pubkey: %w", err)
+	}
+	lss, err := keeper.ExportSignState(ctx)
+	if err != nil {
+		return SignStateInterchange{}, err
+	}
+	return SignStateInterchange{
+		Version:       SignStateInterchangeVersion,
+		PubKey:        pubKey,
+		LastSignState: lss,
+	}, nil
+}
+
+// ImportSignStateInterchange imports the last sign state into the given
+// private validator, which must implement SignStateKeeper and hold the key
+// the state was exported for.
+func ImportSignStateInterchange(ctx context.Context, pv types.PrivValidator, ic SignStateInterchange) error {
+	if ic.Version != SignStateInterchangeVersion {
+		return fmt.Errorf("unsupported sign state interchange version %q", ic.Version)
+	}
+	keeper, ok := pv.(SignStateKeeper)
+	if !ok {
+		return fmt.Errorf("%T does not support importing a sign state", pv)
+	}
+	pubKey, err := pv.GetPubKey(ctx)
+	if err != nil {
+		return fmt.Errorf("can't get pubkey: %w", err)
+	}
+	if ic.PubKey == nil || !pubKey.Equals(ic.PubKey) {
+		return fmt.Errorf("sign state was exported for pubkey %v, not %v", ic.PubKey, pubKey)
+	}
+	return keeper.ImportSignState(ctx, ic.LastSignState)
+}
+
+// checkSignStateImport returns an error if the next last sign state is
+// invalid, or if importing it over the current one would lower the
+// watermark or forget the message signed at it.
+func checkSignStateImport(cur, next FilePVLastSignState) error {
+	switch {
+	case next.Height < 0:
+		return errors.New("negative height")
+	case next.Round < 0:
+		return errors.New("negative round")
+	case next.Step < stepNone || next.Step > stepPrecommit:
+		return fmt.Errorf("invalid step %d", next.Step)
+	case len(next.SignBytes) > 0 && len(next.Signature) == 0:
+		return errors.New("sign bytes without signature")
+	}
+
+	if next.Height != cur.Height {
+		if next.Height < cur.Height {
+			return fmt.Errorf("import would lower the height from %d to %d", cur.Height, next.Height)
+		}
+		return nil
+	}
+	if next.Round != cur.Round {
+		if next.Round < cur.Round {
+			return fmt.Errorf("import would lower the round at height %d from %d to %d",
+				cur.Height, cur.Round, next.Round)
+		}
+		return nil
+	}
+	if next.Step != cur.Step {
+		if next.Step < cur.Step {
+			return fmt.Errorf("import would lower the step at height %d round %d from %d to %d",
+				cur.Height, cur.Round, cur.Step, next.Step)
+		}
+		return nil
+	}
+	if !bytes.Equal(next.SignBytes, cur.SignBytes) || !bytes.Equal(next.Signature, cur.Signature) {
+		return fmt.Errorf("import conflicts with the message signed at height %d round %d step %d",
+			cur.Height, cur.Round, cur.Step)
+	}
+	return nil
+}
+
+// SignStateToProto converts the last sign state to its protobuf
+// representation.
+func SignStateToProto(lss FilePVLastSignState) privvalproto.SignState {
+	return privvalproto.SignState{
+		Height:    lss.Height,
+		Round:     lss.Round,
+		Step:      int32(lss.Step),
+		SignBytes: lss.SignBytes,
+		Signature: lss.Signature,
+	}
+}
+
+// SignStateFromProto converts the protobuf representation of a last sign
+// state.
+func SignStateFromProto(pb *privvalproto.SignState) (FilePVLastSignState, error) {
+	if pb == nil {
+		return FilePVLastSignState{}, errors.New("nil sign state")
+	}
+	if pb.Step < int32(stepNone) || pb.Step > int32(stepPrecommit) {
+		return FilePVLastSignState{}, fmt.Errorf("invalid step %d", pb.Step)
+	}
+	return FilePVLastSignState{
+		Height:    pb.Height,
+		Round:     pb.Round,
+		Step:      int8(pb.Step),
+		SignBytes: pb.SignBytes,
+		Signature: pb.Signature,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package privval
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ari-anchor/sei-tendermint/crypto"
+	tmrand "github.com/ari-anchor/sei-tendermint/libs/rand"
+	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+func TestCheckSignStateImport(t *testing.T) {
+	cur := FilePVLastSignState{
+		Height:    10,
+		Round:     1,
+		Step:      stepPrevote,
+		SignBytes: []byte("signbytes"),
+		Signature: []byte("signature"),
+	}
+
+	testCases := []struct {
+		name  string
+		next  FilePVLastSignState
+		valid bool
+	}{
+		{"Same", cur, true},
+		{"HigherHeight", FilePVLastSignState{Height: 11}, true},
+		{"HigherRound", FilePVLastSignState{Height: 10, Round: 2}, true},
+		{"HigherStep", FilePVLastSignState{Height: 10, Round: 1, Step: stepPrecommit}, true},
+		{"LowerHeight", FilePVLastSignState{Height: 9, Round: 5, Step: stepPrecommit}, false},
+		{"LowerRound", FilePVLastSignState{Height: 10, Round: 0, Step: stepPrecommit}, false},
+		{"LowerStep", FilePVLastSignState{Height: 10, Round: 1, Step: stepPropose}, false},
+		{"Conflicting", FilePVLastSignState{
+			Height: 10, Round: 1, Step: stepPrevote, SignBytes: []byte("other"), Signature: []byte("other"),
+		}, false},
+		{"Forgotten", FilePVLastSignState{Height: 10, Round: 1, Step: stepPrevote}, false},
+		{"InvalidStep", FilePVLastSignState{Height: 11, Step: 4}, false},
+		{"NoSignature", FilePVLastSignState{Height: 11, Step: stepPrevote, SignBytes: []byte("signbytes")}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSignStateImport(cur, tc.next)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSignStateInterchange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const chainID = "mychainid"
+	src, _, _ := newTestFilePV(t)
+	dst, _, _ := newTestFilePV(t)
+	dst.Key = src.Key
+
+	randbytes := tmrand.Bytes(crypto.HashSize)
+	block := types.BlockID{Hash: randbytes,
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: randbytes}}
+	vote := newVote(src.GetAddress(), 0, 10, 1, tmproto.PrecommitType, block, nil).ToProto()
+	require.NoError(t, src.SignVote(ctx, chainID, vote))
+
+	ic, err := ExportSignStateInterchange(ctx, src)
+	require.NoError(t, err)
+	bz, err := json.Marshal(ic)
+	require.NoError(t, err)
+	var decoded SignStateInterchange
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.NoError(t, ImportSignStateInterchange(ctx, dst, decoded))
+
+	// The destination refuses to sign a regression, and signs the same vote
+	// with the same signature.
+	assert.Error(t, dst.SignVote(ctx, chainID,
+		newVote(src.GetAddress(), 0, 10, 0, tmproto.PrecommitType, block, nil).ToProto()))
+	same := newVote(src.GetAddress(), 0, 10, 1, tmproto.PrecommitType, block, nil).ToProto()
+	same.Timestamp = vote.Timestamp
+	require.NoError(t, dst.SignVote(ctx, chainID, same))
+	assert.Equal(t, vote.Signature, same.Signature)
+
+	// The state cannot be imported for another key, or by a private
+	// validator that does not keep a sign state.
+	other, _, _ := newTestFilePV(t)
+	assert.Error(t, ImportSignStateInterchange(ctx, other, decoded))
+	assert.Error(t, ImportSignStateInterchange(ctx, types.NewMockPV(), decoded))
+}
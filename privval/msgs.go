@@ -32,6 +32,14 @@ func mustWrapMsg(pb proto.Message) privvalproto.Message {
 		msg.Sum = &privvalproto.Message_PingRequest{PingRequest: pb}
 	case *privvalproto.PingResponse:
 		msg.Sum = &privvalproto.Message_PingResponse{PingResponse: pb}
+	case *privvalproto.ExportSignStateRequest:
+		msg.Sum = &privvalproto.Message_ExportSignStateRequest{ExportSignStateRequest: pb}
+	case *privvalproto.ExportSignStateResponse:
+		msg.Sum = &privvalproto.Message_ExportSignStateResponse{ExportSignStateResponse: pb}
+	case *privvalproto.ImportSignStateRequest:
+		msg.Sum = &privvalproto.Message_ImportSignStateRequest{ImportSignStateRequest: pb}
+	case *privvalproto.ImportSignStateResponse:
+		msg.Sum = &privvalproto.Message_ImportSignStateResponse{ImportSignStateResponse: pb}
 	default:
 		panic(fmt.Errorf("unknown message type %T", pb))
 	}
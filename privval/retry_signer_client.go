@@ -24,7 +24,10 @@ func NewRetrySignerClient(sc *SignerClient, retries int, timeout time.Duration)
 	return &RetrySignerClient{sc, retries, timeout}
 }
 
-var _ types.PrivValidator = (*RetrySignerClient)(nil)
+var (
+	_ types.PrivValidator = (*RetrySignerClient)(nil)
+	_ SignStateKeeper     = (*RetrySignerClient)(nil)
+)
 
 func (sc *RetrySignerClient) Close() error {
 	return sc.next.Close()
@@ -102,3 +105,38 @@ func (sc *RetrySignerClient) SignProposal(ctx context.Context, chainID string, p
 	}
 	return fmt.Errorf("exhausted all attempts to sign proposal: %w", err)
 }
+
+func (sc *RetrySignerClient) ExportSignState(ctx context.Context) (FilePVLastSignState, error) {
+	var (
+		lss FilePVLastSignState
+		err error
+	)
+	for i := 0; i < sc.retries || sc.retries == 0; i++ {
+		lss, err = sc.next.ExportSignState(ctx)
+		if err == nil {
+			return lss, nil
+		}
+		// If remote signer errors, we don't retry.
+		if _, ok := err.(*RemoteSignerError); ok {
+			return lss, err
+		}
+		time.Sleep(sc.timeout)
+	}
+	return lss, fmt.Errorf("exhausted all attempts to export sign state: %w", err)
+}
+
+func (sc *RetrySignerClient) ImportSignState(ctx context.Context, state FilePVLastSignState) error {
+	var err error
+	for i := 0; i < sc.retries || sc.retries == 0; i++ {
+		err = sc.next.ImportSignState(ctx, state)
+		if err == nil {
+			return nil
+		}
+		// If remote signer errors, we don't retry.
+		if _, ok := err.(*RemoteSignerError); ok {
+			return err
+		}
+		time.Sleep(sc.timeout)
+	}
+	return fmt.Errorf("exhausted all attempts to import sign state: %w", err)
+}
@@ -0,0 +1,151 @@
+package privval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrLastSignStateChanged is returned by a LastSignStateStore when the stored
+// last sign state is not the expected one, because another signer sharing the
+// store has signed in the meantime.
+var ErrLastSignStateChanged = errors.New("last sign state was changed by another signer")
+
+// LastSignStateStore persists the last sign state of a FilePV. The state is
+// only advanced with CompareAndSwap before a signature is returned, so signers
+// sharing a store, like a validator and its standby, never both sign at the
+// same height, round and step.
+type LastSignStateStore interface {
+	// Load returns the stored last sign state, or the zero state if none was
+	// stored yet.
+	Load(ctx context.Context) (FilePVLastSignState, error)
+
+	// CompareAndSwap stores next if the stored last sign state is prev, and
+	// returns ErrLastSignStateChanged otherwise. Implementations shared by
+	// several signers must perform the comparison and the update atomically.
+	CompareAndSwap(ctx context.Context, prev, next FilePVLastSignState) error
+}
+
+// FileLastSignStateStore stores the last sign state in a JSON file. It is the
+// default store of a FilePV. It assumes that it is the only writer of the
+// file, so the state held by its FilePV is the stored state, and
+// CompareAndSwap does not compare.
+type FileLastSignStateStore struct {
+	filePath string
+}
+
+var _ LastSignStateStore = (*FileLastSignStateStore)(nil)
+
+// NewFileLastSignStateStore returns a store of the last sign state in the
+// given file.
+func NewFileLastSignStateStore(filePath string) *FileLastSignStateStore {
+	return &FileLastSignStateStore{filePath: filePath}
+}
+
+// Load implements LastSignStateStore.
+func (s *FileLastSignStateStore) Load(ctx context.Context) (FilePVLastSignState, error) {
+	lss := FilePVLastSignState{filePath: s.filePath}
+	bz, err := os.ReadFile(s.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return lss, nil
+	} else if err != nil {
+		return lss, err
+	}
+	if err := json.Unmarshal(bz, &lss); err != nil {
+		return lss, fmt.Errorf("error reading PrivValidator state from %v: %w", s.filePath, err)
+	}
+	return lss, nil
+}
+
+// CompareAndSwap implements LastSignStateStore.
+func (s *FileLastSignStateStore) CompareAndSwap(ctx context.Context, prev, next FilePVLastSignState) error {
+	next.filePath = s.filePath
+	return next.Save()
+}
+
+// ConsensusKV is a key-value store offering linearizable compare-and-swap,
+// like etcd or Consul, for sharing the last sign state between hosts.
+type ConsensusKV interface {
+	// Get returns the value of key, or nil if the key is not set.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// CompareAndSwap sets key to value if its current value is old, where a
+	// nil old value stands for an unset key, and reports whether it did.
+	CompareAndSwap(ctx context.Context, key string, old, value []byte) (bool, error)
+}
+
+// KVLastSignStateStore stores the last sign state under a key of a
+// ConsensusKV, in the same JSON encoding as a FileLastSignStateStore.
+type KVLastSignStateStore struct {
+	kv  ConsensusKV
+	key string
+}
+
+var _ LastSignStateStore = (*KVLastSignStateStore)(nil)
+
+// NewKVLastSignStateStore returns a store of the last sign state under the
+// given key of kv.
+func NewKVLastSignStateStore(kv ConsensusKV, key string) *KVLastSignStateStore {
+	return &KVLastSignStateStore{kv: kv, key: key}
+}
+
+// Load implements LastSignStateStore.
+func (s *KVLastSignStateStore) Load(ctx context.Context) (FilePVLastSignState, error) {
+	var lss FilePVLastSignState
+	bz, err := s.kv.Get(ctx, s.key)
+	if err != nil || bz == nil {
+		return lss, err
+	}
+	if err := json.Unmarshal(bz, &lss); err != nil {
+		return lss, fmt.Errorf("error reading PrivValidator state from %v: %w", s.key, err)
+	}
+	return lss, nil
+}
+
+// CompareAndSwap implements LastSignStateStore. The zero state stands for an
+// unset key. The stored state is decoded and compared with prev, and then
+// swapped by the bytes that were read, since the stored encoding of prev may
+// differ from the one of this store, e.g. if another version wrote it.
+func (s *KVLastSignStateStore) CompareAndSwap(ctx context.Context, prev, next FilePVLastSignState) error {
+	old, err := s.kv.Get(ctx, s.key)
+	if err != nil {
+		return err
+	}
+	var stored FilePVLastSignState
+	if old != nil {
+		if err := json.Unmarshal(old, &stored); err != nil {
+			return fmt.Errorf("error reading PrivValidator state from %v: %w", s.key, err)
+		}
+	}
+	if !stored.equal(prev) {
+		return ErrLastSignStateChanged
+	}
+
+	value, err := json.Marshal(next)
+	if err != nil {
+		return err
+	}
+	ok, err := s.kv.CompareAndSwap(ctx, s.key, old, value)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrLastSignStateChanged
+	}
+	return nil
+}
+
+// equal reports whether the states record the same signature.
+func (lss FilePVLastSignState) equal(other FilePVLastSignState) bool {
+	return lss.Height == other.Height && lss.Round == other.Round && lss.Step == other.Step &&
+		bytes.Equal(lss.Signature, other.Signature) && bytes.Equal(lss.SignBytes, other.SignBytes)
+}
+
+// isZero reports whether the state is the initial one, before any signature.
+func (lss FilePVLastSignState) isZero() bool {
+	return lss.Height == 0 && lss.Round == 0 && lss.Step == stepNone &&
+		len(lss.Signature) == 0 && len(lss.SignBytes) == 0
+}
@@ -0,0 +1,135 @@
+package privval
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ari-anchor/sei-tendermint/crypto"
+	tmrand "github.com/ari-anchor/sei-tendermint/libs/rand"
+	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// memKV is an in-memory ConsensusKV.
+type memKV struct {
+	mtx    sync.Mutex
+	values map[string][]byte
+}
+
+func newMemKV() *memKV {
+	return &memKV{values: make(map[string][]byte)}
+}
+
+func (kv *memKV) Get(ctx context.Context, key string) ([]byte, error) {
+	kv.mtx.Lock()
+	defer kv.mtx.Unlock()
+	return kv.values[key], nil
+}
+
+func (kv *memKV) CompareAndSwap(ctx context.Context, key string, old, value []byte) (bool, error) {
+	kv.mtx.Lock()
+	defer kv.mtx.Unlock()
+	if cur, ok := kv.values[key]; ok != (old != nil) || !bytes.Equal(cur, old) {
+		return false, nil
+	}
+	kv.values[key] = value
+	return true, nil
+}
+
+func TestFileLastSignStateStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := NewFileLastSignStateStore(filepath.Join(t.TempDir(), "state.json"))
+
+	// A missing file holds the zero state.
+	lss, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.True(t, lss.isZero())
+
+	next := FilePVLastSignState{Height: 10, Round: 1, Step: stepPrecommit}
+	require.NoError(t, store.CompareAndSwap(ctx, lss, next))
+	lss, err = store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, next.Height, lss.Height)
+	assert.Equal(t, next.Round, lss.Round)
+	assert.Equal(t, next.Step, lss.Step)
+}
+
+func TestKVLastSignStateStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := NewKVLastSignStateStore(newMemKV(), "validator")
+
+	zero, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.True(t, zero.isZero())
+
+	first := FilePVLastSignState{Height: 10, Round: 1, Step: stepPrevote, SignBytes: []byte("a")}
+	second := FilePVLastSignState{Height: 10, Round: 1, Step: stepPrecommit, SignBytes: []byte("b")}
+	require.NoError(t, store.CompareAndSwap(ctx, zero, first))
+
+	// Swapping from a stale state fails.
+	assert.ErrorIs(t, store.CompareAndSwap(ctx, zero, second), ErrLastSignStateChanged)
+
+	lss, err := store.Load(ctx)
+	require.NoError(t, err)
+	require.NoError(t, store.CompareAndSwap(ctx, lss, second))
+	lss, err = store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, second.Step, lss.Step)
+	assert.Equal(t, second.SignBytes, lss.SignBytes)
+
+	// A state stored in another encoding is compared by value.
+	kv := newMemKV()
+	kv.values["validator"] = []byte(fmt.Sprintf(`{ "step": %d, "round": 1, "height": "10" }`, stepPrevote))
+	store = NewKVLastSignStateStore(kv, "validator")
+	lss, err = store.Load(ctx)
+	require.NoError(t, err)
+	require.NoError(t, store.CompareAndSwap(ctx, lss, second))
+}
+
+func TestFilePVSharedLastSignState(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const chainID = "mychainid"
+	privVal, keyFile, _ := newTestFilePV(t)
+	require.NoError(t, privVal.Save())
+
+	store := NewKVLastSignStateStore(newMemKV(), "validator")
+	primary, err := LoadFilePVWithStore(ctx, keyFile, store)
+	require.NoError(t, err)
+	standby, err := LoadFilePVWithStore(ctx, keyFile, store)
+	require.NoError(t, err)
+
+	randbytes := tmrand.Bytes(crypto.HashSize)
+	block1 := types.BlockID{Hash: randbytes,
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: randbytes}}
+	block2 := types.BlockID{Hash: tmrand.Bytes(crypto.HashSize),
+		PartSetHeader: types.PartSetHeader{Total: 10, Hash: randbytes}}
+
+	addr := primary.GetAddress()
+	height, round := int64(10), int32(1)
+	require.NoError(t, primary.SignVote(ctx, chainID,
+		newVote(addr, 0, height, round, tmproto.PrecommitType, block1, nil).ToProto()))
+
+	// The standby signed nothing itself, but must not sign a conflicting vote.
+	conflicting := newVote(addr, 0, height, round, tmproto.PrecommitType, block2, nil).ToProto()
+	assert.ErrorIs(t, standby.SignVote(ctx, chainID, conflicting), ErrLastSignStateChanged)
+	assert.Equal(t, height, standby.LastSignState.Height)
+	assert.Error(t, standby.SignVote(ctx, chainID, conflicting))
+
+	// Once it caught up, the standby takes over.
+	next := newVote(addr, 0, height+1, 0, tmproto.PrevoteType, block2, nil).ToProto()
+	require.NoError(t, standby.SignVote(ctx, chainID, next))
+	assert.Error(t, primary.SignProposal(ctx, chainID, newProposal(height+1, 0, block1, next.Timestamp).ToProto()))
+}
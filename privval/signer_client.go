@@ -21,7 +21,10 @@ type SignerClient struct {
 	chainID  string
 }
 
-var _ types.PrivValidator = (*SignerClient)(nil)
+var (
+	_ types.PrivValidator = (*SignerClient)(nil)
+	_ SignStateKeeper     = (*SignerClient)(nil)
+)
 
 // NewSignerClient returns an instance of SignerClient.
 // it will start the endpoint (if not already started)
@@ -143,3 +146,42 @@ func (sc *SignerClient) SignProposal(ctx context.Context, chainID string, propos
 
 	return nil
 }
+
+// ExportSignState requests the last sign state of a remote signer
+func (sc *SignerClient) ExportSignState(ctx context.Context) (FilePVLastSignState, error) {
+	response, err := sc.endpoint.SendRequest(ctx, mustWrapMsg(&privvalproto.ExportSignStateRequest{ChainId: sc.chainID}))
+	if err != nil {
+		return FilePVLastSignState{}, err
+	}
+
+	resp := response.GetExportSignStateResponse()
+	if resp == nil {
+		return FilePVLastSignState{}, ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return FilePVLastSignState{}, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return SignStateFromProto(&resp.SignState)
+}
+
+// ImportSignState requests a remote signer to import a last sign state
+func (sc *SignerClient) ImportSignState(ctx context.Context, state FilePVLastSignState) error {
+	pb := SignStateToProto(state)
+	response, err := sc.endpoint.SendRequest(ctx, mustWrapMsg(
+		&privvalproto.ImportSignStateRequest{SignState: &pb, ChainId: sc.chainID},
+	))
+	if err != nil {
+		return err
+	}
+
+	resp := response.GetImportSignStateResponse()
+	if resp == nil {
+		return ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return nil
+}
@@ -354,6 +354,43 @@ func TestSignerSignProposalErrors(t *testing.T) {
 	}
 }
 
+func TestSignerSignState(t *testing.T) {
+	t.Cleanup(leaktest.Check(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := log.NewNopLogger()
+
+	for _, tc := range getSignerTestCases(ctx, t, logger) {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.closer()
+
+			// The mock does not keep a sign state.
+			_, err := tc.signerClient.ExportSignState(ctx)
+			_, ok := err.(*RemoteSignerError)
+			require.True(t, ok, "%T", err)
+
+			filePV, _, _ := newTestFilePV(t)
+			tc.signerServer.privVal = filePV
+
+			state := FilePVLastSignState{Height: 10, Round: 1, Step: stepPrevote}
+			require.NoError(t, tc.signerClient.ImportSignState(ctx, state))
+			have, err := tc.signerClient.ExportSignState(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, state.Height, have.Height)
+			assert.Equal(t, state.Round, have.Round)
+			assert.Equal(t, state.Step, have.Step)
+
+			// The watermark cannot be lowered.
+			state.Height = 9
+			err = tc.signerClient.ImportSignState(ctx, state)
+			_, ok = err.(*RemoteSignerError)
+			require.True(t, ok, "%T", err)
+		})
+	}
+}
+
 func TestSignerSignVoteErrors(t *testing.T) {
 	t.Cleanup(leaktest.Check(t))
 
@@ -85,6 +85,50 @@ func DefaultValidationRequestHandler(
 		} else {
 			res = mustWrapMsg(&privvalproto.SignedProposalResponse{Proposal: *proposal, Error: nil})
 		}
+	case *privvalproto.Message_ExportSignStateRequest:
+		if r.ExportSignStateRequest.GetChainId() != chainID {
+			res = mustWrapMsg(&privvalproto.ExportSignStateResponse{
+				Error: &privvalproto.RemoteSignerError{
+					Code: 0, Description: "unable to export sign state"}})
+			return res, fmt.Errorf("want chainID: %s, got chainID: %s", r.ExportSignStateRequest.GetChainId(), chainID)
+		}
+
+		var lss FilePVLastSignState
+		keeper, ok := privVal.(SignStateKeeper)
+		if !ok {
+			err = fmt.Errorf("%T does not support exporting its sign state", privVal)
+		} else {
+			lss, err = keeper.ExportSignState(ctx)
+		}
+		if err != nil {
+			res = mustWrapMsg(&privvalproto.ExportSignStateResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}})
+		} else {
+			res = mustWrapMsg(&privvalproto.ExportSignStateResponse{SignState: SignStateToProto(lss), Error: nil})
+		}
+
+	case *privvalproto.Message_ImportSignStateRequest:
+		if r.ImportSignStateRequest.GetChainId() != chainID {
+			res = mustWrapMsg(&privvalproto.ImportSignStateResponse{
+				Error: &privvalproto.RemoteSignerError{
+					Code: 0, Description: "unable to import sign state"}})
+			return res, fmt.Errorf("want chainID: %s, got chainID: %s", r.ImportSignStateRequest.GetChainId(), chainID)
+		}
+
+		var lss FilePVLastSignState
+		keeper, ok := privVal.(SignStateKeeper)
+		if !ok {
+			err = fmt.Errorf("%T does not support importing a sign state", privVal)
+		} else if lss, err = SignStateFromProto(r.ImportSignStateRequest.SignState); err == nil {
+			err = keeper.ImportSignState(ctx, lss)
+		}
+		if err != nil {
+			res = mustWrapMsg(&privvalproto.ImportSignStateResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}})
+		} else {
+			res = mustWrapMsg(&privvalproto.ImportSignStateResponse{Error: nil})
+		}
+
 	case *privvalproto.Message_PingRequest:
 		err, res = nil, mustWrapMsg(&privvalproto.PingResponse{})
 
@@ -0,0 +1,314 @@
+package privval
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/ari-anchor/sei-tendermint/crypto"
+	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// ThresholdSigner implements PrivValidator by fanning out each signing request
+// to a set of remote signers holding the same key, and accepting a signature
+// only once a threshold of them returned it for the same sign bytes. Since the
+// threshold is a majority of the signers, no two conflicting messages can both
+// reach it, even if several validator processes share the signers.
+//
+// In addition, the ThresholdSigner keeps a high-water mark of the last height,
+// round and step it signed, persisted to a LastSignStateStore like the last
+// sign state of a FilePV, and refuses to sign any message below it, or a
+// conflicting message at it.
+// A signer that fails or times out does not prevent signing, as long as the
+// threshold is reached by the others.
+type ThresholdSigner struct {
+	signers   []types.PrivValidator
+	threshold int
+	pubKey    crypto.PubKey
+
+	// signerMtxs serialize the requests to each signer, since a request may
+	// still be in flight when the next one is made.
+	signerMtxs []sync.Mutex
+
+	mtx           sync.Mutex
+	stateStore    LastSignStateStore
+	lastSignState FilePVLastSignState
+}
+
+var (
+	_ types.PrivValidator = (*ThresholdSigner)(nil)
+	_ SignStateKeeper     = (*ThresholdSigner)(nil)
+)
+
+// NewThresholdSigner returns a ThresholdSigner that requires threshold of the
+// given signers to agree on each signature, and persists its high-water mark
+// to the given store, loading it first. All signers must hold the same key,
+// and threshold must be a majority of the signers.
+func NewThresholdSigner(
+	ctx context.Context,
+	signers []types.PrivValidator,
+	threshold int,
+	stateStore LastSignStateStore,
+) (*ThresholdSigner, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("no signers")
+	}
+	if threshold <= len(signers)/2 || threshold > len(signers) {
+		return nil, fmt.Errorf("threshold %d must be a majority of %d signers", threshold, len(signers))
+	}
+
+	var pubKey crypto.PubKey
+	for i, signer := range signers {
+		pk, err := signer.GetPubKey(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting pubkey of signer %d: %w", i, err)
+		}
+		if pubKey != nil && !pubKey.Equals(pk) {
+			return nil, fmt.Errorf("signer %d has pubkey %v, expected %v", i, pk, pubKey)
+		}
+		pubKey = pk
+	}
+
+	lss, err := stateStore.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading threshold signer state: %w", err)
+	}
+
+	return &ThresholdSigner{
+		signers:       signers,
+		signerMtxs:    make([]sync.Mutex, len(signers)),
+		threshold:     threshold,
+		pubKey:        pubKey,
+		stateStore:    stateStore,
+		lastSignState: lss,
+	}, nil
+}
+
+// GetPubKey returns the public key of the signers.
+func (ts *ThresholdSigner) GetPubKey(ctx context.Context) (crypto.PubKey, error) {
+	return ts.pubKey, nil
+}
+
+// SignVote signs the vote once a threshold of the signers agree on its
+// signature. As for a FilePV, a vote that only differs by its timestamp from
+// the vote last signed at the same height, round and step is signed with the
+// timestamp of the latter.
+func (ts *ThresholdSigner) SignVote(ctx context.Context, chainID string, vote *tmproto.Vote) error {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	step, err := voteToStep(vote)
+	if err != nil {
+		return err
+	}
+	lss := ts.lastSignState
+	sameHRS, err := lss.checkHRS(vote.Height, vote.Round, step)
+	if err != nil {
+		return err
+	}
+
+	signBytes := types.VoteSignBytes(chainID, vote)
+	if sameHRS && !bytes.Equal(signBytes, lss.SignBytes) {
+		timestamp, ok, err := checkVotesOnlyDifferByTimestamp(lss.SignBytes, signBytes)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("conflicting data")
+		}
+		vote.Timestamp = timestamp
+		signBytes = types.VoteSignBytes(chainID, vote)
+	}
+
+	// The requests may outlive the call, so they are made from a copy.
+	req := proto.Clone(vote).(*tmproto.Vote)
+	res, err := ts.collect(ctx, func(ctx context.Context, signer types.PrivValidator) (*signResult, error) {
+		v := proto.Clone(req).(*tmproto.Vote)
+		if err := signer.SignVote(ctx, chainID, v); err != nil {
+			return nil, err
+		}
+		res := &signResult{
+			signBytes: types.VoteSignBytes(chainID, v),
+			signature: v.Signature,
+			vote:      v,
+		}
+		if len(v.ExtensionSignature) > 0 &&
+			!ts.pubKey.VerifySignature(types.VoteExtensionSignBytes(chainID, v), v.ExtensionSignature) {
+			return nil, errors.New("invalid extension signature")
+		}
+		return res, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The signers may have signed the vote with the timestamp of a vote they
+	// signed before at the same height, round and step.
+	if !bytes.Equal(res.signBytes, signBytes) {
+		if _, ok, err := checkVotesOnlyDifferByTimestamp(signBytes, res.signBytes); err != nil || !ok {
+			return errors.New("signers signed conflicting data")
+		}
+	}
+
+	if err := ts.saveSigned(ctx, vote.Height, vote.Round, step, res.signBytes, res.signature); err != nil {
+		return err
+	}
+	vote.Timestamp = res.vote.Timestamp
+	vote.Signature = res.signature
+	vote.ExtensionSignature = res.vote.ExtensionSignature
+	return nil
+}
+
+// SignProposal signs the proposal once a threshold of the signers agree on its
+// signature.
+func (ts *ThresholdSigner) SignProposal(ctx context.Context, chainID string, proposal *tmproto.Proposal) error {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	lss := ts.lastSignState
+	sameHRS, err := lss.checkHRS(proposal.Height, proposal.Round, stepPropose)
+	if err != nil {
+		return err
+	}
+
+	signBytes := types.ProposalSignBytes(chainID, proposal)
+	if sameHRS && !bytes.Equal(signBytes, lss.SignBytes) {
+		return errors.New("conflicting data")
+	}
+
+	req := proto.Clone(proposal).(*tmproto.Proposal)
+	res, err := ts.collect(ctx, func(ctx context.Context, signer types.PrivValidator) (*signResult, error) {
+		p := proto.Clone(req).(*tmproto.Proposal)
+		if err := signer.SignProposal(ctx, chainID, p); err != nil {
+			return nil, err
+		}
+		return &signResult{
+			signBytes: types.ProposalSignBytes(chainID, p),
+			signature: p.Signature,
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(res.signBytes, signBytes) {
+		return errors.New("signers signed conflicting data")
+	}
+
+	if err := ts.saveSigned(ctx, proposal.Height, proposal.Round, stepPropose, res.signBytes, res.signature); err != nil {
+		return err
+	}
+	proposal.Signature = res.signature
+	return nil
+}
+
+// signResult is the outcome of a signing request to one of the signers.
+type signResult struct {
+	signBytes []byte
+	signature []byte
+
+	// vote is the vote signed by the signer, if any.
+	vote *tmproto.Vote
+}
+
+// collect sends the signing request to all signers concurrently, and returns
+// the first result whose sign bytes and valid signature were returned by a
+// threshold of the signers. The requests still in flight are canceled.
+func (ts *ThresholdSigner) collect(
+	ctx context.Context,
+	sign func(context.Context, types.PrivValidator) (*signResult, error),
+) (*signResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type response struct {
+		res *signResult
+		err error
+	}
+	responses := make(chan response, len(ts.signers))
+	for i := range ts.signers {
+		go func(i int) {
+			ts.signerMtxs[i].Lock()
+			defer ts.signerMtxs[i].Unlock()
+
+			res, err := sign(ctx, ts.signers[i])
+			if err == nil && !ts.pubKey.VerifySignature(res.signBytes, res.signature) {
+				res, err = nil, errors.New("invalid signature")
+			}
+			responses <- response{res, err}
+		}(i)
+	}
+
+	var (
+		votes = make(map[string]int)
+		errs  []error
+	)
+	for range ts.signers {
+		var r response
+		select {
+		case r = <-responses:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+
+		key := string(r.res.signBytes) + string(r.res.signature)
+		votes[key]++
+		if votes[key] >= ts.threshold {
+			return r.res, nil
+		}
+	}
+	return nil, fmt.Errorf("no signature was agreed on by %d of %d signers: %v",
+		ts.threshold, len(ts.signers), errs)
+}
+
+// saveSigned persists the high-water mark.
+func (ts *ThresholdSigner) saveSigned(ctx context.Context, height int64, round int32, step int8, signBytes, sig []byte) error {
+	next := ts.lastSignState
+	next.Height = height
+	next.Round = round
+	next.Step = step
+	next.Signature = sig
+	next.SignBytes = signBytes
+	return ts.swapLastSignState(ctx, next)
+}
+
+// swapLastSignState replaces the high-water mark with next in its store.
+func (ts *ThresholdSigner) swapLastSignState(ctx context.Context, next FilePVLastSignState) error {
+	if err := ts.stateStore.CompareAndSwap(ctx, ts.lastSignState, next); err != nil {
+		if errors.Is(err, ErrLastSignStateChanged) {
+			if lss, lerr := ts.stateStore.Load(ctx); lerr == nil {
+				ts.lastSignState = lss
+			}
+		}
+		return err
+	}
+	ts.lastSignState = next
+	return nil
+}
+
+// ExportSignState returns the high-water mark. Implements SignStateKeeper.
+func (ts *ThresholdSigner) ExportSignState(ctx context.Context) (FilePVLastSignState, error) {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+	return ts.lastSignState, nil
+}
+
+// ImportSignState replaces the high-water mark with the given state, unless it
+// would lower it. The sign states of the signers are left as is. Implements
+// SignStateKeeper.
+func (ts *ThresholdSigner) ImportSignState(ctx context.Context, state FilePVLastSignState) error {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+	if err := checkSignStateImport(ts.lastSignState, state); err != nil {
+		return err
+	}
+	return ts.swapLastSignState(ctx, state)
+}
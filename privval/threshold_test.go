@@ -0,0 +1,181 @@
+package privval
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ari-anchor/sei-tendermint/crypto"
+	"github.com/ari-anchor/sei-tendermint/crypto/ed25519"
+	tmrand "github.com/ari-anchor/sei-tendermint/libs/rand"
+	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// flakySigner is a signer that fails to sign while down.
+type flakySigner struct {
+	types.PrivValidator
+	down atomic.Bool
+}
+
+func (s *flakySigner) SignVote(ctx context.Context, chainID string, vote *tmproto.Vote) error {
+	if s.down.Load() {
+		return errors.New("signer is down")
+	}
+	return s.PrivValidator.SignVote(ctx, chainID, vote)
+}
+
+func (s *flakySigner) SignProposal(ctx context.Context, chainID string, proposal *tmproto.Proposal) error {
+	if s.down.Load() {
+		return errors.New("signer is down")
+	}
+	return s.PrivValidator.SignProposal(ctx, chainID, proposal)
+}
+
+// newTestSigners returns n signers holding the same key, each with its own
+// last sign state.
+func newTestSigners(t *testing.T, n int) []*flakySigner {
+	privKey := ed25519.GenPrivKey()
+	dir := t.TempDir()
+	signers := make([]*flakySigner, n)
+	for i := range signers {
+		pv := NewFilePV(privKey, "", filepath.Join(dir, tmrand.Str(8)))
+		signers[i] = &flakySigner{PrivValidator: pv}
+	}
+	return signers
+}
+
+func newTestThresholdSigner(
+	ctx context.Context,
+	t *testing.T,
+	signers []*flakySigner,
+	threshold int,
+	stateFile string,
+) *ThresholdSigner {
+	pvs := make([]types.PrivValidator, len(signers))
+	for i, signer := range signers {
+		pvs[i] = signer
+	}
+	ts, err := NewThresholdSigner(ctx, pvs, threshold, NewFileLastSignStateStore(stateFile))
+	require.NoError(t, err)
+	return ts
+}
+
+func TestNewThresholdSigner(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signers := []types.PrivValidator{types.NewMockPV(), types.NewMockPV()}
+	stateStore := NewFileLastSignStateStore(filepath.Join(t.TempDir(), "state.json"))
+
+	// The threshold must be a majority.
+	_, err := NewThresholdSigner(ctx, signers[:1], 1, stateStore)
+	require.NoError(t, err)
+	_, err = NewThresholdSigner(ctx, nil, 1, stateStore)
+	require.Error(t, err)
+	_, err = NewThresholdSigner(ctx, []types.PrivValidator{signers[0], signers[0]}, 1, stateStore)
+	require.Error(t, err)
+	_, err = NewThresholdSigner(ctx, []types.PrivValidator{signers[0], signers[0]}, 3, stateStore)
+	require.Error(t, err)
+
+	// The signers must hold the same key.
+	_, err = NewThresholdSigner(ctx, signers, 2, stateStore)
+	require.Error(t, err)
+}
+
+func TestThresholdSignerSignVote(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const chainID = "mychainid"
+	signers := newTestSigners(t, 3)
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	ts := newTestThresholdSigner(ctx, t, signers, 2, stateFile)
+	pubKey, err := ts.GetPubKey(ctx)
+	require.NoError(t, err)
+
+	randbytes := tmrand.Bytes(crypto.HashSize)
+	block1 := types.BlockID{Hash: randbytes,
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: randbytes}}
+	block2 := types.BlockID{Hash: tmrand.Bytes(crypto.HashSize),
+		PartSetHeader: types.PartSetHeader{Total: 10, Hash: randbytes}}
+
+	height, round := int64(10), int32(1)
+	vote := newVote(pubKey.Address(), 0, height, round, tmproto.PrecommitType, block1, []byte("extension"))
+	v := vote.ToProto()
+
+	// A signer that is down does not prevent reaching the threshold.
+	signers[2].down.Store(true)
+	require.NoError(t, ts.SignVote(ctx, chainID, v))
+	assert.True(t, pubKey.VerifySignature(types.VoteSignBytes(chainID, v), v.Signature))
+	assert.True(t, pubKey.VerifySignature(types.VoteExtensionSignBytes(chainID, v), v.ExtensionSignature))
+
+	// A vote that only differs by its timestamp gets the same signature.
+	sig := v.Signature
+	v.Timestamp = v.Timestamp.Add(time.Second)
+	require.NoError(t, ts.SignVote(ctx, chainID, v))
+	assert.Equal(t, sig, v.Signature)
+
+	// Regressions and conflicting votes are rejected.
+	for _, c := range []*types.Vote{
+		newVote(pubKey.Address(), 0, height, round-1, tmproto.PrecommitType, block1, nil),
+		newVote(pubKey.Address(), 0, height-1, round, tmproto.PrecommitType, block1, nil),
+		newVote(pubKey.Address(), 0, height, round, tmproto.PrevoteType, block1, nil),
+		newVote(pubKey.Address(), 0, height, round, tmproto.PrecommitType, block2, nil),
+	} {
+		assert.Error(t, ts.SignVote(ctx, chainID, c.ToProto()))
+	}
+
+	// The threshold cannot be reached while a majority is down.
+	signers[1].down.Store(true)
+	next := newVote(pubKey.Address(), 0, height+1, 0, tmproto.PrevoteType, block2, nil).ToProto()
+	assert.Error(t, ts.SignVote(ctx, chainID, next))
+
+	// The threshold is reached as soon as another signer is back.
+	signers[2].down.Store(false)
+	require.NoError(t, ts.SignVote(ctx, chainID, next))
+
+	// The high-water mark survives a restart.
+	ts = newTestThresholdSigner(ctx, t, signers, 2, stateFile)
+	signers[1].down.Store(false)
+	assert.Error(t, ts.SignVote(ctx, chainID, v))
+}
+
+func TestThresholdSignerSignProposal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const chainID = "mychainid"
+	signers := newTestSigners(t, 3)
+	ts := newTestThresholdSigner(ctx, t, signers, 2, filepath.Join(t.TempDir(), "state.json"))
+	pubKey, err := ts.GetPubKey(ctx)
+	require.NoError(t, err)
+
+	randbytes := tmrand.Bytes(crypto.HashSize)
+	block1 := types.BlockID{Hash: randbytes,
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: randbytes}}
+	block2 := types.BlockID{Hash: tmrand.Bytes(crypto.HashSize),
+		PartSetHeader: types.PartSetHeader{Total: 10, Hash: randbytes}}
+
+	height, round := int64(10), int32(1)
+	ts1 := time.Now()
+	p := newProposal(height, round, block1, ts1).ToProto()
+
+	// A signer that already signed a conflicting proposal refuses to sign,
+	// which does not prevent the others from reaching the threshold.
+	conflicting := newProposal(height, round, block2, ts1).ToProto()
+	require.NoError(t, signers[0].SignProposal(ctx, chainID, conflicting))
+	require.NoError(t, ts.SignProposal(ctx, chainID, p))
+	assert.True(t, pubKey.VerifySignature(types.ProposalSignBytes(chainID, p), p.Signature))
+
+	// Signing the same proposal again is fine, but not a conflicting one.
+	require.NoError(t, ts.SignProposal(ctx, chainID, p))
+	assert.Error(t, ts.SignProposal(ctx, chainID, newProposal(height, round, block2, ts1).ToProto()))
+	assert.Error(t, ts.SignProposal(ctx, chainID, newProposal(height, round-1, block1, ts1).ToProto()))
+}
@@ -36,6 +36,12 @@ func (m *Message) Wrap(pb proto.Message) error {
 	case *VoteSetBits:
 		m.Sum = &Message_VoteSetBits{VoteSetBits: msg}
 
+	case *TxRequest:
+		m.Sum = &Message_TxRequest{TxRequest: msg}
+
+	case *TxResponse:
+		m.Sum = &Message_TxResponse{TxResponse: msg}
+
 	default:
 		return fmt.Errorf("unknown message: %T", msg)
 	}
@@ -74,6 +80,12 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_VoteSetBits:
 		return m.GetVoteSetBits(), nil
 
+	case *Message_TxRequest:
+		return m.GetTxRequest(), nil
+
+	case *Message_TxResponse:
+		return m.GetTxResponse(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
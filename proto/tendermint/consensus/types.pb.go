@@ -612,6 +612,130 @@ func (m *VoteSetBits) GetVotes() bits.BitArray {
 	return bits.BitArray{}
 }
 
+// TxRequest is sent to ask a peer for transactions referenced by a key-only
+// proposal that are missing from the local mempool.
+type TxRequest struct {
+	Height int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32          `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	TxKeys []*types.TxKey `protobuf:"bytes,3,rep,name=tx_keys,json=txKeys,proto3" json:"tx_keys,omitempty"`
+}
+
+func (m *TxRequest) Reset()         { *m = TxRequest{} }
+func (m *TxRequest) String() string { return proto.CompactTextString(m) }
+func (*TxRequest) ProtoMessage()    {}
+func (*TxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{9}
+}
+func (m *TxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRequest.Merge(m, src)
+}
+func (m *TxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRequest proto.InternalMessageInfo
+
+func (m *TxRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxRequest) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TxRequest) GetTxKeys() []*types.TxKey {
+	if m != nil {
+		return m.TxKeys
+	}
+	return nil
+}
+
+// TxResponse carries the transactions a peer was able to find in response to
+// a TxRequest.
+type TxResponse struct {
+	Height int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Txs    [][]byte `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *TxResponse) Reset()         { *m = TxResponse{} }
+func (m *TxResponse) String() string { return proto.CompactTextString(m) }
+func (*TxResponse) ProtoMessage()    {}
+func (*TxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{10}
+}
+func (m *TxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxResponse.Merge(m, src)
+}
+func (m *TxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxResponse proto.InternalMessageInfo
+
+func (m *TxResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxResponse) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TxResponse) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Message_NewRoundStep
@@ -623,6 +747,8 @@ type Message struct {
 	//	*Message_HasVote
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
+	//	*Message_TxRequest
+	//	*Message_TxResponse
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -630,7 +756,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{9}
+	return fileDescriptor_81a22d2efc008981, []int{11}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,6 +818,12 @@ type Message_VoteSetMaj23 struct {
 type Message_VoteSetBits struct {
 	VoteSetBits *VoteSetBits `protobuf:"bytes,9,opt,name=vote_set_bits,json=voteSetBits,proto3,oneof" json:"vote_set_bits,omitempty"`
 }
+type Message_TxRequest struct {
+	TxRequest *TxRequest `protobuf:"bytes,10,opt,name=tx_request,json=txRequest,proto3,oneof" json:"tx_request,omitempty"`
+}
+type Message_TxResponse struct {
+	TxResponse *TxResponse `protobuf:"bytes,11,opt,name=tx_response,json=txResponse,proto3,oneof" json:"tx_response,omitempty"`
+}
 
 func (*Message_NewRoundStep) isMessage_Sum()  {}
 func (*Message_NewValidBlock) isMessage_Sum() {}
@@ -702,6 +834,8 @@ func (*Message_Vote) isMessage_Sum()          {}
 func (*Message_HasVote) isMessage_Sum()       {}
 func (*Message_VoteSetMaj23) isMessage_Sum()  {}
 func (*Message_VoteSetBits) isMessage_Sum()   {}
+func (*Message_TxRequest) isMessage_Sum()     {}
+func (*Message_TxResponse) isMessage_Sum()    {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -773,6 +907,20 @@ func (m *Message) GetVoteSetBits() *VoteSetBits {
 	return nil
 }
 
+func (m *Message) GetTxRequest() *TxRequest {
+	if x, ok := m.GetSum().(*Message_TxRequest); ok {
+		return x.TxRequest
+	}
+	return nil
+}
+
+func (m *Message) GetTxResponse() *TxResponse {
+	if x, ok := m.GetSum().(*Message_TxResponse); ok {
+		return x.TxResponse
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -785,6 +933,8 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_HasVote)(nil),
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
+		(*Message_TxRequest)(nil),
+		(*Message_TxResponse)(nil),
 	}
 }
 
@@ -798,67 +948,75 @@ func init() {
 	proto.RegisterType((*HasVote)(nil), "seitendermint.consensus.HasVote")
 	proto.RegisterType((*VoteSetMaj23)(nil), "seitendermint.consensus.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "seitendermint.consensus.VoteSetBits")
+	proto.RegisterType((*TxRequest)(nil), "seitendermint.consensus.TxRequest")
+	proto.RegisterType((*TxResponse)(nil), "seitendermint.consensus.TxResponse")
 	proto.RegisterType((*Message)(nil), "seitendermint.consensus.Message")
 }
 
 func init() { proto.RegisterFile("tendermint/consensus/types.proto", fileDescriptor_81a22d2efc008981) }
 
 var fileDescriptor_81a22d2efc008981 = []byte{
-	// 860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xc1, 0x6e, 0xf3, 0x44,
-	0x10, 0xb6, 0x69, 0xd2, 0x24, 0xe3, 0xa4, 0x85, 0xd5, 0xff, 0x43, 0x28, 0x25, 0x0d, 0x16, 0xa0,
-	0x0a, 0x51, 0x47, 0x4a, 0x05, 0x9c, 0xaa, 0x0a, 0x97, 0x83, 0x5b, 0x9a, 0x36, 0x72, 0xaa, 0x4a,
-	0xc0, 0xc1, 0x72, 0xe2, 0x55, 0xb2, 0x10, 0x7b, 0x2d, 0xef, 0x26, 0xa5, 0x0f, 0xc0, 0x1d, 0x9e,
-	0x80, 0xa7, 0xe0, 0xca, 0xb9, 0xc7, 0x1e, 0x39, 0x55, 0x28, 0x7d, 0x0c, 0x38, 0xa0, 0x5d, 0x6f,
-	0x12, 0xa7, 0x4a, 0x88, 0x72, 0x41, 0xe2, 0xb6, 0xeb, 0x99, 0xf9, 0x76, 0xf6, 0x9b, 0x99, 0xcf,
-	0x0b, 0x75, 0x8e, 0xa3, 0x00, 0x27, 0x21, 0x89, 0x78, 0xa3, 0x47, 0x23, 0x86, 0x23, 0x36, 0x62,
-	0x0d, 0x7e, 0x1f, 0x63, 0x66, 0xc5, 0x09, 0xe5, 0x14, 0xbd, 0xc3, 0x30, 0x99, 0x3b, 0x59, 0x33,
-	0xa7, 0xbd, 0x57, 0x7d, 0xda, 0xa7, 0xd2, 0xa7, 0x21, 0x56, 0xa9, 0xfb, 0xde, 0x7e, 0x06, 0x50,
-	0xc2, 0x64, 0xc1, 0xf6, 0xb2, 0xc7, 0x0d, 0x49, 0x97, 0x35, 0xba, 0x84, 0x2f, 0x78, 0x98, 0xbf,
-	0xe9, 0x50, 0xbe, 0xc2, 0x77, 0x2e, 0x1d, 0x45, 0x41, 0x87, 0xe3, 0x18, 0xbd, 0x0d, 0xdb, 0x03,
-	0x4c, 0xfa, 0x03, 0x5e, 0xd5, 0xeb, 0xfa, 0xe1, 0x96, 0xab, 0x76, 0xe8, 0x15, 0xe4, 0x13, 0xe1,
-	0x54, 0x7d, 0xa3, 0xae, 0x1f, 0xe6, 0xdd, 0x74, 0x83, 0x10, 0xe4, 0x18, 0xc7, 0x71, 0x75, 0xab,
-	0xae, 0x1f, 0x56, 0x5c, 0xb9, 0x46, 0x5f, 0x40, 0x95, 0xe1, 0x1e, 0x8d, 0x02, 0xe6, 0x31, 0x12,
-	0xf5, 0xb0, 0xc7, 0xb8, 0x9f, 0x70, 0x8f, 0x93, 0x10, 0x57, 0x73, 0x12, 0xf3, 0xb5, 0xb2, 0x77,
-	0x84, 0xb9, 0x23, 0xac, 0x37, 0x24, 0xc4, 0xe8, 0x13, 0x78, 0x6b, 0xe8, 0x33, 0xee, 0xf5, 0x68,
-	0x18, 0x12, 0xee, 0xa5, 0xc7, 0xe5, 0xe5, 0x71, 0xbb, 0xc2, 0x70, 0x26, 0xbf, 0xcb, 0x54, 0xcd,
-	0xbf, 0x74, 0xa8, 0x5c, 0xe1, 0xbb, 0x5b, 0x7f, 0x48, 0x02, 0x7b, 0x48, 0x7b, 0x3f, 0x6c, 0x98,
-	0xf8, 0x77, 0xf0, 0xba, 0x2b, 0xc2, 0xbc, 0x58, 0xe4, 0xc6, 0x30, 0xf7, 0x06, 0xd8, 0x0f, 0x70,
-	0x22, 0x6f, 0x62, 0x34, 0x4d, 0x6b, 0xb1, 0x0c, 0x29, 0x65, 0x6d, 0x3f, 0xe1, 0x1d, 0xcc, 0x1d,
-	0xe9, 0x69, 0xe7, 0x1e, 0x9e, 0x0e, 0x34, 0x17, 0x49, 0x98, 0x05, 0x0b, 0xb2, 0xc1, 0x98, 0x83,
-	0x33, 0x79, 0x69, 0xa3, 0xf9, 0xc1, 0x0b, 0x48, 0x51, 0x0f, 0x4b, 0xd4, 0xc3, 0xb2, 0x09, 0xff,
-	0x32, 0x49, 0xfc, 0x7b, 0x17, 0x66, 0x58, 0x0c, 0xbd, 0x07, 0x25, 0xc2, 0x14, 0x15, 0x92, 0x84,
-	0xa2, 0x5b, 0x24, 0x2c, 0xa5, 0xc0, 0xfc, 0x1a, 0x8a, 0xed, 0x84, 0xc6, 0x94, 0xf9, 0x43, 0x74,
-	0x0a, 0xc5, 0x58, 0xad, 0xe5, 0xcd, 0x8d, 0xe6, 0xfb, 0xcb, 0x93, 0x57, 0x4e, 0x2a, 0xef, 0x59,
-	0x90, 0xf9, 0xab, 0x0e, 0xc6, 0xd4, 0xd8, 0xbe, 0xbe, 0x5c, 0x49, 0xe4, 0xa7, 0x80, 0xa6, 0x31,
-	0x5e, 0x4c, 0x87, 0x5e, 0x96, 0xd5, 0x37, 0xa7, 0x96, 0x36, 0x1d, 0xca, 0x02, 0xa1, 0x0b, 0x28,
-	0x67, 0xbd, 0x15, 0xaf, 0xeb, 0x49, 0x50, 0xe9, 0x19, 0x19, 0x40, 0x33, 0x82, 0x92, 0x3d, 0x65,
-	0x66, 0xc3, 0x3a, 0x1f, 0x43, 0x4e, 0x14, 0x41, 0x1d, 0xff, 0xee, 0xca, 0xb2, 0xaa, 0x63, 0xa5,
-	0xb3, 0xf9, 0x19, 0xe4, 0x6e, 0x29, 0xc7, 0xe8, 0x08, 0x72, 0x63, 0xca, 0xb1, 0xa2, 0x75, 0x79,
-	0xb0, 0x70, 0x74, 0xa5, 0x9b, 0xf9, 0x93, 0x0e, 0x05, 0xc7, 0x67, 0x32, 0x74, 0xb3, 0x2c, 0x3f,
-	0x87, 0x9c, 0x40, 0x93, 0x59, 0xee, 0xac, 0x68, 0xbe, 0x0e, 0xe9, 0x47, 0x38, 0x68, 0xb1, 0xfe,
-	0xcd, 0x7d, 0x8c, 0x5d, 0xe9, 0x2f, 0xd0, 0x48, 0x14, 0xe0, 0x1f, 0x65, 0x8b, 0xe5, 0xdd, 0x74,
-	0x63, 0xfe, 0xae, 0x43, 0x59, 0x24, 0xd1, 0xc1, 0xbc, 0xe5, 0x7f, 0xdf, 0x3c, 0xfe, 0x8f, 0x92,
-	0x71, 0xa0, 0x98, 0x76, 0x3d, 0x09, 0x54, 0xcb, 0xef, 0x2f, 0x8d, 0x95, 0xa5, 0x3c, 0xff, 0xca,
-	0xde, 0x15, 0x8c, 0x4f, 0x9e, 0x0e, 0x0a, 0xea, 0x83, 0x5b, 0x90, 0xe1, 0xe7, 0x81, 0xf9, 0xb7,
-	0x0e, 0x86, 0xba, 0x80, 0x4d, 0x38, 0xfb, 0xbf, 0xe5, 0x8f, 0x4e, 0x20, 0x2f, 0x1a, 0x82, 0xc9,
-	0xb9, 0xdd, 0xa0, 0xe9, 0xd3, 0x28, 0xf3, 0x97, 0x3c, 0x14, 0x5a, 0x98, 0x31, 0xbf, 0x8f, 0x51,
-	0x0b, 0x76, 0x22, 0x7c, 0x97, 0xce, 0x9a, 0x27, 0xa5, 0x36, 0x6d, 0xc6, 0x8f, 0xac, 0x15, 0xff,
-	0x09, 0x2b, 0xab, 0xe6, 0x8e, 0xe6, 0x96, 0xa3, 0xac, 0xba, 0xb7, 0x61, 0x57, 0xc0, 0x8d, 0x85,
-	0x6c, 0x7a, 0x32, 0x5d, 0xc9, 0x9d, 0xd1, 0xfc, 0xf8, 0xdf, 0xf0, 0xe6, 0x2a, 0xeb, 0x68, 0x6e,
-	0x25, 0x5a, 0x90, 0xdd, 0xac, 0xfc, 0x2c, 0x9f, 0xf1, 0x39, 0xd4, 0x54, 0x65, 0x9c, 0x8c, 0xfc,
-	0xa0, 0xf3, 0x17, 0x42, 0x91, 0x52, 0xff, 0xe1, 0x5a, 0x90, 0xf6, 0xf5, 0xa5, 0xb3, 0xa8, 0x13,
-	0xe8, 0x0c, 0x60, 0xae, 0xbb, 0x8a, 0x7c, 0x73, 0x25, 0xd0, 0x4c, 0x52, 0x1c, 0xcd, 0x2d, 0xcd,
-	0x94, 0x57, 0x28, 0x86, 0x1c, 0xfa, 0xed, 0xa5, 0x5a, 0x3a, 0x0f, 0x17, 0x0d, 0xea, 0x68, 0xe9,
-	0xe8, 0xa3, 0x13, 0x28, 0x0e, 0x7c, 0xe6, 0xc9, 0xc0, 0x82, 0x0c, 0xac, 0xaf, 0x0c, 0x54, 0x12,
-	0xe1, 0x68, 0x6e, 0x61, 0xa0, 0xd4, 0xa2, 0x05, 0x3b, 0x22, 0x54, 0xfe, 0x87, 0x42, 0x31, 0xb2,
-	0xd5, 0xe2, 0x9a, 0x2a, 0x67, 0xe7, 0x5b, 0x54, 0x79, 0x9c, 0x9d, 0xf7, 0x0b, 0xa8, 0xcc, 0xe0,
-	0x44, 0x9f, 0x55, 0x4b, 0x6b, 0x38, 0xcd, 0x0c, 0x9b, 0xe0, 0x74, 0x3c, 0xdf, 0xda, 0x79, 0xd8,
-	0x62, 0xa3, 0xd0, 0xfe, 0xe6, 0x61, 0x52, 0xd3, 0x1f, 0x27, 0x35, 0xfd, 0xcf, 0x49, 0x4d, 0xff,
-	0xf9, 0xb9, 0xa6, 0x3d, 0x3e, 0xd7, 0xb4, 0x3f, 0x9e, 0x6b, 0xda, 0xb7, 0xa7, 0x7d, 0xc2, 0x07,
-	0xa3, 0xae, 0xd5, 0xa3, 0x61, 0xc3, 0x4f, 0xc8, 0x91, 0x1f, 0xf5, 0x06, 0x34, 0x69, 0x30, 0x4c,
-	0x8e, 0x32, 0xaf, 0x8f, 0xf4, 0xe1, 0xb2, 0xec, 0xf5, 0xd3, 0xdd, 0x96, 0xb6, 0xe3, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xca, 0x8b, 0x13, 0xe8, 0x1c, 0x09, 0x00, 0x00,
+	// 956 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xdf, 0xc5, 0x7f, 0xf3, 0xd6, 0x69, 0xca, 0xa8, 0x85, 0x25, 0x14, 0xd7, 0x2c, 0x7f, 0x14,
+	0x21, 0x62, 0x4b, 0x8e, 0x80, 0x53, 0x55, 0xb1, 0x45, 0x68, 0xd3, 0x26, 0xad, 0x35, 0x8e, 0x2a,
+	0x01, 0x87, 0xd5, 0xda, 0x3b, 0xb2, 0x87, 0xda, 0x3b, 0xcb, 0xce, 0x38, 0xb1, 0x3f, 0x00, 0x77,
+	0x3e, 0x01, 0x7c, 0x0a, 0xae, 0x9c, 0x7b, 0xec, 0x91, 0x53, 0x85, 0x92, 0x8f, 0x01, 0x07, 0x34,
+	0x33, 0xeb, 0xf5, 0x3a, 0xb2, 0x63, 0x7c, 0x41, 0xea, 0xc9, 0x33, 0x7e, 0xef, 0xfd, 0xe6, 0xcd,
+	0xef, 0xbd, 0xf9, 0xbd, 0x85, 0x86, 0x20, 0x51, 0x48, 0x92, 0x31, 0x8d, 0x44, 0xab, 0xcf, 0x22,
+	0x4e, 0x22, 0x3e, 0xe1, 0x2d, 0x31, 0x8b, 0x09, 0x6f, 0xc6, 0x09, 0x13, 0x0c, 0xbd, 0xcb, 0x09,
+	0x5d, 0x38, 0x35, 0x33, 0xa7, 0xfd, 0x3b, 0x03, 0x36, 0x60, 0xca, 0xa7, 0x25, 0x57, 0xda, 0x7d,
+	0xff, 0x5e, 0x0e, 0x50, 0xc1, 0xe4, 0xc1, 0xf6, 0xf3, 0xc7, 0x8d, 0x68, 0x8f, 0xb7, 0x7a, 0x54,
+	0x2c, 0x79, 0x38, 0xbf, 0x9b, 0x50, 0x7b, 0x4a, 0x2e, 0x30, 0x9b, 0x44, 0x61, 0x57, 0x90, 0x18,
+	0xbd, 0x03, 0xe5, 0x21, 0xa1, 0x83, 0xa1, 0xb0, 0xcd, 0x86, 0x79, 0x50, 0xc0, 0xe9, 0x0e, 0xdd,
+	0x81, 0x52, 0x22, 0x9d, 0xec, 0xb7, 0x1a, 0xe6, 0x41, 0x09, 0xeb, 0x0d, 0x42, 0x50, 0xe4, 0x82,
+	0xc4, 0x76, 0xa1, 0x61, 0x1e, 0xec, 0x62, 0xb5, 0x46, 0x5f, 0x81, 0xcd, 0x49, 0x9f, 0x45, 0x21,
+	0xf7, 0x39, 0x8d, 0xfa, 0xc4, 0xe7, 0x22, 0x48, 0x84, 0x2f, 0xe8, 0x98, 0xd8, 0x45, 0x85, 0x79,
+	0x37, 0xb5, 0x77, 0xa5, 0xb9, 0x2b, 0xad, 0x67, 0x74, 0x4c, 0xd0, 0x67, 0xf0, 0xf6, 0x28, 0xe0,
+	0xc2, 0xef, 0xb3, 0xf1, 0x98, 0x0a, 0x5f, 0x1f, 0x57, 0x52, 0xc7, 0xed, 0x49, 0xc3, 0x23, 0xf5,
+	0xbf, 0x4a, 0xd5, 0xf9, 0xdb, 0x84, 0xdd, 0xa7, 0xe4, 0xe2, 0x79, 0x30, 0xa2, 0xa1, 0x3b, 0x62,
+	0xfd, 0x17, 0x5b, 0x26, 0xfe, 0x03, 0xdc, 0xed, 0xc9, 0x30, 0x3f, 0x96, 0xb9, 0x71, 0x22, 0xfc,
+	0x21, 0x09, 0x42, 0x92, 0xa8, 0x9b, 0x58, 0x6d, 0xa7, 0xb9, 0x5c, 0x06, 0x4d, 0x59, 0x27, 0x48,
+	0x44, 0x97, 0x08, 0x4f, 0x79, 0xba, 0xc5, 0x97, 0xaf, 0xef, 0x1b, 0x18, 0x29, 0x98, 0x25, 0x0b,
+	0x72, 0xc1, 0x5a, 0x80, 0x73, 0x75, 0x69, 0xab, 0xfd, 0xe1, 0x35, 0x48, 0x59, 0x8f, 0xa6, 0xac,
+	0x47, 0xd3, 0xa5, 0xe2, 0xeb, 0x24, 0x09, 0x66, 0x18, 0x32, 0x2c, 0x8e, 0xde, 0x87, 0x1d, 0xca,
+	0x53, 0x2a, 0x14, 0x09, 0x55, 0x5c, 0xa5, 0x5c, 0x53, 0xe0, 0x3c, 0x81, 0x6a, 0x27, 0x61, 0x31,
+	0xe3, 0xc1, 0x08, 0x3d, 0x84, 0x6a, 0x9c, 0xae, 0xd5, 0xcd, 0xad, 0xf6, 0x07, 0xab, 0x93, 0x4f,
+	0x9d, 0xd2, 0xbc, 0xb3, 0x20, 0xe7, 0x37, 0x13, 0xac, 0xb9, 0xb1, 0xf3, 0xec, 0x64, 0x2d, 0x91,
+	0x9f, 0x03, 0x9a, 0xc7, 0xf8, 0x31, 0x1b, 0xf9, 0x79, 0x56, 0x6f, 0xcf, 0x2d, 0x1d, 0x36, 0x52,
+	0x05, 0x42, 0x8f, 0xa1, 0x96, 0xf7, 0xb6, 0x0b, 0xff, 0x91, 0x84, 0x34, 0x3d, 0x2b, 0x07, 0xe8,
+	0x44, 0xb0, 0xe3, 0xce, 0x99, 0xd9, 0xb2, 0xce, 0x47, 0x50, 0x94, 0x45, 0x48, 0x8f, 0x7f, 0x6f,
+	0x6d, 0x59, 0xd3, 0x63, 0x95, 0xb3, 0xf3, 0x05, 0x14, 0x9f, 0x33, 0x41, 0xd0, 0x21, 0x14, 0xcf,
+	0x99, 0x20, 0xb6, 0x79, 0x43, 0xb0, 0x74, 0xc4, 0xca, 0xcd, 0xf9, 0xd9, 0x84, 0x8a, 0x17, 0x70,
+	0x15, 0xba, 0x5d, 0x96, 0x5f, 0x42, 0x51, 0xa2, 0xa9, 0x2c, 0x6f, 0xad, 0x69, 0xbe, 0x2e, 0x1d,
+	0x44, 0x24, 0x3c, 0xe5, 0x83, 0xb3, 0x59, 0x4c, 0xb0, 0xf2, 0x97, 0x68, 0x34, 0x0a, 0xc9, 0x54,
+	0xb5, 0x58, 0x09, 0xeb, 0x8d, 0xf3, 0x87, 0x09, 0x35, 0x99, 0x44, 0x97, 0x88, 0xd3, 0xe0, 0xc7,
+	0xf6, 0xd1, 0xff, 0x94, 0x8c, 0x07, 0x55, 0xdd, 0xf5, 0x34, 0x4c, 0x5b, 0xfe, 0xde, 0xca, 0x58,
+	0x55, 0xca, 0xe3, 0x6f, 0xdc, 0x3d, 0xc9, 0xf8, 0xe5, 0xeb, 0xfb, 0x95, 0xf4, 0x0f, 0x5c, 0x51,
+	0xe1, 0xc7, 0xa1, 0xf3, 0x8f, 0x09, 0x56, 0x7a, 0x01, 0x97, 0x0a, 0xfe, 0xa6, 0xe5, 0x8f, 0x1e,
+	0x40, 0x49, 0x36, 0x04, 0xb7, 0x4b, 0xdb, 0x35, 0xbd, 0x8e, 0x92, 0xed, 0x7e, 0x36, 0xc5, 0xe4,
+	0xa7, 0x09, 0xe1, 0xdb, 0xb7, 0x7b, 0x45, 0x4c, 0xfd, 0x17, 0x64, 0xc6, 0xed, 0x42, 0xa3, 0x70,
+	0x60, 0xb5, 0xf7, 0x57, 0x5e, 0xe1, 0x6c, 0xfa, 0x84, 0xcc, 0x70, 0x59, 0xc8, 0x1f, 0xee, 0x9c,
+	0x00, 0xc8, 0xf3, 0x78, 0xcc, 0x22, 0xbe, 0x6d, 0xe7, 0xde, 0x86, 0x82, 0x98, 0xea, 0xc3, 0x6a,
+	0x58, 0x2e, 0x9d, 0x5f, 0xcb, 0x50, 0x39, 0x25, 0x9c, 0x07, 0x03, 0x82, 0x4e, 0xe1, 0x56, 0x44,
+	0x2e, 0xb4, 0x52, 0xf8, 0x6a, 0x50, 0xe8, 0xa7, 0xf4, 0x49, 0x73, 0xcd, 0x94, 0x6b, 0xe6, 0x67,
+	0x91, 0x67, 0xe0, 0x5a, 0x94, 0xdb, 0xa3, 0x0e, 0xec, 0x49, 0xb8, 0x73, 0x29, 0xfa, 0xbe, 0x22,
+	0x5b, 0x25, 0x63, 0xb5, 0x3f, 0xbd, 0x09, 0x6f, 0x31, 0x23, 0x3c, 0x03, 0xef, 0x46, 0xf9, 0x3f,
+	0x96, 0xc4, 0x73, 0xb5, 0x42, 0x2d, 0xa0, 0xe6, 0x1a, 0xe9, 0xe5, 0xc4, 0x13, 0x1d, 0x5f, 0x93,
+	0x39, 0xdd, 0x38, 0x1f, 0x6f, 0x04, 0xe9, 0x3c, 0x3b, 0xf1, 0x96, 0x55, 0x0e, 0x3d, 0x02, 0x58,
+	0x4c, 0x8d, 0xb4, 0x75, 0x9c, 0xb5, 0x40, 0x99, 0x20, 0x7a, 0x06, 0xde, 0xc9, 0xe6, 0x86, 0xd4,
+	0x3b, 0x25, 0x59, 0xe5, 0x95, 0x93, 0x60, 0x11, 0x2e, 0x9f, 0x97, 0x67, 0x68, 0xe1, 0x42, 0x0f,
+	0xa0, 0x3a, 0x0c, 0xb8, 0xaf, 0x02, 0x2b, 0x2a, 0xb0, 0xb1, 0x36, 0x30, 0x15, 0x38, 0xcf, 0xc0,
+	0x95, 0xa1, 0x5e, 0xca, 0x2a, 0xcb, 0x50, 0x35, 0x45, 0xc7, 0x52, 0x70, 0xec, 0xea, 0x86, 0x2a,
+	0xe7, 0xd5, 0x49, 0x56, 0xf9, 0x3c, 0xb7, 0x47, 0x8f, 0x61, 0x37, 0x83, 0x93, 0xaf, 0xc4, 0xde,
+	0xd9, 0xc0, 0x69, 0x4e, 0x2a, 0x24, 0xa7, 0xe7, 0x8b, 0xad, 0xe4, 0x54, 0x4c, 0xfd, 0x44, 0xbf,
+	0x25, 0x1b, 0x36, 0x70, 0x9a, 0xbd, 0x3a, 0xc9, 0xa9, 0x98, 0x6f, 0xd0, 0xb7, 0x60, 0x29, 0x10,
+	0xfd, 0x40, 0x6c, 0x4b, 0xa1, 0x7c, 0x74, 0x23, 0x8a, 0x76, 0xf5, 0x0c, 0x0c, 0x22, 0xdb, 0xb9,
+	0x25, 0x28, 0xf0, 0xc9, 0xd8, 0xfd, 0xee, 0xe5, 0x65, 0xdd, 0x7c, 0x75, 0x59, 0x37, 0xff, 0xba,
+	0xac, 0x9b, 0xbf, 0x5c, 0xd5, 0x8d, 0x57, 0x57, 0x75, 0xe3, 0xcf, 0xab, 0xba, 0xf1, 0xfd, 0xc3,
+	0x01, 0x15, 0xc3, 0x49, 0xaf, 0xd9, 0x67, 0xe3, 0x56, 0x90, 0xd0, 0xc3, 0x20, 0xea, 0x0f, 0x59,
+	0xd2, 0xe2, 0x84, 0x1e, 0xe6, 0x3e, 0xe4, 0xf4, 0x37, 0xe0, 0xaa, 0x0f, 0xc9, 0x5e, 0x59, 0xd9,
+	0x8e, 0xfe, 0x1d, 0x00, 0x32, 0x16, 0x6e, 0x16, 0x67, 0x0a, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1277,6 +1435,95 @@ func (m *VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxKeys) > 0 {
+		for iNdEx := len(m.TxKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1498,6 +1745,48 @@ func (m *Message_VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_TxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TxRequest != nil {
+		{
+			size, err := m.TxRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_TxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_TxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1678,6 +1967,48 @@ func (m *VoteSetBits) Size() (n int) {
 	return n
 }
 
+func (m *TxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.TxKeys) > 0 {
+		for _, e := range m.TxKeys {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1798,6 +2129,30 @@ func (m *Message_VoteSetBits) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_TxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxRequest != nil {
+		l = m.TxRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_TxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2977,7 +3332,7 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *TxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3000,17 +3355,17 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRoundStep", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3020,20 +3375,262 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxKeys = append(m.TxKeys, &types.TxKey{})
+			if err := m.TxKeys[len(m.TxKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoundStep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
 			v := &NewRoundStep{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
@@ -3321,6 +3918,76 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_VoteSetBits{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TxRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_TxRequest{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TxResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_TxResponse{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
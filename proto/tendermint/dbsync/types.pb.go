@@ -228,6 +228,10 @@ type MetadataResponse struct {
 	Hash        []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	Filenames   []string `protobuf:"bytes,3,rep,name=filenames,proto3" json:"filenames,omitempty"`
 	Md5Checksum [][]byte `protobuf:"bytes,4,rep,name=md5checksum,proto3" json:"md5checksum,omitempty"`
+	// chunk_size is the size in bytes of every chunk except the last one of
+	// each file.
+	ChunkSize uint64          `protobuf:"varint,5,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	Files     []*FileMetadata `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
 }
 
 func (m *MetadataResponse) Reset()         { *m = MetadataResponse{} }
@@ -291,16 +295,96 @@ func (m *MetadataResponse) GetMd5Checksum() [][]byte {
 	return nil
 }
 
+func (m *MetadataResponse) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *MetadataResponse) GetFiles() []*FileMetadata {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+// FileMetadata describes how a snapshot file is split into chunks.
+type FileMetadata struct {
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Size_    uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// chunk_hashes holds the SHA-256 hash of each chunk, in offset order.
+	ChunkHashes [][]byte `protobuf:"bytes,3,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+}
+
+func (m *FileMetadata) Reset()         { *m = FileMetadata{} }
+func (m *FileMetadata) String() string { return proto.CompactTextString(m) }
+func (*FileMetadata) ProtoMessage()    {}
+func (*FileMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab44cdc90da47cb, []int{3}
+}
+func (m *FileMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FileMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileMetadata.Merge(m, src)
+}
+func (m *FileMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileMetadata proto.InternalMessageInfo
+
+func (m *FileMetadata) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *FileMetadata) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *FileMetadata) GetChunkHashes() [][]byte {
+	if m != nil {
+		return m.ChunkHashes
+	}
+	return nil
+}
+
+// FileRequest requests length bytes of a file starting at offset. A zero
+// length requests the whole file.
 type FileRequest struct {
 	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Offset   uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length   uint64 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
 }
 
 func (m *FileRequest) Reset()         { *m = FileRequest{} }
 func (m *FileRequest) String() string { return proto.CompactTextString(m) }
 func (*FileRequest) ProtoMessage()    {}
 func (*FileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab44cdc90da47cb, []int{3}
+	return fileDescriptor_aab44cdc90da47cb, []int{4}
 }
 func (m *FileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,17 +427,32 @@ func (m *FileRequest) GetFilename() string {
 	return ""
 }
 
+func (m *FileRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *FileRequest) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
 type FileResponse struct {
 	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Offset   uint64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
 func (m *FileResponse) Reset()         { *m = FileResponse{} }
 func (m *FileResponse) String() string { return proto.CompactTextString(m) }
 func (*FileResponse) ProtoMessage()    {}
 func (*FileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab44cdc90da47cb, []int{4}
+	return fileDescriptor_aab44cdc90da47cb, []int{5}
 }
 func (m *FileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,6 +502,13 @@ func (m *FileResponse) GetData() []byte {
 	return nil
 }
 
+func (m *FileResponse) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 type LightBlockRequest struct {
 	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
@@ -411,7 +517,7 @@ func (m *LightBlockRequest) Reset()         { *m = LightBlockRequest{} }
 func (m *LightBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*LightBlockRequest) ProtoMessage()    {}
 func (*LightBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab44cdc90da47cb, []int{5}
+	return fileDescriptor_aab44cdc90da47cb, []int{6}
 }
 func (m *LightBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,7 +561,7 @@ func (m *LightBlockResponse) Reset()         { *m = LightBlockResponse{} }
 func (m *LightBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*LightBlockResponse) ProtoMessage()    {}
 func (*LightBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab44cdc90da47cb, []int{6}
+	return fileDescriptor_aab44cdc90da47cb, []int{7}
 }
 func (m *LightBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +605,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab44cdc90da47cb, []int{7}
+	return fileDescriptor_aab44cdc90da47cb, []int{8}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +650,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab44cdc90da47cb, []int{8}
+	return fileDescriptor_aab44cdc90da47cb, []int{9}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,6 +697,7 @@ func init() {
 	proto.RegisterType((*Message)(nil), "seitendermint.dbsync.Message")
 	proto.RegisterType((*MetadataRequest)(nil), "seitendermint.dbsync.MetadataRequest")
 	proto.RegisterType((*MetadataResponse)(nil), "seitendermint.dbsync.MetadataResponse")
+	proto.RegisterType((*FileMetadata)(nil), "seitendermint.dbsync.FileMetadata")
 	proto.RegisterType((*FileRequest)(nil), "seitendermint.dbsync.FileRequest")
 	proto.RegisterType((*FileResponse)(nil), "seitendermint.dbsync.FileResponse")
 	proto.RegisterType((*LightBlockRequest)(nil), "seitendermint.dbsync.LightBlockRequest")
@@ -602,45 +709,51 @@ func init() {
 func init() { proto.RegisterFile("tendermint/dbsync/types.proto", fileDescriptor_aab44cdc90da47cb) }
 
 var fileDescriptor_aab44cdc90da47cb = []byte{
-	// 593 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcb, 0x6a, 0xdb, 0x40,
-	0x18, 0x85, 0xe5, 0xfa, 0x92, 0xf8, 0xb7, 0x7c, 0x9b, 0x9a, 0x62, 0x4c, 0xa2, 0xb8, 0x6a, 0xda,
-	0x18, 0x4a, 0x6c, 0x68, 0xe9, 0xae, 0x8b, 0xd6, 0x85, 0x90, 0x42, 0x42, 0xcb, 0x40, 0x0c, 0x2d,
-	0x05, 0x23, 0xcb, 0x63, 0x4b, 0x44, 0x23, 0xa9, 0x1a, 0x79, 0x91, 0x4d, 0xfb, 0x0a, 0x7d, 0x8a,
-	0x3e, 0x4b, 0x96, 0x59, 0x76, 0x55, 0x8a, 0xfd, 0x22, 0x45, 0x23, 0x45, 0x1a, 0xcb, 0x72, 0x02,
-	0xd9, 0xcd, 0x9c, 0x39, 0xfa, 0x74, 0x24, 0x1d, 0xfd, 0xb0, 0xef, 0x13, 0x7b, 0x4a, 0x3c, 0x6a,
-	0xda, 0xfe, 0x60, 0x3a, 0x61, 0x57, 0xb6, 0x3e, 0xf0, 0xaf, 0x5c, 0xc2, 0xfa, 0xae, 0xe7, 0xf8,
-	0x0e, 0x6a, 0x31, 0x62, 0x26, 0x8e, 0x7e, 0xe8, 0xe8, 0xb4, 0xe6, 0xce, 0xdc, 0xe1, 0x86, 0x41,
-	0xb0, 0x0a, 0xbd, 0x9d, 0x3d, 0x01, 0xc5, 0x19, 0x22, 0xa9, 0xb3, 0xbf, 0x71, 0xea, 0x6a, 0x9e,
-	0x46, 0xa3, 0x63, 0xf5, 0x77, 0x11, 0x76, 0xce, 0x09, 0x63, 0xda, 0x9c, 0x20, 0x0c, 0x0d, 0x4a,
-	0x7c, 0x6d, 0xaa, 0xf9, 0xda, 0xd8, 0x23, 0xdf, 0x17, 0x84, 0xf9, 0xed, 0x5c, 0x37, 0xd7, 0xab,
-	0xbc, 0x7a, 0xde, 0xcf, 0xca, 0xd3, 0x3f, 0x8f, 0xdc, 0x38, 0x34, 0x9f, 0x4a, 0xb8, 0x4e, 0xd7,
-	0x25, 0x74, 0x01, 0x4d, 0x81, 0xc9, 0x5c, 0xc7, 0x66, 0xa4, 0xfd, 0x88, 0x43, 0x5f, 0xdc, 0x07,
-	0x0d, 0xdd, 0xa7, 0x12, 0x6e, 0xd0, 0x94, 0x86, 0x4e, 0x40, 0x9e, 0x99, 0x16, 0x89, 0x63, 0xe6,
-	0x39, 0xf1, 0x69, 0x36, 0xf1, 0xc4, 0xb4, 0x48, 0x12, 0xb1, 0x32, 0x4b, 0xb6, 0xe8, 0x23, 0x54,
-	0x23, 0x4e, 0x14, 0xad, 0xc0, 0x41, 0xea, 0x5d, 0xa0, 0x38, 0x96, 0x3c, 0x13, 0xf6, 0xe8, 0x0b,
-	0x3c, 0xb6, 0xcc, 0xb9, 0xe1, 0x8f, 0x27, 0x96, 0xa3, 0x5f, 0xc6, 0xc9, 0x8a, 0x1c, 0x78, 0x94,
-	0x0d, 0x3c, 0x0b, 0x2e, 0x18, 0x06, 0xfe, 0x24, 0x5f, 0xd3, 0x4a, 0x8b, 0xe8, 0x1b, 0xb4, 0xd6,
-	0xd1, 0x51, 0xd8, 0x12, 0x67, 0xf7, 0xee, 0x67, 0xc7, 0x91, 0x91, 0xb5, 0xa1, 0xa2, 0x33, 0xa8,
-	0x85, 0x95, 0x88, 0x33, 0xef, 0x70, 0xee, 0xb3, 0x6c, 0xee, 0x67, 0xee, 0x4d, 0xf2, 0x56, 0x5d,
-	0x51, 0x40, 0x9f, 0xa0, 0x1e, 0xd3, 0xa2, 0x98, 0xbb, 0x1c, 0x77, 0x78, 0x37, 0x2e, 0x8e, 0x58,
-	0x73, 0xd7, 0x94, 0x61, 0x11, 0xf2, 0x6c, 0x41, 0xd5, 0x26, 0xd4, 0x53, 0x75, 0x53, 0x7f, 0x40,
-	0x23, 0x5d, 0x16, 0xf4, 0x04, 0x4a, 0x06, 0x09, 0x9e, 0x91, 0x37, 0xb7, 0x80, 0xa3, 0x1d, 0x42,
-	0x50, 0x30, 0x34, 0x66, 0xf0, 0xea, 0xc9, 0x98, 0xaf, 0xd1, 0x1e, 0x94, 0x83, 0x2f, 0x68, 0x6b,
-	0x94, 0xb0, 0x76, 0xbe, 0x9b, 0xef, 0x95, 0x71, 0x22, 0xa0, 0x2e, 0x54, 0xe8, 0xf4, 0x8d, 0x6e,
-	0x10, 0xfd, 0x92, 0x2d, 0x68, 0xbb, 0xd0, 0xcd, 0xf7, 0x64, 0x2c, 0x4a, 0xea, 0x7b, 0xa8, 0x08,
-	0xd5, 0xda, 0x7a, 0xeb, 0x0e, 0xec, 0xde, 0x52, 0xf9, 0xed, 0xcb, 0x38, 0xde, 0xab, 0x23, 0x90,
-	0xc5, 0x52, 0x3d, 0x84, 0x11, 0x3c, 0x5a, 0xf0, 0x0a, 0xf8, 0x3f, 0x20, 0x63, 0xbe, 0x56, 0x5f,
-	0x42, 0x73, 0xa3, 0x5b, 0xdb, 0xe0, 0xea, 0x08, 0xd0, 0x66, 0x59, 0xd0, 0x3b, 0xa8, 0x08, 0xa5,
-	0x8b, 0x06, 0xc1, 0x41, 0xea, 0x23, 0x86, 0x93, 0x46, 0xb8, 0x1a, 0x92, 0x82, 0xa9, 0x47, 0x50,
-	0x5d, 0x2b, 0xcb, 0xd6, 0x00, 0x3f, 0xa1, 0xb6, 0x5e, 0x83, 0xad, 0xef, 0xe1, 0x02, 0x1a, 0x7a,
-	0x60, 0xb0, 0xd9, 0x82, 0x8d, 0xc3, 0xa2, 0x44, 0xd3, 0xe4, 0x30, 0x33, 0xd9, 0x87, 0x5b, 0x73,
-	0xc8, 0x1f, 0x16, 0xae, 0xff, 0x1e, 0x48, 0xb8, 0xae, 0xa7, 0xe4, 0xd1, 0xf5, 0x52, 0xc9, 0xdd,
-	0x2c, 0x95, 0xdc, 0xbf, 0xa5, 0x92, 0xfb, 0xb5, 0x52, 0xa4, 0x9b, 0x95, 0x22, 0xfd, 0x59, 0x29,
-	0xd2, 0xd7, 0xb7, 0x73, 0xd3, 0x37, 0x16, 0x93, 0xbe, 0xee, 0xd0, 0x81, 0xe6, 0x99, 0xc7, 0x9a,
-	0xad, 0x1b, 0x8e, 0x37, 0x60, 0xc4, 0x3c, 0x16, 0x06, 0x6b, 0x38, 0x93, 0x37, 0x46, 0xfa, 0xa4,
-	0xc4, 0x0f, 0x5e, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xb8, 0x52, 0x22, 0xee, 0x05, 0x00,
-	0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xc7, 0xfd, 0x21, 0x3b, 0xf1, 0x58, 0x89, 0xed, 0x6d, 0x08, 0xc6, 0x24, 0x8e, 0xa3, 0x7e,
+	0xc4, 0x50, 0x62, 0x43, 0x4b, 0xa1, 0x87, 0x1e, 0x4a, 0x0a, 0x21, 0x85, 0x84, 0x96, 0x2d, 0x09,
+	0xb4, 0x14, 0x8c, 0x2c, 0xaf, 0x2d, 0x11, 0x7d, 0x38, 0x5e, 0xe9, 0x90, 0x5c, 0xfa, 0x0a, 0x7d,
+	0x8a, 0x3e, 0x4b, 0x8e, 0xb9, 0xb5, 0xa7, 0x52, 0x92, 0x17, 0x29, 0x9a, 0x55, 0xa4, 0xb5, 0xfc,
+	0x75, 0xdb, 0xfd, 0x6b, 0xf4, 0x9b, 0xff, 0x68, 0x67, 0xb4, 0xb0, 0xeb, 0x33, 0x77, 0xc0, 0x26,
+	0x8e, 0xe5, 0xfa, 0xdd, 0x41, 0x9f, 0x5f, 0xbb, 0x46, 0xd7, 0xbf, 0x1e, 0x33, 0xde, 0x19, 0x4f,
+	0x3c, 0xdf, 0x23, 0x5b, 0x9c, 0x59, 0x49, 0x44, 0x47, 0x44, 0x34, 0xb6, 0x46, 0xde, 0xc8, 0xc3,
+	0x80, 0x6e, 0xb8, 0x12, 0xb1, 0x8d, 0x1d, 0x09, 0x85, 0x0c, 0x99, 0xd4, 0xd8, 0x9d, 0x79, 0x3a,
+	0xd6, 0x27, 0xba, 0x13, 0x3d, 0xd6, 0x7e, 0x15, 0x60, 0xed, 0x8c, 0x71, 0xae, 0x8f, 0x18, 0xa1,
+	0x50, 0x75, 0x98, 0xaf, 0x0f, 0x74, 0x5f, 0xef, 0x4d, 0xd8, 0x55, 0xc0, 0xb8, 0x5f, 0xcf, 0xb6,
+	0xb2, 0xed, 0xf2, 0xab, 0xe7, 0x9d, 0x79, 0x7e, 0x3a, 0x67, 0x51, 0x34, 0x15, 0xc1, 0x27, 0x19,
+	0x5a, 0x71, 0xa6, 0x25, 0x72, 0x0e, 0x35, 0x89, 0xc9, 0xc7, 0x9e, 0xcb, 0x59, 0x3d, 0x87, 0xd0,
+	0x17, 0xab, 0xa0, 0x22, 0xfa, 0x24, 0x43, 0xab, 0x4e, 0x4a, 0x23, 0xc7, 0xa0, 0x0e, 0x2d, 0x9b,
+	0xc5, 0x36, 0xf3, 0x48, 0xdc, 0x9f, 0x4f, 0x3c, 0xb6, 0x6c, 0x96, 0x58, 0x2c, 0x0f, 0x93, 0x2d,
+	0xf9, 0x08, 0x1b, 0x11, 0x27, 0xb2, 0xa6, 0x20, 0x48, 0x5b, 0x06, 0x8a, 0x6d, 0xa9, 0x43, 0x69,
+	0x4f, 0xbe, 0xc2, 0x13, 0xdb, 0x1a, 0x99, 0x7e, 0xaf, 0x6f, 0x7b, 0xc6, 0x65, 0xec, 0xac, 0x80,
+	0xc0, 0x83, 0xf9, 0xc0, 0xd3, 0xf0, 0x85, 0xa3, 0x30, 0x3e, 0xf1, 0x57, 0xb3, 0xd3, 0x22, 0xf9,
+	0x0e, 0x5b, 0xd3, 0xe8, 0xc8, 0x6c, 0x11, 0xd9, 0xed, 0xd5, 0xec, 0xd8, 0x32, 0xb1, 0x67, 0x54,
+	0x72, 0x0a, 0x9b, 0xa2, 0x25, 0x62, 0xcf, 0x6b, 0xc8, 0x7d, 0x3a, 0x9f, 0xfb, 0x19, 0x63, 0x13,
+	0xbf, 0x1b, 0x63, 0x59, 0x20, 0x9f, 0xa0, 0x12, 0xd3, 0x22, 0x9b, 0xeb, 0x88, 0x7b, 0xb6, 0x1c,
+	0x17, 0x5b, 0xdc, 0x1c, 0x4f, 0x29, 0x47, 0x05, 0xc8, 0xf3, 0xc0, 0xd1, 0x6a, 0x50, 0x49, 0xb5,
+	0x9b, 0xf6, 0x3b, 0x0b, 0xd5, 0x74, 0xb7, 0x90, 0x6d, 0x28, 0x9a, 0x2c, 0x2c, 0x12, 0x5b, 0x57,
+	0xa1, 0xd1, 0x8e, 0x10, 0x50, 0x4c, 0x9d, 0x9b, 0xd8, 0x7b, 0x2a, 0xc5, 0x35, 0xd9, 0x81, 0x52,
+	0x78, 0x84, 0xae, 0xee, 0x30, 0x5e, 0xcf, 0xb7, 0xf2, 0xed, 0x12, 0x4d, 0x04, 0xd2, 0x82, 0xb2,
+	0x33, 0x78, 0x63, 0x98, 0xcc, 0xb8, 0xe4, 0x81, 0x53, 0x57, 0x5a, 0xf9, 0xb6, 0x4a, 0x65, 0x89,
+	0xec, 0x02, 0x18, 0x66, 0xe0, 0x5e, 0xf6, 0xb8, 0x75, 0xc3, 0xf0, 0xa4, 0x15, 0x5a, 0x42, 0xe5,
+	0x8b, 0x75, 0xc3, 0xc8, 0x5b, 0x28, 0x84, 0x34, 0x5e, 0x2f, 0xb6, 0xf2, 0xcb, 0x9b, 0x2a, 0xae,
+	0x42, 0xbc, 0xa0, 0xe9, 0xa0, 0xca, 0x32, 0x69, 0xc0, 0xfa, 0xa3, 0x2f, 0x2c, 0xab, 0x44, 0xe3,
+	0x7d, 0x58, 0x18, 0xa6, 0xcf, 0x61, 0x7a, 0x5c, 0x93, 0x7d, 0x50, 0x85, 0xb1, 0xb0, 0xcc, 0xa8,
+	0x36, 0x95, 0x96, 0x51, 0x3b, 0x41, 0x49, 0xbb, 0x82, 0xb2, 0x34, 0x17, 0x0b, 0x3f, 0x9b, 0x9c,
+	0x39, 0x97, 0xca, 0xbc, 0x0d, 0x45, 0x6f, 0x38, 0xe4, 0x4c, 0x8c, 0x9f, 0x42, 0xa3, 0x5d, 0xa8,
+	0xdb, 0xcc, 0x1d, 0xf9, 0x26, 0x4e, 0x93, 0x42, 0xa3, 0x9d, 0xe6, 0x8a, 0xaa, 0x56, 0x1e, 0xd5,
+	0xb2, 0x9c, 0x04, 0x94, 0xf0, 0x8b, 0x60, 0x46, 0x95, 0xe2, 0x5a, 0xf2, 0xa1, 0xc8, 0x3e, 0xb4,
+	0x97, 0x50, 0x9b, 0x19, 0xb0, 0x45, 0x49, 0xb5, 0x0b, 0x20, 0xb3, 0x13, 0x43, 0xde, 0x43, 0x59,
+	0x9a, 0xbc, 0xe8, 0x6f, 0xb8, 0x97, 0x3a, 0x48, 0xf1, 0xbb, 0x95, 0xde, 0x86, 0x64, 0xca, 0xb4,
+	0x03, 0xd8, 0x98, 0x9a, 0x98, 0x85, 0x06, 0x7e, 0xc0, 0xe6, 0xf4, 0x2c, 0x2c, 0xfc, 0x3e, 0xe7,
+	0x50, 0x35, 0xc2, 0x00, 0x97, 0x07, 0xbc, 0x27, 0xa6, 0xa5, 0x9e, 0x9b, 0x3b, 0x63, 0xc2, 0xd9,
+	0x87, 0xc7, 0x60, 0xc1, 0x3f, 0x52, 0x6e, 0xff, 0xee, 0x65, 0x68, 0xc5, 0x48, 0xc9, 0x17, 0xb7,
+	0xf7, 0xcd, 0xec, 0xdd, 0x7d, 0x33, 0xfb, 0xef, 0xbe, 0x99, 0xfd, 0xf9, 0xd0, 0xcc, 0xdc, 0x3d,
+	0x34, 0x33, 0x7f, 0x1e, 0x9a, 0x99, 0x6f, 0xef, 0x46, 0x96, 0x6f, 0x06, 0xfd, 0x8e, 0xe1, 0x39,
+	0x5d, 0x7d, 0x62, 0x1d, 0xea, 0xae, 0x61, 0x7a, 0x93, 0x2e, 0x67, 0xd6, 0xa1, 0x74, 0xbb, 0x88,
+	0x8b, 0x69, 0xe6, 0x5e, 0xeb, 0x17, 0xf1, 0xc1, 0xeb, 0xff, 0x03, 0x00, 0x26, 0x74, 0x16, 0x84,
+	0xf3, 0x06, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -886,6 +999,25 @@ func (m *MetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Files[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ChunkSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Md5Checksum) > 0 {
 		for iNdEx := len(m.Md5Checksum) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Md5Checksum[iNdEx])
@@ -919,6 +1051,50 @@ func (m *MetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FileMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChunkHashes) > 0 {
+		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChunkHashes[iNdEx])
+			copy(dAtA[i:], m.ChunkHashes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ChunkHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -939,6 +1115,16 @@ func (m *FileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Length != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Offset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Filename) > 0 {
 		i -= len(m.Filename)
 		copy(dAtA[i:], m.Filename)
@@ -974,6 +1160,11 @@ func (m *FileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -1278,6 +1469,37 @@ func (m *MetadataResponse) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovTypes(uint64(m.ChunkSize))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FileMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovTypes(uint64(m.Size_))
+	}
+	if len(m.ChunkHashes) > 0 {
+		for _, b := range m.ChunkHashes {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1294,6 +1516,12 @@ func (m *FileRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Offset != 0 {
+		n += 1 + sovTypes(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovTypes(uint64(m.Length))
+	}
 	return n
 }
 
@@ -1314,6 +1542,9 @@ func (m *FileResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Offset != 0 {
+		n += 1 + sovTypes(uint64(m.Offset))
+	}
 	return n
 }
 
@@ -1900,6 +2131,192 @@ func (m *MetadataResponse) Unmarshal(dAtA []byte) error {
 			m.Md5Checksum = append(m.Md5Checksum, make([]byte, postIndex-iNdEx))
 			copy(m.Md5Checksum[len(m.Md5Checksum)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, &FileMetadata{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
+			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2001,6 +2418,44 @@ func (m *FileRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2136,6 +2591,25 @@ func (m *FileResponse) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5,16 +5,21 @@ package mempool
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -68,7 +73,6 @@ func (m *Txs) GetTxs() [][]byte {
 
 type Message struct {
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*Message_Txs
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
@@ -139,28 +143,125 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// JournalEntry is a transaction recorded in the mempool journal, from which
+// the mempool is restored when the node restarts.
+type JournalEntry struct {
+	Tx        []byte    `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Priority  int64     `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Sender    string    `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Height    int64     `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// The node IDs of the peers the transaction was received from.
+	Peers []string `protobuf:"bytes,7,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (m *JournalEntry) Reset()         { *m = JournalEntry{} }
+func (m *JournalEntry) String() string { return proto.CompactTextString(m) }
+func (*JournalEntry) ProtoMessage()    {}
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2af51926fdbcbc05, []int{2}
+}
+func (m *JournalEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JournalEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JournalEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalEntry.Merge(m, src)
+}
+func (m *JournalEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *JournalEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalEntry proto.InternalMessageInfo
+
+func (m *JournalEntry) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *JournalEntry) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *JournalEntry) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *JournalEntry) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *JournalEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JournalEntry) GetPeers() []string {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Txs)(nil), "seitendermint.mempool.Txs")
 	proto.RegisterType((*Message)(nil), "seitendermint.mempool.Message")
+	proto.RegisterType((*JournalEntry)(nil), "seitendermint.mempool.JournalEntry")
 }
 
 func init() { proto.RegisterFile("tendermint/mempool/types.proto", fileDescriptor_2af51926fdbcbc05) }
 
 var fileDescriptor_2af51926fdbcbc05 = []byte{
-	// 195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x49, 0xcd, 0x4b,
-	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0xcf, 0x4d, 0xcd, 0x2d, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f,
-	0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2d, 0x4e, 0xcd, 0x44,
-	0x28, 0xd1, 0x83, 0x2a, 0x51, 0x12, 0xe7, 0x62, 0x0e, 0xa9, 0x28, 0x16, 0x12, 0xe0, 0x62, 0x2e,
-	0xa9, 0x28, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x09, 0x02, 0x31, 0x95, 0x1c, 0xb8, 0xd8, 0x7d,
-	0x53, 0x8b, 0x8b, 0x13, 0xd3, 0x53, 0x85, 0xf4, 0x60, 0x92, 0x8c, 0x1a, 0xdc, 0x46, 0x52, 0x7a,
-	0x58, 0x0d, 0xd2, 0x0b, 0xa9, 0x28, 0xf6, 0x60, 0x00, 0x6b, 0x75, 0x62, 0xe5, 0x62, 0x2e, 0x2e,
-	0xcd, 0x75, 0x0a, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xdb, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xc4, 0xa2, 0x4c, 0xdd, 0xc4, 0xbc,
-	0xe4, 0x8c, 0xfc, 0x22, 0xfd, 0xe2, 0xd4, 0x4c, 0x5d, 0x24, 0x5f, 0x80, 0xdd, 0xad, 0x8f, 0xe9,
-	0xad, 0x24, 0x36, 0xb0, 0x8c, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x05, 0x14, 0x6d, 0xf3,
-	0x00, 0x00, 0x00,
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x6a, 0xf3, 0x30,
+	0x14, 0xc5, 0xad, 0x38, 0x7f, 0xf5, 0x85, 0x8f, 0x20, 0xd2, 0xd6, 0x78, 0x70, 0x4c, 0x26, 0x2f,
+	0x91, 0xa1, 0x9d, 0x0b, 0xc5, 0x50, 0x28, 0x81, 0x2e, 0x26, 0x50, 0xe8, 0xe6, 0xa4, 0xaa, 0x2c,
+	0x88, 0x2c, 0x23, 0xc9, 0xe0, 0xbc, 0x45, 0x1e, 0x2b, 0x74, 0xca, 0xd8, 0xa9, 0x2d, 0xc9, 0x8b,
+	0x94, 0xc8, 0x4e, 0x52, 0x68, 0x37, 0x1d, 0xee, 0xef, 0xde, 0xa3, 0xc3, 0x81, 0x9e, 0x26, 0xd9,
+	0x0b, 0x91, 0x9c, 0x65, 0x3a, 0xe4, 0x84, 0xe7, 0x42, 0x2c, 0x43, 0xbd, 0xca, 0x89, 0xc2, 0xb9,
+	0x14, 0x5a, 0xa0, 0x0b, 0x45, 0xd8, 0x19, 0xc1, 0x35, 0xe2, 0x0e, 0xa9, 0xa0, 0xc2, 0x10, 0xe1,
+	0xe1, 0x55, 0xc1, 0xee, 0x88, 0x0a, 0x41, 0x97, 0x24, 0x34, 0x6a, 0x5e, 0xbc, 0x86, 0x9a, 0x71,
+	0xa2, 0x74, 0xc2, 0xf3, 0x0a, 0x18, 0x5f, 0x41, 0x7b, 0x56, 0x2a, 0x34, 0x80, 0xb6, 0x2e, 0x95,
+	0x03, 0x7c, 0x3b, 0xe8, 0xc7, 0x87, 0xe7, 0xf8, 0x0e, 0x76, 0x1e, 0x89, 0x52, 0x09, 0x25, 0x08,
+	0x1f, 0x87, 0x20, 0xf8, 0x77, 0xed, 0xe2, 0x3f, 0xfd, 0xf1, 0xac, 0x54, 0x0f, 0x96, 0x59, 0x8d,
+	0x5a, 0xd0, 0x56, 0x05, 0x1f, 0xbf, 0x01, 0xd8, 0x9f, 0x8a, 0x42, 0x66, 0xc9, 0xf2, 0x3e, 0xd3,
+	0x72, 0x85, 0xfe, 0xc3, 0x86, 0x2e, 0xcd, 0x99, 0x7e, 0xdc, 0xd0, 0x25, 0x72, 0x61, 0x37, 0x97,
+	0x4c, 0x48, 0xa6, 0x57, 0x4e, 0xc3, 0x07, 0x81, 0x1d, 0x9f, 0x34, 0xba, 0x84, 0x6d, 0x65, 0x4c,
+	0x1c, 0xdb, 0x07, 0x41, 0x2f, 0xae, 0x15, 0x8a, 0x60, 0xef, 0x14, 0xc1, 0x69, 0xd6, 0x3f, 0xaa,
+	0x42, 0xe2, 0x63, 0x48, 0x3c, 0x3b, 0x12, 0x51, 0x77, 0xf3, 0x31, 0xb2, 0xd6, 0x9f, 0x23, 0x10,
+	0x9f, 0xd7, 0x0e, 0xb7, 0x53, 0xc2, 0x68, 0xaa, 0x9d, 0x96, 0x71, 0xad, 0x15, 0x1a, 0xc2, 0x56,
+	0x4e, 0x88, 0x54, 0x4e, 0xc7, 0xb7, 0x83, 0x5e, 0x5c, 0x89, 0x69, 0xb3, 0xdb, 0x1e, 0x74, 0xa2,
+	0xa7, 0xcd, 0xce, 0x03, 0xdb, 0x9d, 0x07, 0xbe, 0x76, 0x1e, 0x58, 0xef, 0x3d, 0x6b, 0xbb, 0xf7,
+	0xac, 0xf7, 0xbd, 0x67, 0x3d, 0xdf, 0x52, 0xa6, 0xd3, 0x62, 0x8e, 0x17, 0x82, 0x87, 0x89, 0x64,
+	0x93, 0x24, 0x5b, 0xa4, 0x42, 0x86, 0x8a, 0xb0, 0xc9, 0x8f, 0x26, 0xab, 0x66, 0x7e, 0x57, 0x3b,
+	0x6f, 0x9b, 0xc9, 0xcd, 0xf7, 0x00, 0x28, 0xc9, 0x1a, 0x29, 0xf7, 0x01, 0x00, 0x00,
 }
 
 func (m *Txs) Marshal() (dAtA []byte, err error) {
@@ -248,6 +349,70 @@ func (m *Message_Txs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *JournalEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peers[iNdEx])
+			copy(dAtA[i:], m.Peers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Peers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -298,6 +463,36 @@ func (m *Message_Txs) Size() (n int) {
 	}
 	return n
 }
+func (m *JournalEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if len(m.Peers) > 0 {
+		for _, s := range m.Peers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -472,6 +667,225 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *JournalEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,24 +27,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tendermint/privval/service.proto", fileDescriptor_7afe74f9f46d3dc9) }
 
 var fileDescriptor_7afe74f9f46d3dc9 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x4a, 0xc4, 0x40,
-	0x10, 0x86, 0x13, 0x0b, 0xd1, 0x60, 0x21, 0x0b, 0x36, 0x57, 0x2c, 0x16, 0x2a, 0x28, 0x24, 0x0b,
-	0x5a, 0x5b, 0x68, 0x23, 0x62, 0x13, 0x14, 0x4e, 0x11, 0x2c, 0x36, 0xc9, 0x70, 0x37, 0x90, 0xdb,
-	0x59, 0x77, 0x27, 0x81, 0x7b, 0x0b, 0x9f, 0xc6, 0x67, 0xb0, 0xbc, 0xd2, 0x52, 0x92, 0x17, 0x11,
-	0xcd, 0x85, 0x43, 0x3c, 0x63, 0x3b, 0xf3, 0x7d, 0xff, 0x5f, 0xfc, 0xd1, 0x3e, 0x83, 0x29, 0xc0,
-	0xcd, 0xd0, 0xb0, 0xb2, 0x0e, 0xeb, 0x5a, 0x97, 0xca, 0x83, 0xab, 0x31, 0x87, 0xc4, 0x3a, 0x62,
-	0x12, 0x7b, 0x1e, 0x70, 0x05, 0x25, 0x4b, 0x68, 0x24, 0xd7, 0x88, 0x3c, 0xb7, 0xe0, 0x3b, 0xed,
-	0xf4, 0x75, 0x23, 0xda, 0x4d, 0x1d, 0xd6, 0x63, 0x5d, 0x62, 0xa1, 0x99, 0xdc, 0x45, 0x7a, 0x2d,
-	0x1e, 0xa2, 0xed, 0x2b, 0xe0, 0xb4, 0xca, 0x6e, 0x60, 0x2e, 0x0e, 0x92, 0xb5, 0xc9, 0x49, 0xf7,
-	0xbe, 0x85, 0xe7, 0x0a, 0x3c, 0x8f, 0x0e, 0xff, 0xa1, 0xbc, 0x25, 0xe3, 0x41, 0x3c, 0x45, 0x5b,
-	0x77, 0x38, 0x31, 0x63, 0x62, 0x10, 0x47, 0x7f, 0x28, 0x3d, 0xd0, 0x47, 0x1f, 0x0f, 0x70, 0x50,
-	0x74, 0xe4, 0x32, 0x1e, 0xa3, 0x9d, 0xaf, 0x6b, 0xea, 0xc8, 0x92, 0xd7, 0xa5, 0x38, 0x19, 0x50,
-	0x7b, 0xa8, 0xaf, 0x89, 0x07, 0x6b, 0x56, 0x74, 0x57, 0x75, 0x79, 0xff, 0xd6, 0xc8, 0x70, 0xd1,
-	0xc8, 0xf0, 0xa3, 0x91, 0xe1, 0x4b, 0x2b, 0x83, 0x45, 0x2b, 0x83, 0xf7, 0x56, 0x06, 0x8f, 0xe7,
-	0x13, 0xe4, 0x69, 0x95, 0x25, 0x39, 0xcd, 0x94, 0x76, 0x18, 0x6b, 0x93, 0x4f, 0xc9, 0x29, 0x0f,
-	0x18, 0xff, 0x18, 0x83, 0x98, 0xd4, 0xef, 0x75, 0xb2, 0xcd, 0xef, 0xcf, 0xd9, 0x67, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xcf, 0x10, 0xb9, 0xf1, 0xf3, 0x01, 0x00, 0x00,
+	// 301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x5b, 0xf8, 0xf9, 0xd1, 0x41, 0x50, 0x06, 0xdc, 0x74, 0x31, 0xb8, 0x50, 0x41, 0xa1,
+	0x13, 0xd0, 0xb5, 0x0b, 0x05, 0x91, 0xe0, 0x26, 0x58, 0xa8, 0x22, 0xb8, 0x98, 0xb6, 0x97, 0x76,
+	0x20, 0xc9, 0x1d, 0x67, 0x6e, 0x82, 0x7d, 0x0b, 0xd7, 0x3e, 0x91, 0xcb, 0x2e, 0x5d, 0x4a, 0xf2,
+	0x22, 0x52, 0x93, 0x10, 0xd4, 0x34, 0xd6, 0xed, 0x3d, 0xdf, 0x39, 0xdf, 0xe6, 0xb2, 0x3d, 0x82,
+	0x78, 0x02, 0x36, 0xd2, 0x31, 0x79, 0xc6, 0xea, 0x34, 0x55, 0xa1, 0xe7, 0xc0, 0xa6, 0x7a, 0x0c,
+	0xd2, 0x58, 0x24, 0xe4, 0xbb, 0x0e, 0x74, 0x0d, 0xc9, 0x12, 0xea, 0x89, 0x86, 0x22, 0xcd, 0x0d,
+	0xb8, 0xa2, 0x76, 0xf2, 0xf2, 0x8f, 0xed, 0x04, 0x56, 0xa7, 0x43, 0x15, 0xea, 0x89, 0x22, 0xb4,
+	0xe7, 0x81, 0xcf, 0xef, 0xd8, 0xe6, 0x15, 0x50, 0x90, 0x8c, 0xae, 0x61, 0xce, 0xf7, 0x65, 0xe3,
+	0xb2, 0x2c, 0xe2, 0x1b, 0x78, 0x4c, 0xc0, 0x51, 0xef, 0xe0, 0x17, 0xca, 0x19, 0x8c, 0x1d, 0xf0,
+	0x07, 0xb6, 0x31, 0xd0, 0xd3, 0x78, 0x88, 0x04, 0xfc, 0x70, 0x45, 0xa5, 0x02, 0xaa, 0xe9, 0xa3,
+	0x16, 0x0e, 0x26, 0x05, 0x59, 0xce, 0x6b, 0xb6, 0xb5, 0xbc, 0x06, 0x16, 0x0d, 0x3a, 0x15, 0xf2,
+	0xe3, 0x96, 0x6a, 0x05, 0x55, 0x9a, 0x7e, 0xab, 0xa6, 0xa6, 0x4b, 0x95, 0x61, 0xdb, 0x97, 0x4f,
+	0x06, 0x2d, 0x2d, 0xf3, 0x01, 0x29, 0x02, 0xbe, 0x6a, 0xe1, 0x1b, 0x57, 0x09, 0xe5, 0xba, 0x78,
+	0x6d, 0xf4, 0xa3, 0xf5, 0x8c, 0x7e, 0xf4, 0x27, 0xa3, 0x1f, 0x35, 0x1a, 0x2f, 0x6e, 0x5f, 0x33,
+	0xd1, 0x5d, 0x64, 0xa2, 0xfb, 0x9e, 0x89, 0xee, 0x73, 0x2e, 0x3a, 0x8b, 0x5c, 0x74, 0xde, 0x72,
+	0xd1, 0xb9, 0x3f, 0x9b, 0x6a, 0x9a, 0x25, 0x23, 0x39, 0xc6, 0xc8, 0x53, 0x56, 0xf7, 0x55, 0x3c,
+	0x9e, 0xa1, 0xf5, 0x1c, 0xe8, 0xfe, 0x97, 0x87, 0x43, 0x42, 0xef, 0xe7, 0x07, 0x8e, 0xfe, 0x7f,
+	0x26, 0xa7, 0x1f, 0x03, 0x00, 0x64, 0x45, 0x6e, 0x21, 0xd7, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -62,6 +64,8 @@ type PrivValidatorAPIClient interface {
 	GetPubKey(ctx context.Context, in *PubKeyRequest, opts ...grpc.CallOption) (*PubKeyResponse, error)
 	SignVote(ctx context.Context, in *SignVoteRequest, opts ...grpc.CallOption) (*SignedVoteResponse, error)
 	SignProposal(ctx context.Context, in *SignProposalRequest, opts ...grpc.CallOption) (*SignedProposalResponse, error)
+	ExportSignState(ctx context.Context, in *ExportSignStateRequest, opts ...grpc.CallOption) (*ExportSignStateResponse, error)
+	ImportSignState(ctx context.Context, in *ImportSignStateRequest, opts ...grpc.CallOption) (*ImportSignStateResponse, error)
 }
 
 type privValidatorAPIClient struct {
@@ -99,11 +103,31 @@ func (c *privValidatorAPIClient) SignProposal(ctx context.Context, in *SignPropo
 	return out, nil
 }
 
+func (c *privValidatorAPIClient) ExportSignState(ctx context.Context, in *ExportSignStateRequest, opts ...grpc.CallOption) (*ExportSignStateResponse, error) {
+	out := new(ExportSignStateResponse)
+	err := c.cc.Invoke(ctx, "/seitendermint.privval.PrivValidatorAPI/ExportSignState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorAPIClient) ImportSignState(ctx context.Context, in *ImportSignStateRequest, opts ...grpc.CallOption) (*ImportSignStateResponse, error) {
+	out := new(ImportSignStateResponse)
+	err := c.cc.Invoke(ctx, "/seitendermint.privval.PrivValidatorAPI/ImportSignState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PrivValidatorAPIServer is the server API for PrivValidatorAPI service.
 type PrivValidatorAPIServer interface {
 	GetPubKey(context.Context, *PubKeyRequest) (*PubKeyResponse, error)
 	SignVote(context.Context, *SignVoteRequest) (*SignedVoteResponse, error)
 	SignProposal(context.Context, *SignProposalRequest) (*SignedProposalResponse, error)
+	ExportSignState(context.Context, *ExportSignStateRequest) (*ExportSignStateResponse, error)
+	ImportSignState(context.Context, *ImportSignStateRequest) (*ImportSignStateResponse, error)
 }
 
 // UnimplementedPrivValidatorAPIServer can be embedded to have forward compatible implementations.
@@ -119,6 +143,12 @@ func (*UnimplementedPrivValidatorAPIServer) SignVote(ctx context.Context, req *S
 func (*UnimplementedPrivValidatorAPIServer) SignProposal(ctx context.Context, req *SignProposalRequest) (*SignedProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignProposal not implemented")
 }
+func (*UnimplementedPrivValidatorAPIServer) ExportSignState(ctx context.Context, req *ExportSignStateRequest) (*ExportSignStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSignState not implemented")
+}
+func (*UnimplementedPrivValidatorAPIServer) ImportSignState(ctx context.Context, req *ImportSignStateRequest) (*ImportSignStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSignState not implemented")
+}
 
 func RegisterPrivValidatorAPIServer(s *grpc.Server, srv PrivValidatorAPIServer) {
 	s.RegisterService(&_PrivValidatorAPI_serviceDesc, srv)
@@ -178,6 +208,42 @@ func _PrivValidatorAPI_SignProposal_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PrivValidatorAPI_ExportSignState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSignStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).ExportSignState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seitendermint.privval.PrivValidatorAPI/ExportSignState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).ExportSignState(ctx, req.(*ExportSignStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorAPI_ImportSignState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSignStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).ImportSignState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seitendermint.privval.PrivValidatorAPI/ImportSignState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).ImportSignState(ctx, req.(*ImportSignStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PrivValidatorAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seitendermint.privval.PrivValidatorAPI",
 	HandlerType: (*PrivValidatorAPIServer)(nil),
@@ -194,6 +260,14 @@ var _PrivValidatorAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SignProposal",
 			Handler:    _PrivValidatorAPI_SignProposal_Handler,
 		},
+		{
+			MethodName: "ExportSignState",
+			Handler:    _PrivValidatorAPI_ExportSignState_Handler,
+		},
+		{
+			MethodName: "ImportSignState",
+			Handler:    _PrivValidatorAPI_ImportSignState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tendermint/privval/service.proto",
@@ -498,6 +498,283 @@ func (m *PingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PingResponse proto.InternalMessageInfo
 
+// SignState is the last height, round and step signed by a signer, along with
+// the sign bytes and signature of the message it signed there.
+type SignState struct {
+	Height    int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int32  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Step      int32  `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	SignBytes []byte `protobuf:"bytes,4,opt,name=sign_bytes,json=signBytes,proto3" json:"sign_bytes,omitempty"`
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignState) Reset()         { *m = SignState{} }
+func (m *SignState) String() string { return proto.CompactTextString(m) }
+func (*SignState) ProtoMessage()    {}
+func (*SignState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{9}
+}
+func (m *SignState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignState.Merge(m, src)
+}
+func (m *SignState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignState proto.InternalMessageInfo
+
+func (m *SignState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SignState) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *SignState) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *SignState) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+func (m *SignState) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// ExportSignStateRequest requests the last sign state from the remote signer.
+type ExportSignStateRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *ExportSignStateRequest) Reset()         { *m = ExportSignStateRequest{} }
+func (m *ExportSignStateRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportSignStateRequest) ProtoMessage()    {}
+func (*ExportSignStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{10}
+}
+func (m *ExportSignStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportSignStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportSignStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportSignStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSignStateRequest.Merge(m, src)
+}
+func (m *ExportSignStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportSignStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSignStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSignStateRequest proto.InternalMessageInfo
+
+func (m *ExportSignStateRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// ExportSignStateResponse is a response containing the last sign state or an
+// error
+type ExportSignStateResponse struct {
+	SignState SignState          `protobuf:"bytes,1,opt,name=sign_state,json=signState,proto3" json:"sign_state"`
+	Error     *RemoteSignerError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ExportSignStateResponse) Reset()         { *m = ExportSignStateResponse{} }
+func (m *ExportSignStateResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportSignStateResponse) ProtoMessage()    {}
+func (*ExportSignStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{11}
+}
+func (m *ExportSignStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportSignStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportSignStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportSignStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSignStateResponse.Merge(m, src)
+}
+func (m *ExportSignStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportSignStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSignStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSignStateResponse proto.InternalMessageInfo
+
+func (m *ExportSignStateResponse) GetSignState() SignState {
+	if m != nil {
+		return m.SignState
+	}
+	return SignState{}
+}
+
+func (m *ExportSignStateResponse) GetError() *RemoteSignerError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// ImportSignStateRequest is a request to raise the last sign state of the
+// remote signer
+type ImportSignStateRequest struct {
+	SignState *SignState `protobuf:"bytes,1,opt,name=sign_state,json=signState,proto3" json:"sign_state,omitempty"`
+	ChainId   string     `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *ImportSignStateRequest) Reset()         { *m = ImportSignStateRequest{} }
+func (m *ImportSignStateRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportSignStateRequest) ProtoMessage()    {}
+func (*ImportSignStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{12}
+}
+func (m *ImportSignStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportSignStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportSignStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportSignStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportSignStateRequest.Merge(m, src)
+}
+func (m *ImportSignStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportSignStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportSignStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportSignStateRequest proto.InternalMessageInfo
+
+func (m *ImportSignStateRequest) GetSignState() *SignState {
+	if m != nil {
+		return m.SignState
+	}
+	return nil
+}
+
+func (m *ImportSignStateRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// ImportSignStateResponse is a response to an import, containing an error if
+// it was refused
+type ImportSignStateResponse struct {
+	Error *RemoteSignerError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ImportSignStateResponse) Reset()         { *m = ImportSignStateResponse{} }
+func (m *ImportSignStateResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportSignStateResponse) ProtoMessage()    {}
+func (*ImportSignStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{13}
+}
+func (m *ImportSignStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportSignStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportSignStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportSignStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportSignStateResponse.Merge(m, src)
+}
+func (m *ImportSignStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportSignStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportSignStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportSignStateResponse proto.InternalMessageInfo
+
+func (m *ImportSignStateResponse) GetError() *RemoteSignerError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Message_PubKeyRequest
@@ -508,6 +785,10 @@ type Message struct {
 	//	*Message_SignedProposalResponse
 	//	*Message_PingRequest
 	//	*Message_PingResponse
+	//	*Message_ExportSignStateRequest
+	//	*Message_ExportSignStateResponse
+	//	*Message_ImportSignStateRequest
+	//	*Message_ImportSignStateResponse
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -515,7 +796,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb4e437a5328cf9c, []int{9}
+	return fileDescriptor_cb4e437a5328cf9c, []int{14}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,15 +855,31 @@ type Message_PingRequest struct {
 type Message_PingResponse struct {
 	PingResponse *PingResponse `protobuf:"bytes,8,opt,name=ping_response,json=pingResponse,proto3,oneof" json:"ping_response,omitempty"`
 }
+type Message_ExportSignStateRequest struct {
+	ExportSignStateRequest *ExportSignStateRequest `protobuf:"bytes,9,opt,name=export_sign_state_request,json=exportSignStateRequest,proto3,oneof" json:"export_sign_state_request,omitempty"`
+}
+type Message_ExportSignStateResponse struct {
+	ExportSignStateResponse *ExportSignStateResponse `protobuf:"bytes,10,opt,name=export_sign_state_response,json=exportSignStateResponse,proto3,oneof" json:"export_sign_state_response,omitempty"`
+}
+type Message_ImportSignStateRequest struct {
+	ImportSignStateRequest *ImportSignStateRequest `protobuf:"bytes,11,opt,name=import_sign_state_request,json=importSignStateRequest,proto3,oneof" json:"import_sign_state_request,omitempty"`
+}
+type Message_ImportSignStateResponse struct {
+	ImportSignStateResponse *ImportSignStateResponse `protobuf:"bytes,12,opt,name=import_sign_state_response,json=importSignStateResponse,proto3,oneof" json:"import_sign_state_response,omitempty"`
+}
 
-func (*Message_PubKeyRequest) isMessage_Sum()          {}
-func (*Message_PubKeyResponse) isMessage_Sum()         {}
-func (*Message_SignVoteRequest) isMessage_Sum()        {}
-func (*Message_SignedVoteResponse) isMessage_Sum()     {}
-func (*Message_SignProposalRequest) isMessage_Sum()    {}
-func (*Message_SignedProposalResponse) isMessage_Sum() {}
-func (*Message_PingRequest) isMessage_Sum()            {}
-func (*Message_PingResponse) isMessage_Sum()           {}
+func (*Message_PubKeyRequest) isMessage_Sum()           {}
+func (*Message_PubKeyResponse) isMessage_Sum()          {}
+func (*Message_SignVoteRequest) isMessage_Sum()         {}
+func (*Message_SignedVoteResponse) isMessage_Sum()      {}
+func (*Message_SignProposalRequest) isMessage_Sum()     {}
+func (*Message_SignedProposalResponse) isMessage_Sum()  {}
+func (*Message_PingRequest) isMessage_Sum()             {}
+func (*Message_PingResponse) isMessage_Sum()            {}
+func (*Message_ExportSignStateRequest) isMessage_Sum()  {}
+func (*Message_ExportSignStateResponse) isMessage_Sum() {}
+func (*Message_ImportSignStateRequest) isMessage_Sum()  {}
+func (*Message_ImportSignStateResponse) isMessage_Sum() {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -647,6 +944,34 @@ func (m *Message) GetPingResponse() *PingResponse {
 	return nil
 }
 
+func (m *Message) GetExportSignStateRequest() *ExportSignStateRequest {
+	if x, ok := m.GetSum().(*Message_ExportSignStateRequest); ok {
+		return x.ExportSignStateRequest
+	}
+	return nil
+}
+
+func (m *Message) GetExportSignStateResponse() *ExportSignStateResponse {
+	if x, ok := m.GetSum().(*Message_ExportSignStateResponse); ok {
+		return x.ExportSignStateResponse
+	}
+	return nil
+}
+
+func (m *Message) GetImportSignStateRequest() *ImportSignStateRequest {
+	if x, ok := m.GetSum().(*Message_ImportSignStateRequest); ok {
+		return x.ImportSignStateRequest
+	}
+	return nil
+}
+
+func (m *Message) GetImportSignStateResponse() *ImportSignStateResponse {
+	if x, ok := m.GetSum().(*Message_ImportSignStateResponse); ok {
+		return x.ImportSignStateResponse
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -658,6 +983,10 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_SignedProposalResponse)(nil),
 		(*Message_PingRequest)(nil),
 		(*Message_PingResponse)(nil),
+		(*Message_ExportSignStateRequest)(nil),
+		(*Message_ExportSignStateResponse)(nil),
+		(*Message_ImportSignStateRequest)(nil),
+		(*Message_ImportSignStateResponse)(nil),
 	}
 }
 
@@ -673,7 +1002,7 @@ func (m *AuthSigMessage) Reset()         { *m = AuthSigMessage{} }
 func (m *AuthSigMessage) String() string { return proto.CompactTextString(m) }
 func (*AuthSigMessage) ProtoMessage()    {}
 func (*AuthSigMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb4e437a5328cf9c, []int{10}
+	return fileDescriptor_cb4e437a5328cf9c, []int{15}
 }
 func (m *AuthSigMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,6 +1056,11 @@ func init() {
 	proto.RegisterType((*SignedProposalResponse)(nil), "seitendermint.privval.SignedProposalResponse")
 	proto.RegisterType((*PingRequest)(nil), "seitendermint.privval.PingRequest")
 	proto.RegisterType((*PingResponse)(nil), "seitendermint.privval.PingResponse")
+	proto.RegisterType((*SignState)(nil), "seitendermint.privval.SignState")
+	proto.RegisterType((*ExportSignStateRequest)(nil), "seitendermint.privval.ExportSignStateRequest")
+	proto.RegisterType((*ExportSignStateResponse)(nil), "seitendermint.privval.ExportSignStateResponse")
+	proto.RegisterType((*ImportSignStateRequest)(nil), "seitendermint.privval.ImportSignStateRequest")
+	proto.RegisterType((*ImportSignStateResponse)(nil), "seitendermint.privval.ImportSignStateResponse")
 	proto.RegisterType((*Message)(nil), "seitendermint.privval.Message")
 	proto.RegisterType((*AuthSigMessage)(nil), "seitendermint.privval.AuthSigMessage")
 }
@@ -734,57 +1068,71 @@ func init() {
 func init() { proto.RegisterFile("tendermint/privval/types.proto", fileDescriptor_cb4e437a5328cf9c) }
 
 var fileDescriptor_cb4e437a5328cf9c = []byte{
-	// 791 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcb, 0x4e, 0xf3, 0x46,
-	0x14, 0xb6, 0xc9, 0x0d, 0x4e, 0x2e, 0x84, 0xe1, 0xd2, 0x80, 0x8a, 0x41, 0xee, 0x45, 0x14, 0x29,
-	0x89, 0x04, 0xab, 0x2e, 0x4a, 0xc5, 0xc5, 0x6a, 0x52, 0x84, 0x93, 0x4e, 0x42, 0xa9, 0x5a, 0x55,
-	0x6e, 0x2e, 0x23, 0x67, 0x04, 0xf1, 0xb8, 0x1e, 0x07, 0x29, 0x8f, 0xd0, 0x1d, 0xdb, 0xbe, 0x41,
-	0x1f, 0x05, 0x75, 0xc5, 0xb2, 0xab, 0xaa, 0x82, 0x17, 0xa9, 0x32, 0x9e, 0x38, 0x71, 0x08, 0x69,
-	0x7f, 0xb1, 0x9b, 0x39, 0xe7, 0xcc, 0x77, 0xbe, 0x6f, 0xfc, 0x1d, 0x0f, 0x68, 0x3e, 0x71, 0xba,
-	0xc4, 0xeb, 0x53, 0xc7, 0x2f, 0xbb, 0x1e, 0xbd, 0xbf, 0x6f, 0xdd, 0x95, 0xfd, 0xa1, 0x4b, 0x78,
-	0xc9, 0xf5, 0x98, 0xcf, 0xd0, 0x26, 0x27, 0x74, 0x52, 0x52, 0x92, 0x25, 0x3b, 0x1f, 0x4f, 0x1d,
-	0xeb, 0x78, 0x43, 0xd7, 0x67, 0xe5, 0x5b, 0x32, 0x94, 0x87, 0x22, 0x59, 0x01, 0x36, 0x0d, 0xb9,
-	0xb3, 0x61, 0x33, 0x9b, 0x89, 0x65, 0x79, 0xb4, 0x0a, 0xa2, 0x7a, 0x15, 0xd6, 0x30, 0xe9, 0x33,
-	0x9f, 0x34, 0xa8, 0xed, 0x10, 0xcf, 0xf0, 0x3c, 0xe6, 0x21, 0x04, 0xf1, 0x0e, 0xeb, 0x92, 0x82,
-	0xba, 0xaf, 0x1e, 0x24, 0xb0, 0x58, 0xa3, 0x7d, 0x48, 0x77, 0x09, 0xef, 0x78, 0xd4, 0xf5, 0x29,
-	0x73, 0x0a, 0x4b, 0xfb, 0xea, 0xc1, 0x0a, 0x9e, 0x0e, 0xe9, 0x87, 0x90, 0xad, 0x0f, 0xda, 0x97,
-	0x64, 0x88, 0xc9, 0xaf, 0x03, 0xc2, 0x7d, 0xb4, 0x0d, 0xcb, 0x9d, 0x5e, 0x8b, 0x3a, 0x16, 0xed,
-	0x0a, 0xa8, 0x15, 0x9c, 0x12, 0xfb, 0x6a, 0x57, 0x7f, 0x50, 0x21, 0x37, 0x2e, 0xe6, 0x2e, 0x73,
-	0x38, 0x41, 0x27, 0x90, 0x72, 0x07, 0x6d, 0xeb, 0x96, 0x0c, 0x45, 0x71, 0xfa, 0x68, 0xaf, 0x14,
-	0xbd, 0x84, 0x40, 0x70, 0xa9, 0x3e, 0x68, 0xdf, 0xd1, 0xce, 0x25, 0x19, 0x9e, 0xc5, 0x1f, 0xff,
-	0xde, 0x53, 0x70, 0xd2, 0x15, 0x38, 0xe8, 0x04, 0x12, 0x64, 0xc4, 0x5e, 0x50, 0x4b, 0x1f, 0x1d,
-	0x94, 0xe6, 0x5e, 0x61, 0xe9, 0x95, 0x5a, 0x1c, 0x1c, 0xd3, 0x7f, 0x82, 0xd5, 0x51, 0xf4, 0x7b,
-	0xe6, 0x93, 0xb1, 0x80, 0x22, 0xc4, 0xef, 0x99, 0x4f, 0x24, 0x9f, 0xed, 0x19, 0xc4, 0xe0, 0x72,
-	0x45, 0xbd, 0x28, 0x8b, 0xe8, 0x5d, 0x8a, 0xea, 0xfd, 0x4d, 0x05, 0x24, 0x7a, 0x76, 0x03, 0x7c,
-	0xa9, 0xf9, 0xf8, 0x7f, 0x36, 0x90, 0x52, 0x83, 0x36, 0xef, 0x15, 0x7a, 0x0b, 0xeb, 0xa3, 0x68,
-	0xdd, 0x63, 0x2e, 0xe3, 0xad, 0xbb, 0xb1, 0xd8, 0x2f, 0x61, 0xd9, 0x95, 0x21, 0xc9, 0x67, 0x77,
-	0x2e, 0x9f, 0xf0, 0x5c, 0x58, 0xbe, 0x48, 0xf8, 0xef, 0x2a, 0x6c, 0x05, 0xc2, 0x27, 0xfd, 0xa4,
-	0xf8, 0xaf, 0x3f, 0xb0, 0xa1, 0xbc, 0x84, 0x49, 0xdb, 0xf7, 0x5e, 0x44, 0x16, 0xd2, 0x75, 0xea,
-	0xd8, 0xf2, 0x02, 0xf4, 0x1c, 0x64, 0x82, 0x6d, 0xc0, 0x4f, 0xff, 0x33, 0x01, 0xa9, 0x2b, 0xc2,
-	0x79, 0xcb, 0x26, 0xc8, 0x84, 0x55, 0x69, 0x4e, 0xcb, 0x0b, 0xca, 0x25, 0xe5, 0x4f, 0xdf, 0x68,
-	0x1a, 0x99, 0x84, 0x8a, 0x82, 0xb3, 0x6e, 0x64, 0x34, 0xbe, 0x83, 0xfc, 0x04, 0x2f, 0xe8, 0x27,
-	0x55, 0x7c, 0xf6, 0x1f, 0x80, 0x41, 0x71, 0x45, 0xc1, 0x39, 0x37, 0x3a, 0x3f, 0x4d, 0x58, 0xe3,
-	0xd4, 0x76, 0xac, 0x91, 0x47, 0x42, 0x92, 0x31, 0x81, 0xf9, 0xf9, 0x1b, 0x98, 0x33, 0x7e, 0xaf,
-	0x28, 0x78, 0x95, 0xcf, 0x8c, 0xc0, 0xcf, 0xb0, 0xc1, 0xc5, 0xe7, 0x1b, 0xe3, 0x4a, 0xb2, 0x71,
-	0x01, 0xfc, 0xc5, 0x02, 0xe0, 0xa8, 0xd5, 0x2b, 0x0a, 0x46, 0xfc, 0xf5, 0x00, 0xfc, 0x02, 0x9b,
-	0x82, 0xf4, 0xf8, 0x9b, 0x86, 0xc4, 0x13, 0x02, 0xff, 0x70, 0x01, 0xfe, 0x8c, 0x7f, 0x2b, 0x0a,
-	0x5e, 0xe7, 0x73, 0x6c, 0x4d, 0xa1, 0x20, 0x05, 0x4c, 0xf5, 0x90, 0x22, 0x92, 0xa2, 0x49, 0x71,
-	0xa1, 0x88, 0x59, 0xdb, 0x56, 0x14, 0xbc, 0xc5, 0xe7, 0x1b, 0xfa, 0x1b, 0xc8, 0xb8, 0xd4, 0xb1,
-	0x43, 0x0d, 0x29, 0x01, 0xaf, 0xbf, 0xf5, 0x41, 0x27, 0xd6, 0xab, 0x28, 0x38, 0xed, 0x4e, 0xb6,
-	0xe8, 0x5b, 0xc8, 0x4a, 0x20, 0x49, 0x74, 0x59, 0x20, 0x7d, 0xb2, 0x10, 0x29, 0xa4, 0x97, 0x71,
-	0xa7, 0xf6, 0x67, 0x09, 0x88, 0xf1, 0x41, 0x5f, 0x6f, 0x43, 0xee, 0x74, 0xe0, 0xf7, 0x1a, 0xd4,
-	0x1e, 0x5b, 0xfa, 0xbd, 0xff, 0xdb, 0x3c, 0xc4, 0x38, 0xb5, 0x85, 0x6b, 0x33, 0x78, 0xb4, 0x3c,
-	0xfc, 0x43, 0x85, 0xa4, 0x18, 0x30, 0x8e, 0x10, 0xe4, 0x0c, 0x8c, 0x6b, 0xb8, 0x61, 0x5d, 0x9b,
-	0x97, 0x66, 0xed, 0xc6, 0xcc, 0x2b, 0x48, 0x83, 0x9d, 0x30, 0x66, 0xfc, 0x50, 0x37, 0xce, 0x9b,
-	0xc6, 0x85, 0x85, 0x8d, 0x46, 0xbd, 0x66, 0x36, 0x8c, 0xbc, 0x8a, 0x0a, 0xb0, 0x21, 0xf3, 0x66,
-	0xcd, 0x3a, 0xaf, 0x99, 0xa6, 0x71, 0xde, 0xac, 0xd6, 0xcc, 0xfc, 0x12, 0xda, 0x85, 0x6d, 0x99,
-	0x99, 0x84, 0xad, 0x66, 0xf5, 0xca, 0xa8, 0x5d, 0x37, 0xf3, 0x31, 0xf4, 0x11, 0xac, 0xcb, 0x34,
-	0x36, 0x4e, 0x2f, 0xc2, 0x44, 0x7c, 0x0a, 0xf1, 0x06, 0x57, 0x9b, 0x46, 0x98, 0x49, 0x9c, 0xdd,
-	0x3c, 0x3e, 0x6b, 0xea, 0xd3, 0xb3, 0xa6, 0xfe, 0xf3, 0xac, 0xa9, 0x0f, 0x2f, 0x9a, 0xf2, 0xf4,
-	0xa2, 0x29, 0x7f, 0xbd, 0x68, 0xca, 0x8f, 0x5f, 0xd9, 0xd4, 0xef, 0x0d, 0xda, 0xa5, 0x0e, 0xeb,
-	0x97, 0x5b, 0x1e, 0x2d, 0xb6, 0x9c, 0x4e, 0x8f, 0x79, 0x65, 0x4e, 0x68, 0x31, 0xf2, 0x66, 0x8f,
-	0xde, 0xd1, 0xd7, 0x8f, 0x78, 0x3b, 0x29, 0x32, 0xc7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x39,
-	0x80, 0xcf, 0x8c, 0xe1, 0x07, 0x00, 0x00,
+	// 1009 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0x1a, 0x47,
+	0x14, 0xdf, 0x0d, 0x1f, 0x36, 0x0f, 0x8c, 0xc9, 0xd8, 0xc1, 0xd8, 0x8a, 0x09, 0xa2, 0x1f, 0x72,
+	0x2d, 0x19, 0xa4, 0xf8, 0xd4, 0x43, 0x13, 0xc5, 0xce, 0xaa, 0x50, 0x2b, 0x40, 0x07, 0x52, 0xf7,
+	0x43, 0x15, 0xe5, 0x63, 0xb4, 0x4c, 0xed, 0xdd, 0xd9, 0xee, 0x0c, 0x56, 0xb9, 0xf5, 0xda, 0x9e,
+	0x72, 0xed, 0xad, 0xc7, 0xfe, 0x29, 0x39, 0xe6, 0xd8, 0x53, 0x55, 0xd9, 0xff, 0x48, 0xb5, 0xb3,
+	0xc3, 0x02, 0x66, 0xd9, 0xba, 0xf1, 0x6d, 0xe6, 0xf7, 0xde, 0xfc, 0xde, 0xef, 0xf7, 0x76, 0xe7,
+	0x69, 0xa0, 0x28, 0x88, 0x3d, 0x24, 0xae, 0x45, 0x6d, 0x51, 0x75, 0x5c, 0x7a, 0x75, 0xd5, 0xbb,
+	0xac, 0x8a, 0x89, 0x43, 0x78, 0xc5, 0x71, 0x99, 0x60, 0xe8, 0x11, 0x27, 0x74, 0x96, 0x52, 0x51,
+	0x29, 0x7b, 0x8f, 0xe7, 0x8e, 0x0d, 0xdc, 0x89, 0x23, 0x58, 0xf5, 0x82, 0x4c, 0xd4, 0xa1, 0x85,
+	0xa8, 0x24, 0x9b, 0xa7, 0xdc, 0xdb, 0x36, 0x99, 0xc9, 0xe4, 0xb2, 0xea, 0xad, 0x7c, 0xb4, 0x5c,
+	0x87, 0x87, 0x98, 0x58, 0x4c, 0x90, 0x36, 0x35, 0x6d, 0xe2, 0x1a, 0xae, 0xcb, 0x5c, 0x84, 0x20,
+	0x3e, 0x60, 0x43, 0x52, 0xd0, 0x4b, 0xfa, 0x41, 0x02, 0xcb, 0x35, 0x2a, 0x41, 0x7a, 0x48, 0xf8,
+	0xc0, 0xa5, 0x8e, 0xa0, 0xcc, 0x2e, 0x3c, 0x28, 0xe9, 0x07, 0x29, 0x3c, 0x0f, 0x95, 0x0f, 0x61,
+	0xa3, 0x35, 0xee, 0x9f, 0x91, 0x09, 0x26, 0x3f, 0x8d, 0x09, 0x17, 0x68, 0x17, 0xd6, 0x07, 0xa3,
+	0x1e, 0xb5, 0xbb, 0x74, 0x28, 0xa9, 0x52, 0x78, 0x4d, 0xee, 0xeb, 0xc3, 0xf2, 0x1b, 0x1d, 0xb2,
+	0xd3, 0x64, 0xee, 0x30, 0x9b, 0x13, 0xf4, 0x0c, 0xd6, 0x9c, 0x71, 0xbf, 0x7b, 0x41, 0x26, 0x32,
+	0x39, 0xfd, 0xf4, 0x49, 0x65, 0xb1, 0x09, 0xbe, 0xe1, 0x4a, 0x6b, 0xdc, 0xbf, 0xa4, 0x83, 0x33,
+	0x32, 0x39, 0x89, 0xbf, 0xfd, 0xfb, 0x89, 0x86, 0x93, 0x8e, 0xe4, 0x41, 0xcf, 0x20, 0x41, 0x3c,
+	0xf5, 0x52, 0x5a, 0xfa, 0xe9, 0x41, 0x25, 0xb4, 0x85, 0x95, 0x25, 0xb7, 0xd8, 0x3f, 0x56, 0xfe,
+	0x0e, 0x36, 0x3d, 0xf4, 0x2b, 0x26, 0xc8, 0xd4, 0xc0, 0x11, 0xc4, 0xaf, 0x98, 0x20, 0x4a, 0xcf,
+	0xee, 0x2d, 0x46, 0xbf, 0xb9, 0x32, 0x5f, 0xa6, 0x2d, 0xf8, 0x7d, 0xb0, 0xe8, 0xf7, 0x57, 0x1d,
+	0x90, 0xac, 0x39, 0xf4, 0xf9, 0x95, 0xe7, 0xe3, 0x3b, 0x16, 0x50, 0x56, 0xfd, 0x32, 0xf7, 0x35,
+	0x7a, 0x01, 0x5b, 0x1e, 0xda, 0x72, 0x99, 0xc3, 0x78, 0xef, 0x72, 0x6a, 0xf6, 0x53, 0x58, 0x77,
+	0x14, 0xa4, 0xf4, 0xec, 0x87, 0xea, 0x09, 0xce, 0x05, 0xe9, 0x51, 0xc6, 0x7f, 0xd7, 0x21, 0xef,
+	0x1b, 0x9f, 0xd5, 0x53, 0xe6, 0x9f, 0xff, 0xcf, 0x82, 0xaa, 0x09, 0xb3, 0xb2, 0xf7, 0x6d, 0xc4,
+	0x06, 0xa4, 0x5b, 0xd4, 0x36, 0x55, 0x03, 0xca, 0x59, 0xc8, 0xf8, 0x5b, 0x5f, 0x5f, 0xf9, 0x37,
+	0x1d, 0x52, 0xde, 0xa9, 0xb6, 0xe8, 0x09, 0x82, 0xf2, 0x90, 0x1c, 0x11, 0x6a, 0x8e, 0x84, 0xd4,
+	0x1a, 0xc3, 0x6a, 0x87, 0xb6, 0x21, 0xe1, 0xb2, 0xb1, 0xed, 0x1b, 0x4f, 0x60, 0x7f, 0xe3, 0xdd,
+	0x20, 0x2e, 0x88, 0x53, 0x88, 0xf9, 0x37, 0xc8, 0x5b, 0xa3, 0x7d, 0x00, 0x4e, 0x4d, 0xbb, 0xdb,
+	0x9f, 0x08, 0xc2, 0x0b, 0xf1, 0x92, 0x7e, 0x90, 0xc1, 0x29, 0x0f, 0x39, 0xf1, 0x00, 0xf4, 0x18,
+	0xe4, 0xa6, 0x27, 0xc6, 0x2e, 0x29, 0x24, 0x66, 0x51, 0x09, 0x94, 0x8f, 0x21, 0x6f, 0xfc, 0xec,
+	0x30, 0x57, 0x04, 0x8a, 0xee, 0x70, 0xcb, 0xfe, 0xd0, 0x61, 0x67, 0xe9, 0x94, 0xea, 0xbe, 0xa1,
+	0xd4, 0x70, 0x0f, 0x55, 0xfd, 0x2f, 0xad, 0xe8, 0x60, 0x70, 0x5a, 0x7d, 0x82, 0x14, 0x9f, 0x02,
+	0xf7, 0xfe, 0x06, 0x02, 0xf2, 0x75, 0x2b, 0xd4, 0xd7, 0xf3, 0xf7, 0x11, 0x38, 0x2f, 0x2d, 0xe2,
+	0xaf, 0xfc, 0x06, 0x76, 0xea, 0x56, 0x78, 0x5f, 0x02, 0x43, 0xfa, 0xfb, 0x19, 0xfa, 0x25, 0x05,
+	0x6b, 0xaf, 0x08, 0xe7, 0x3d, 0x93, 0xa0, 0x06, 0x6c, 0xaa, 0x91, 0xd6, 0x75, 0x7d, 0x57, 0x8a,
+	0xf5, 0xc3, 0x15, 0xac, 0x0b, 0xf3, 0xb3, 0xa6, 0xe1, 0x0d, 0x67, 0x1e, 0x40, 0x5f, 0x42, 0x6e,
+	0xc6, 0xe7, 0xeb, 0x55, 0x7d, 0xff, 0xe8, 0x3f, 0x08, 0xfd, 0xe4, 0x9a, 0x86, 0xb3, 0xce, 0x02,
+	0x82, 0x3a, 0xf0, 0x50, 0x76, 0xd9, 0x9b, 0x2c, 0x81, 0xc8, 0x98, 0xe4, 0xfc, 0x38, 0xa2, 0xd9,
+	0x73, 0x53, 0xb2, 0xa6, 0xe1, 0x4d, 0xbe, 0x08, 0xa1, 0xef, 0x61, 0x9b, 0xcb, 0x4b, 0x3f, 0xe5,
+	0x55, 0x62, 0xe3, 0x92, 0xf8, 0x93, 0x08, 0xe2, 0xc5, 0x01, 0x59, 0xd3, 0x30, 0xe2, 0x4b, 0x28,
+	0xfa, 0x01, 0x1e, 0x49, 0xd1, 0xd3, 0x49, 0x10, 0x08, 0x4f, 0x48, 0xfe, 0xc3, 0x08, 0xfe, 0x5b,
+	0x53, 0xaf, 0xa6, 0xe1, 0x2d, 0xbe, 0x0c, 0x23, 0x0a, 0x05, 0x65, 0x60, 0xae, 0x86, 0x32, 0x91,
+	0x94, 0x45, 0x8e, 0x22, 0x4d, 0xdc, 0x1e, 0x76, 0x35, 0x0d, 0xe7, 0x79, 0x68, 0x04, 0x7d, 0x0e,
+	0x19, 0x87, 0xda, 0x66, 0xe0, 0x61, 0x4d, 0xd2, 0x97, 0x57, 0x7d, 0xd0, 0xd9, 0xc0, 0xaa, 0x69,
+	0x38, 0xed, 0xcc, 0xb6, 0xe8, 0x0b, 0xd8, 0x50, 0x44, 0x4a, 0xe8, 0xba, 0x64, 0xfa, 0x20, 0x92,
+	0x29, 0x90, 0x97, 0x71, 0xe6, 0xf6, 0xe8, 0x47, 0xd8, 0x25, 0x72, 0x70, 0x74, 0x67, 0x77, 0x30,
+	0x50, 0x98, 0x8a, 0x6c, 0x40, 0xf8, 0x98, 0xf2, 0x1a, 0x40, 0x42, 0x23, 0xc8, 0x82, 0xbd, 0xb0,
+	0x5a, 0xca, 0x04, 0xc8, 0x62, 0x95, 0xbb, 0x16, 0x0b, 0xfc, 0xec, 0x90, 0xf0, 0x90, 0x67, 0x8d,
+	0x5a, 0xab, 0xac, 0xa5, 0x23, 0xad, 0xd5, 0xad, 0x55, 0xd6, 0xa8, 0xb5, 0xca, 0x5a, 0x58, 0x2d,
+	0x65, 0x2d, 0x13, 0x69, 0xad, 0x6e, 0xad, 0xb4, 0x46, 0xc3, 0x43, 0x27, 0x09, 0x88, 0xf1, 0xb1,
+	0x55, 0xee, 0x43, 0xf6, 0xc5, 0x58, 0x8c, 0xda, 0xd4, 0x9c, 0x0e, 0xa2, 0xfb, 0xbe, 0xad, 0x72,
+	0x10, 0xe3, 0xd4, 0x94, 0xb3, 0x26, 0x83, 0xbd, 0xe5, 0xe1, 0x9f, 0x3a, 0x24, 0xe5, 0xdc, 0xe3,
+	0x08, 0x41, 0xd6, 0xc0, 0xb8, 0x89, 0xdb, 0xdd, 0xd7, 0x8d, 0xb3, 0x46, 0xf3, 0xbc, 0x91, 0xd3,
+	0x50, 0x11, 0xf6, 0x02, 0xcc, 0xf8, 0xba, 0x65, 0x9c, 0x76, 0x8c, 0x97, 0x5d, 0x6c, 0xb4, 0x5b,
+	0xcd, 0x46, 0xdb, 0xc8, 0xe9, 0xa8, 0x00, 0xdb, 0x2a, 0xde, 0x68, 0x76, 0x4f, 0x9b, 0x8d, 0x86,
+	0x71, 0xda, 0xa9, 0x37, 0x1b, 0xb9, 0x07, 0x68, 0x1f, 0x76, 0x55, 0x64, 0x06, 0x77, 0x3b, 0xf5,
+	0x57, 0x46, 0xf3, 0x75, 0x27, 0x17, 0x43, 0x3b, 0xb0, 0xa5, 0xc2, 0xd8, 0x78, 0xf1, 0x32, 0x08,
+	0xc4, 0xe7, 0x18, 0xcf, 0x71, 0xbd, 0x63, 0x04, 0x91, 0xc4, 0xc9, 0xf9, 0xdb, 0xeb, 0xa2, 0xfe,
+	0xee, 0xba, 0xa8, 0xff, 0x73, 0x5d, 0xd4, 0xdf, 0xdc, 0x14, 0xb5, 0x77, 0x37, 0x45, 0xed, 0xaf,
+	0x9b, 0xa2, 0xf6, 0xed, 0x67, 0x26, 0x15, 0xa3, 0x71, 0xbf, 0x32, 0x60, 0x56, 0xb5, 0xe7, 0xd2,
+	0xa3, 0x9e, 0x3d, 0x18, 0x31, 0xb7, 0xca, 0x09, 0x3d, 0x5a, 0x78, 0x9f, 0x7b, 0x6f, 0xe6, 0xe5,
+	0x07, 0x7b, 0x3f, 0x29, 0x23, 0xc7, 0xff, 0x0e, 0x00, 0xeb, 0x77, 0x9c, 0x92, 0xcd, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *RemoteSignerError) Marshal() (dAtA []byte, err error) {
@@ -1117,7 +1465,7 @@ func (m *PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *SignState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1127,59 +1475,101 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Step != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Message_PubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExportSignStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportSignStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_PubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExportSignStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PubKeyRequest != nil {
-		{
-			size, err := m.PubKeyRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_PubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *ExportSignStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportSignStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExportSignStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PubKeyResponse != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
 		{
-			size, err := m.PubKeyResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1189,7 +1579,169 @@ func (m *Message_PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
+	{
+		size, err := m.SignState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportSignStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportSignStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportSignStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignState != nil {
+		{
+			size, err := m.SignState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportSignStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportSignStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportSignStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message_PubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_PubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PubKeyRequest != nil {
+		{
+			size, err := m.PubKeyRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_PubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PubKeyResponse != nil {
+		{
+			size, err := m.PubKeyResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
 }
 func (m *Message_SignVoteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
@@ -1317,6 +1869,90 @@ func (m *Message_PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_ExportSignStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ExportSignStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExportSignStateRequest != nil {
+		{
+			size, err := m.ExportSignStateRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_ExportSignStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ExportSignStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExportSignStateResponse != nil {
+		{
+			size, err := m.ExportSignStateResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_ImportSignStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ImportSignStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ImportSignStateRequest != nil {
+		{
+			size, err := m.ImportSignStateRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_ImportSignStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ImportSignStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ImportSignStateResponse != nil {
+		{
+			size, err := m.ImportSignStateResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
 func (m *AuthSigMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1494,67 +2130,151 @@ func (m *PingResponse) Size() (n int) {
 	return n
 }
 
-func (m *Message) Size() (n int) {
+func (m *SignState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if m.Step != 0 {
+		n += 1 + sovTypes(uint64(m.Step))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
 
-func (m *Message_PubKeyRequest) Size() (n int) {
+func (m *ExportSignStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PubKeyRequest != nil {
-		l = m.PubKeyRequest.Size()
+	l = len(m.ChainId)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
-func (m *Message_PubKeyResponse) Size() (n int) {
+
+func (m *ExportSignStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PubKeyResponse != nil {
-		l = m.PubKeyResponse.Size()
+	l = m.SignState.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
-func (m *Message_SignVoteRequest) Size() (n int) {
+
+func (m *ImportSignStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SignVoteRequest != nil {
-		l = m.SignVoteRequest.Size()
+	if m.SignState != nil {
+		l = m.SignState.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
-func (m *Message_SignedVoteResponse) Size() (n int) {
+
+func (m *ImportSignStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SignedVoteResponse != nil {
-		l = m.SignedVoteResponse.Size()
+	if m.Error != nil {
+		l = m.Error.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
-func (m *Message_SignProposalRequest) Size() (n int) {
+
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Message_PubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKeyRequest != nil {
+		l = m.PubKeyRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_PubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKeyResponse != nil {
+		l = m.PubKeyResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_SignVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignVoteRequest != nil {
+		l = m.SignVoteRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_SignedVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedVoteResponse != nil {
+		l = m.SignedVoteResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_SignProposalRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1602,6 +2322,54 @@ func (m *Message_PingResponse) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_ExportSignStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExportSignStateRequest != nil {
+		l = m.ExportSignStateRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_ExportSignStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExportSignStateResponse != nil {
+		l = m.ExportSignStateResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_ImportSignStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ImportSignStateRequest != nil {
+		l = m.ImportSignStateRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_ImportSignStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ImportSignStateResponse != nil {
+		l = m.ImportSignStateResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *AuthSigMessage) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2134,12 +2902,606 @@ func (m *SignedVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &RemoteSignerError{}
-			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &types.Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportSignStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportSignStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportSignStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2162,7 +3524,7 @@ func (m *SignedVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportSignStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,15 +3547,15 @@ func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportSignStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportSignStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2220,18 +3582,15 @@ func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &types.Proposal{}
-			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2241,23 +3600,27 @@ func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2280,7 +3643,7 @@ func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *ImportSignStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2303,15 +3666,15 @@ func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignedProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportSignStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignedProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportSignStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2338,15 +3701,18 @@ func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SignState == nil {
+				m.SignState = &SignState{}
+			}
+			if err := m.SignState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2356,27 +3722,23 @@ func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &RemoteSignerError{}
-			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2399,7 +3761,7 @@ func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportSignStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2422,62 +3784,48 @@ func (m *PingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportSignStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportSignStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2808,6 +4156,146 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_PingResponse{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportSignStateRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExportSignStateRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_ExportSignStateRequest{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportSignStateResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExportSignStateResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_ExportSignStateResponse{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportSignStateRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ImportSignStateRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_ImportSignStateRequest{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportSignStateResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ImportSignStateResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_ImportSignStateResponse{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
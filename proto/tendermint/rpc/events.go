@@ -0,0 +1,6 @@
+package rpc
+
+// OldestCursorTrailer is the key of the trailer metadata in which the Events
+// method reports the cursor of the oldest item of the event log, when it
+// fails because items after the cursor of the request have been pruned.
+const OldestCursorTrailer = "oldest-cursor"
@@ -0,0 +1,159 @@
+// Package grpc implements a client for the EventsAPI gRPC service of a node,
+// which streams the events of the node's event log that match a query. The
+// events are delivered in protobuf encoding, without the overhead of the JSON
+// encoding of the events RPC method, and the stream is resumable from the
+// cursor of the last event delivered.
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tmnet "github.com/ari-anchor/sei-tendermint/libs/net"
+	tmrpc "github.com/ari-anchor/sei-tendermint/proto/tendermint/rpc"
+	"github.com/ari-anchor/sei-tendermint/rpc/client/eventstream"
+	"github.com/ari-anchor/sei-tendermint/types"
+)
+
+// Item is an event delivered by a Stream.
+type Item struct {
+	Cursor string          // the cursor of the item in the event log
+	Event  string          // the type of the event, such as "Tx"
+	Data   types.EventData // the data of the event
+}
+
+// Client is a client of the EventsAPI service of a node.
+type Client struct {
+	conn *grpc.ClientConn
+	api  tmrpc.EventsAPIClient
+}
+
+// New constructs a client of the EventsAPI service on conn. Closing the
+// client closes conn.
+func New(conn *grpc.ClientConn) *Client {
+	return &Client{conn: conn, api: tmrpc.NewEventsAPIClient(conn)}
+}
+
+// Dial connects to the EventsAPI service of a node at addr, which is
+// prefixed with its protocol, such as "tcp://127.0.0.1:26660" or
+// "unix:///tmp/grpc.sock". Unless opts provide transport credentials, the
+// connection is insecure.
+func Dial(ctx context.Context, addr string, opts ...grpc.DialOption) (*Client, error) {
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialerFunc),
+	}, opts...)
+	conn, err := grpc.DialContext(ctx, addr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("dialing %s: %w", addr, err)
+	}
+	return New(conn), nil
+}
+
+func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
+	proto, address := tmnet.ProtocolAndAddress(addr)
+	var d net.Dialer
+	return d.DialContext(ctx, proto, address)
+}
+
+// Close closes the connection of the client.
+func (c *Client) Close() error { return c.conn.Close() }
+
+// Stream constructs a stream of the events matching query, which is empty to
+// match all events. If opts == nil, the stream uses default values as
+// described by StreamOptions.
+func (c *Client) Stream(query string, opts *StreamOptions) *Stream {
+	var resumeFrom string
+	if opts != nil {
+		resumeFrom = opts.ResumeFrom
+	}
+	return &Stream{api: c.api, query: query, newestSeen: resumeFrom}
+}
+
+// StreamOptions are optional settings for a Stream value. A nil
+// *StreamOptions is ready for use and provides default values as described.
+type StreamOptions struct {
+	// If set, resume streaming after this cursor. Typically this is the cursor
+	// of the most recently received item, saved by a previous run of the
+	// client. If empty, streaming begins with the events published after the
+	// stream starts (the default).
+	ResumeFrom string
+}
+
+// A Stream captures the state of a streaming event subscription.
+type Stream struct {
+	api        tmrpc.EventsAPIClient
+	query      string
+	newestSeen string // the cursor of the latest item delivered
+}
+
+// Run streams the events matching the query from the service, and calls
+// accept for each such event, in order of publication.
+//
+// Run continues until ctx ends or accept reports an error. If accept returns
+// eventstream.ErrStopRunning, Run returns nil; otherwise Run returns the error
+// reported by accept or ctx. Run also returns an error if the stream fails,
+// for example if the connection to the node is lost. Calling Run again
+// resumes the stream after the last event delivered.
+//
+// If the stream falls behind the event log on the node, Run will stop and
+// report an error of concrete type *eventstream.MissedItemsError. Call Reset
+// to reset the stream to the head of the log, and call Run again to resume.
+func (s *Stream) Run(ctx context.Context, accept func(*Item) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	es, err := s.api.Events(ctx, &tmrpc.EventsRequest{
+		Query: s.query,
+		After: s.newestSeen,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		pb, err := es.Recv()
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if status.Code(err) == codes.OutOfRange {
+				missed := &eventstream.MissedItemsError{
+					Query:      s.query,
+					NewestSeen: s.newestSeen,
+				}
+				if oldest := es.Trailer().Get(tmrpc.OldestCursorTrailer); len(oldest) != 0 {
+					missed.OldestPresent = oldest[0]
+				}
+				return missed
+			}
+			return err
+		}
+
+		data, err := types.EventDataFromProto(&pb.Data)
+		if err != nil {
+			return fmt.Errorf("decoding event %s: %w", pb.Cursor, err)
+		}
+		err = accept(&Item{Cursor: pb.Cursor, Event: pb.Event, Data: data})
+		s.newestSeen = pb.Cursor // update the latest delivered
+		if errors.Is(err, eventstream.ErrStopRunning) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// Cursor returns the cursor of the latest event delivered by the stream, from
+// which a stream may be resumed with StreamOptions.ResumeFrom. It is empty if
+// no event was delivered.
+func (s *Stream) Cursor() string { return s.newestSeen }
+
+// Reset updates the stream's current cursor position to the head of the log.
+// This method may safely be called only when Run is not executing.
+func (s *Stream) Reset() { s.newestSeen = "" }
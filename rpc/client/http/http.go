@@ -274,6 +274,22 @@ func (c *baseRPCClient) NumUnconfirmedTxs(ctx context.Context) (*coretypes.Resul
 	return result, nil
 }
 
+func (c *baseRPCClient) MempoolTxs(ctx context.Context, req *coretypes.RequestMempoolTxs) (*coretypes.ResultMempoolTxs, error) {
+	result := new(coretypes.ResultMempoolTxs)
+	if err := c.caller.Call(ctx, "mempool_txs", req, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) MempoolTx(ctx context.Context, txKey types.TxKey) (*coretypes.ResultMempoolTx, error) {
+	result := new(coretypes.ResultMempoolTx)
+	if err := c.caller.Call(ctx, "mempool_tx", &coretypes.RequestMempoolTx{TxKey: txKey}, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*coretypes.ResultCheckTx, error) {
 	result := new(coretypes.ResultCheckTx)
 	if err := c.caller.Call(ctx, "check_tx", &coretypes.RequestCheckTx{Tx: tx}, result); err != nil {
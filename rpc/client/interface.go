@@ -165,6 +165,8 @@ type SubscriptionClient interface {
 type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, page, perPage *int) (*coretypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(context.Context) (*coretypes.ResultUnconfirmedTxs, error)
+	MempoolTxs(context.Context, *coretypes.RequestMempoolTxs) (*coretypes.ResultMempoolTxs, error)
+	MempoolTx(context.Context, types.TxKey) (*coretypes.ResultMempoolTx, error)
 	CheckTx(context.Context, types.Tx) (*coretypes.ResultCheckTx, error)
 	RemoveTx(context.Context, types.TxKey) error
 }
@@ -110,6 +110,14 @@ func (c *Local) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfi
 	return c.env.NumUnconfirmedTxs(ctx)
 }
 
+func (c *Local) MempoolTxs(ctx context.Context, req *coretypes.RequestMempoolTxs) (*coretypes.ResultMempoolTxs, error) {
+	return c.env.MempoolTxs(ctx, req)
+}
+
+func (c *Local) MempoolTx(ctx context.Context, txKey types.TxKey) (*coretypes.ResultMempoolTx, error) {
+	return c.env.MempoolTx(ctx, &coretypes.RequestMempoolTx{TxKey: txKey})
+}
+
 func (c *Local) CheckTx(ctx context.Context, tx types.Tx) (*coretypes.ResultCheckTx, error) {
 	return c.env.CheckTx(ctx, &coretypes.RequestCheckTx{Tx: tx})
 }
@@ -572,6 +572,52 @@ func (_m *Client) Health(_a0 context.Context) (*coretypes.ResultHealth, error) {
 	return r0, r1
 }
 
+// MempoolTx provides a mock function with given fields: _a0, _a1
+func (_m *Client) MempoolTx(_a0 context.Context, _a1 types.TxKey) (*coretypes.ResultMempoolTx, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *coretypes.ResultMempoolTx
+	if rf, ok := ret.Get(0).(func(context.Context, types.TxKey) *coretypes.ResultMempoolTx); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultMempoolTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.TxKey) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MempoolTxs provides a mock function with given fields: _a0, _a1
+func (_m *Client) MempoolTxs(_a0 context.Context, _a1 *coretypes.RequestMempoolTxs) (*coretypes.ResultMempoolTxs, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *coretypes.ResultMempoolTxs
+	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.RequestMempoolTxs) *coretypes.ResultMempoolTxs); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultMempoolTxs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *coretypes.RequestMempoolTxs) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NetInfo provides a mock function with given fields: _a0
 func (_m *Client) NetInfo(_a0 context.Context) (*coretypes.ResultNetInfo, error) {
 	ret := _m.Called(_a0)
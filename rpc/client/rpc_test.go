@@ -671,6 +671,49 @@ func TestClientMethodCallsAdvanced(t *testing.T) {
 
 		pool.Flush()
 	})
+	t.Run("MempoolTxs", func(t *testing.T) {
+		ch := make(chan struct{})
+
+		pool := getMempool(t, n)
+
+		_, _, tx := MakeTxKV()
+
+		err := pool.CheckTx(ctx, tx, func(_ *abci.ResponseCheckTx) { close(ch) }, mempool.TxInfo{})
+		require.NoError(t, err)
+
+		// wait for tx to arrive in mempoool.
+		select {
+		case <-ch:
+		case <-time.After(5 * time.Second):
+			t.Error("Timed out waiting for CheckTx callback")
+		}
+
+		for i, c := range GetClients(t, n, conf) {
+			mc, ok := c.(client.MempoolClient)
+			require.True(t, ok, "%d", i)
+			res, err := mc.MempoolTxs(ctx, &coretypes.RequestMempoolTxs{})
+			require.NoError(t, err, "%d: %+v", i, err)
+			require.Equal(t, 1, res.Total)
+			require.Len(t, res.Txs, 1)
+			assert.Equal(t, types.Tx(tx), res.Txs[0].Tx)
+			assert.EqualValues(t, types.Tx(tx).Hash(), res.Txs[0].Hash)
+
+			// The age filters are given in seconds.
+			minAge := coretypes.Int64(3600)
+			aged, err := mc.MempoolTxs(ctx, &coretypes.RequestMempoolTxs{MinAge: &minAge})
+			require.NoError(t, err, "%d: %+v", i, err)
+			require.Zero(t, aged.Total)
+
+			entry, err := mc.MempoolTx(ctx, types.Tx(tx).Key())
+			require.NoError(t, err, "%d: %+v", i, err)
+			assert.Equal(t, res.Txs[0], entry)
+
+			_, err = mc.MempoolTx(ctx, types.Tx("missing").Key())
+			assert.Error(t, err)
+		}
+
+		pool.Flush()
+	})
 	t.Run("Tx", func(t *testing.T) {
 		logger := log.NewTestingLogger(t)
 
@@ -78,6 +78,26 @@ type RequestUnconfirmedTxs struct {
 	PerPage *Int64 `json:"per_page"`
 }
 
+// RequestMempoolTxs selects transactions in the mempool. Filters left empty do
+// not restrict the selection.
+type RequestMempoolTxs struct {
+	Sender      string `json:"sender"`
+	MinPriority *Int64 `json:"min_priority"`
+	MaxPriority *Int64 `json:"max_priority"`
+
+	// MinAge and MaxAge bound the time elapsed since the node received the
+	// transaction, in seconds.
+	MinAge *Int64 `json:"min_age"`
+	MaxAge *Int64 `json:"max_age"`
+
+	Page    *Int64 `json:"page"`
+	PerPage *Int64 `json:"per_page"`
+}
+
+type RequestMempoolTx struct {
+	TxKey types.TxKey `json:"txkey"`
+}
+
 type RequestBroadcastTx struct {
 	Tx types.Tx `json:"tx"`
 }
@@ -144,6 +164,13 @@ type EventFilter struct {
 	Query string `json:"query"`
 }
 
+// RequestWAL is the argument for the "/wal" endpoint of the inspector.
+type RequestWAL struct {
+	// The number of the last heights recorded in the WAL to return. If zero,
+	// only the last height is returned.
+	Heights *Int64 `json:"heights"`
+}
+
 // Int64 is a wrapper for int64 that encodes to JSON as a string and can be
 // decoded from either a string or a number value.
 type Int64 int64
@@ -13,6 +13,7 @@ import (
 	"github.com/ari-anchor/sei-tendermint/libs/bytes"
 	tmproto "github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
 	"github.com/ari-anchor/sei-tendermint/types"
+	"github.com/ari-anchor/sei-tendermint/version"
 )
 
 // List of standardized errors used across RPC
@@ -204,6 +205,23 @@ type PeerConnection struct {
 	ID    types.NodeID `json:"node_id"`
 	State string       `json:"state"`
 	Score int          `json:"score,string"`
+
+	// BlockSync holds the statistics of the block sync requests made to the
+	// peer, if any.
+	BlockSync *PeerBlockSyncInfo `json:"block_sync,omitempty"`
+}
+
+// PeerBlockSyncInfo are the statistics of the block sync requests made to a
+// peer.
+type PeerBlockSyncInfo struct {
+	Height      int64 `json:"height,string"`
+	NumPending  int32 `json:"num_pending"`
+	NumReceived int64 `json:"num_received,string"`
+	// LatencyMs is the average latency of the block requests in
+	// milliseconds.
+	LatencyMs float64 `json:"latency_ms"`
+	// Throughput is in bytes per second.
+	Throughput float64 `json:"throughput"`
 }
 
 // Validators for a height.
@@ -291,6 +309,38 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// ResultMempoolTx describes a transaction in the mempool.
+type ResultMempoolTx struct {
+	Tx        types.Tx       `json:"tx"`
+	Hash      bytes.HexBytes `json:"hash"`
+	Priority  int64          `json:"priority,string"`
+	Sender    string         `json:"sender"`
+	Nonce     uint64         `json:"nonce,string"`
+	GasWanted int64          `json:"gas_wanted,string"`
+	Height    int64          `json:"height,string"`
+	Timestamp time.Time      `json:"timestamp"`
+
+	// Peers holds the mempool IDs of the peers the transaction was received
+	// from, where 0 stands for a transaction submitted through RPC.
+	Peers []uint16 `json:"peers"`
+
+	// Pending is true if the transaction waits for a transaction of the same
+	// sender with a lower nonce, and cannot be included in a block until then.
+	Pending bool `json:"pending"`
+
+	// Evictable is true if the transaction can be evicted to make room for
+	// another transaction: pending transactions for any transaction that is
+	// not pending, and the others for transactions of higher priority.
+	Evictable bool `json:"evictable"`
+}
+
+// List of selected mempool txs
+type ResultMempoolTxs struct {
+	Count int                `json:"n_txs,string"`
+	Total int                `json:"total,string"`
+	Txs   []*ResultMempoolTx `json:"txs"`
+}
+
 // Info abci msg
 type ResultABCIInfo struct {
 	Response abci.ResponseInfo `json:"response"`
@@ -467,3 +517,113 @@ type EventItem struct {
 	// The known type tags are defined by the tendermint/types package.
 	Data json.RawMessage `json:"data"`
 }
+
+// The results below are served only by the inspector of a failed node, and
+// are UNSTABLE.
+
+// ResultState is the consensus state of a node after the block at
+// LastBlockHeight was committed.
+type ResultState struct {
+	Version  version.Consensus `json:"version"`
+	Software string            `json:"software,omitempty"`
+
+	ChainID       string `json:"chain_id"`
+	InitialHeight int64  `json:"initial_height,string"`
+
+	LastBlockHeight int64         `json:"last_block_height,string"`
+	LastBlockID     types.BlockID `json:"last_block_id"`
+	LastBlockTime   time.Time     `json:"last_block_time"`
+
+	NextValidators *types.ValidatorSet `json:"next_validators"`
+	Validators     *types.ValidatorSet `json:"validators"`
+	LastValidators *types.ValidatorSet `json:"last_validators"`
+
+	ConsensusParams types.ConsensusParams `json:"consensus_params"`
+
+	// The heights at which the validators and the consensus params last
+	// changed are only recorded in the latest state.
+	LastHeightValidatorsChanged      int64 `json:"last_height_validators_changed,string,omitempty"`
+	LastHeightConsensusParamsChanged int64 `json:"last_height_consensus_params_changed,string,omitempty"`
+
+	LastResultsHash bytes.HexBytes `json:"last_results_hash"`
+	AppHash         bytes.HexBytes `json:"app_hash"`
+
+	// Latest is true if this is the latest state saved by the node, and false
+	// if it was reconstructed from the stores for an earlier height.
+	Latest bool `json:"latest"`
+}
+
+// ResultFinalizeBlockResponse is the response of the application to
+// FinalizeBlock for the block at a height.
+type ResultFinalizeBlockResponse struct {
+	Height   int64                       `json:"height,string"`
+	Response *abci.ResponseFinalizeBlock `json:"response"`
+}
+
+// ResultValidatorSetHistory lists the changes of the validator set within a
+// range of heights.
+type ResultValidatorSetHistory struct {
+	// The validator set at the first height of the range, followed by each
+	// validator set that differs from the one at the height before.
+	Changes []ValidatorSetChange `json:"changes"`
+}
+
+// ValidatorSetChange is a validator set and the height from which it applied.
+type ValidatorSetChange struct {
+	Height     int64               `json:"height,string"`
+	Hash       bytes.HexBytes      `json:"hash"`
+	Validators *types.ValidatorSet `json:"validators"`
+}
+
+// ResultConsensusParamsHistory lists the changes of the consensus params
+// within a range of heights.
+type ResultConsensusParamsHistory struct {
+	// The consensus params at the first height of the range, followed by the
+	// consensus params that differ from those at the height before.
+	Changes []ConsensusParamsChange `json:"changes"`
+}
+
+// ConsensusParamsChange is a set of consensus params and the height from
+// which they applied.
+type ConsensusParamsChange struct {
+	Height          int64                 `json:"height,string"`
+	Hash            bytes.HexBytes        `json:"hash"`
+	ConsensusParams types.ConsensusParams `json:"consensus_params"`
+}
+
+// ResultWAL is the contents of the consensus write-ahead log of the node for
+// its last heights.
+type ResultWAL struct {
+	// The messages, from the oldest to the newest, each encoded as a JSON
+	// object with the time and the content of the message. The messages of a
+	// height end with a message whose content is of type EndHeightMessage.
+	Messages []json.RawMessage `json:"messages"`
+
+	// If the WAL is corrupted, the reason why the messages after the last one
+	// returned could not be read.
+	Error string `json:"error,omitempty"`
+}
+
+// ResultStoreDiff reports the inconsistencies between the state store and
+// the block store of a node within a range of heights.
+type ResultStoreDiff struct {
+	StateHeight      int64 `json:"state_height,string"`
+	BlockStoreBase   int64 `json:"block_store_base,string"`
+	BlockStoreHeight int64 `json:"block_store_height,string"`
+	MinHeight        int64 `json:"min_height,string"`
+	MaxHeight        int64 `json:"max_height,string"`
+
+	Mismatches []StoreMismatch `json:"mismatches"`
+}
+
+// StoreMismatch is a value at a height that differs between the state store
+// and the block store, or that is missing from one of them.
+type StoreMismatch struct {
+	Height int64  `json:"height,string"`
+	Field  string `json:"field"` // for example, "app_hash"
+
+	// The value derived from the state store and the value recorded in the
+	// block store, or the reason it could not be loaded.
+	StateStore string `json:"state_store"`
+	BlockStore string `json:"block_store"`
+}
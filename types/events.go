@@ -2,12 +2,14 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
 	abci "github.com/ari-anchor/sei-tendermint/abci/types"
 	"github.com/ari-anchor/sei-tendermint/internal/jsontypes"
 	tmquery "github.com/ari-anchor/sei-tendermint/internal/pubsub/query"
+	tmrpc "github.com/ari-anchor/sei-tendermint/proto/tendermint/rpc"
 	"github.com/ari-anchor/sei-tendermint/proto/tendermint/types"
 )
 
@@ -448,6 +450,257 @@ func (e EventDataEvidenceValidated) ToLegacy() LegacyEventData {
 	return e
 }
 
+// PROTO
+
+// EventDataToProto encodes the data of an event to protobuf.
+func EventDataToProto(data EventData) (*tmrpc.EventData, error) {
+	var pb tmrpc.EventData
+	switch d := data.(type) {
+	case EventDataNewBlock:
+		pbd := &tmrpc.EventDataNewBlock{
+			BlockId:             d.BlockID.ToProto(),
+			ResultFinalizeBlock: d.ResultFinalizeBlock,
+		}
+		if d.Block != nil {
+			block, err := d.Block.ToProto()
+			if err != nil {
+				return nil, err
+			}
+			pbd.Block = block
+		}
+		pb.Sum = &tmrpc.EventData_NewBlock{NewBlock: pbd}
+
+	case EventDataNewBlockHeader:
+		pb.Sum = &tmrpc.EventData_NewBlockHeader{NewBlockHeader: &tmrpc.EventDataNewBlockHeader{
+			Header:              *d.Header.ToProto(),
+			NumTxs:              d.NumTxs,
+			ResultFinalizeBlock: d.ResultFinalizeBlock,
+		}}
+
+	case EventDataNewEvidence:
+		pbd, err := evidenceDataToProto(d.Evidence, d.Height)
+		if err != nil {
+			return nil, err
+		}
+		pb.Sum = &tmrpc.EventData_NewEvidence{NewEvidence: pbd}
+
+	case EventDataEvidenceValidated:
+		pbd, err := evidenceDataToProto(d.Evidence, d.Height)
+		if err != nil {
+			return nil, err
+		}
+		pb.Sum = &tmrpc.EventData_EvidenceValidated{EvidenceValidated: pbd}
+
+	case EventDataTx:
+		pb.Sum = &tmrpc.EventData_Tx{Tx: &tmrpc.EventDataTx{TxResult: d.TxResult}}
+
+	case EventDataRoundState:
+		pb.Sum = &tmrpc.EventData_RoundState{RoundState: &types.EventDataRoundState{
+			Height: d.Height,
+			Round:  d.Round,
+			Step:   d.Step,
+		}}
+
+	case EventDataNewRound:
+		pb.Sum = &tmrpc.EventData_NewRound{NewRound: &tmrpc.EventDataNewRound{
+			Height: d.Height,
+			Round:  d.Round,
+			Step:   d.Step,
+			Proposer: tmrpc.ValidatorInfo{
+				Address: d.Proposer.Address,
+				Index:   d.Proposer.Index,
+			},
+		}}
+
+	case EventDataCompleteProposal:
+		pb.Sum = &tmrpc.EventData_CompleteProposal{CompleteProposal: &tmrpc.EventDataCompleteProposal{
+			Height:  d.Height,
+			Round:   d.Round,
+			Step:    d.Step,
+			BlockId: d.BlockID.ToProto(),
+		}}
+
+	case EventDataVote:
+		pbd := &tmrpc.EventDataVote{}
+		if d.Vote != nil {
+			pbd.Vote = d.Vote.ToProto()
+		}
+		pb.Sum = &tmrpc.EventData_Vote{Vote: pbd}
+
+	case EventDataString:
+		pb.Sum = &tmrpc.EventData_Text{Text: string(d)}
+
+	case EventDataValidatorSetUpdates:
+		pbd := &tmrpc.EventDataValidatorSetUpdates{}
+		for _, val := range d.ValidatorUpdates {
+			pbv, err := val.ToProto()
+			if err != nil {
+				return nil, err
+			}
+			pbd.ValidatorUpdates = append(pbd.ValidatorUpdates, pbv)
+		}
+		pb.Sum = &tmrpc.EventData_ValidatorSetUpdates{ValidatorSetUpdates: pbd}
+
+	case EventDataBlockSyncStatus:
+		pb.Sum = &tmrpc.EventData_BlockSyncStatus{BlockSyncStatus: &tmrpc.EventDataSyncStatus{
+			Complete: d.Complete,
+			Height:   d.Height,
+		}}
+
+	case EventDataStateSyncStatus:
+		pb.Sum = &tmrpc.EventData_StateSyncStatus{StateSyncStatus: &tmrpc.EventDataSyncStatus{
+			Complete: d.Complete,
+			Height:   d.Height,
+		}}
+
+	default:
+		return nil, fmt.Errorf("toproto: event data is not recognized: %T", data)
+	}
+	return &pb, nil
+}
+
+func evidenceDataToProto(ev Evidence, height int64) (*tmrpc.EventDataNewEvidence, error) {
+	pbd := &tmrpc.EventDataNewEvidence{Height: height}
+	if ev != nil {
+		pbev, err := EvidenceToProto(ev)
+		if err != nil {
+			return nil, err
+		}
+		pbd.Evidence = pbev
+	}
+	return pbd, nil
+}
+
+// EventDataFromProto decodes the data of an event from protobuf.
+func EventDataFromProto(pb *tmrpc.EventData) (EventData, error) {
+	if pb == nil {
+		return nil, errors.New("nil event data")
+	}
+
+	switch sum := pb.Sum.(type) {
+	case *tmrpc.EventData_NewBlock:
+		blockID, err := BlockIDFromProto(&sum.NewBlock.BlockId)
+		if err != nil {
+			return nil, err
+		}
+		d := EventDataNewBlock{
+			BlockID:             *blockID,
+			ResultFinalizeBlock: sum.NewBlock.ResultFinalizeBlock,
+		}
+		if sum.NewBlock.Block != nil {
+			if d.Block, err = BlockFromProto(sum.NewBlock.Block); err != nil {
+				return nil, err
+			}
+		}
+		return d, nil
+
+	case *tmrpc.EventData_NewBlockHeader:
+		header, err := HeaderFromProto(&sum.NewBlockHeader.Header)
+		if err != nil {
+			return nil, err
+		}
+		return EventDataNewBlockHeader{
+			Header:              header,
+			NumTxs:              sum.NewBlockHeader.NumTxs,
+			ResultFinalizeBlock: sum.NewBlockHeader.ResultFinalizeBlock,
+		}, nil
+
+	case *tmrpc.EventData_NewEvidence:
+		ev, err := evidenceDataFromProto(sum.NewEvidence)
+		if err != nil {
+			return nil, err
+		}
+		return EventDataNewEvidence{Evidence: ev, Height: sum.NewEvidence.Height}, nil
+
+	case *tmrpc.EventData_EvidenceValidated:
+		ev, err := evidenceDataFromProto(sum.EvidenceValidated)
+		if err != nil {
+			return nil, err
+		}
+		return EventDataEvidenceValidated{Evidence: ev, Height: sum.EvidenceValidated.Height}, nil
+
+	case *tmrpc.EventData_Tx:
+		return EventDataTx{TxResult: sum.Tx.TxResult}, nil
+
+	case *tmrpc.EventData_RoundState:
+		return EventDataRoundState{
+			Height: sum.RoundState.Height,
+			Round:  sum.RoundState.Round,
+			Step:   sum.RoundState.Step,
+		}, nil
+
+	case *tmrpc.EventData_NewRound:
+		return EventDataNewRound{
+			Height: sum.NewRound.Height,
+			Round:  sum.NewRound.Round,
+			Step:   sum.NewRound.Step,
+			Proposer: ValidatorInfo{
+				Address: sum.NewRound.Proposer.Address,
+				Index:   sum.NewRound.Proposer.Index,
+			},
+		}, nil
+
+	case *tmrpc.EventData_CompleteProposal:
+		blockID, err := BlockIDFromProto(&sum.CompleteProposal.BlockId)
+		if err != nil {
+			return nil, err
+		}
+		return EventDataCompleteProposal{
+			Height:  sum.CompleteProposal.Height,
+			Round:   sum.CompleteProposal.Round,
+			Step:    sum.CompleteProposal.Step,
+			BlockID: *blockID,
+		}, nil
+
+	case *tmrpc.EventData_Vote:
+		var d EventDataVote
+		if sum.Vote.Vote != nil {
+			vote, err := VoteFromProto(sum.Vote.Vote)
+			if err != nil {
+				return nil, err
+			}
+			d.Vote = vote
+		}
+		return d, nil
+
+	case *tmrpc.EventData_Text:
+		return EventDataString(sum.Text), nil
+
+	case *tmrpc.EventData_ValidatorSetUpdates:
+		var d EventDataValidatorSetUpdates
+		for _, pbv := range sum.ValidatorSetUpdates.ValidatorUpdates {
+			val, err := ValidatorFromProto(pbv)
+			if err != nil {
+				return nil, err
+			}
+			d.ValidatorUpdates = append(d.ValidatorUpdates, val)
+		}
+		return d, nil
+
+	case *tmrpc.EventData_BlockSyncStatus:
+		return EventDataBlockSyncStatus{
+			Complete: sum.BlockSyncStatus.Complete,
+			Height:   sum.BlockSyncStatus.Height,
+		}, nil
+
+	case *tmrpc.EventData_StateSyncStatus:
+		return EventDataStateSyncStatus{
+			Complete: sum.StateSyncStatus.Complete,
+			Height:   sum.StateSyncStatus.Height,
+		}, nil
+
+	default:
+		return nil, errors.New("event data is not recognized")
+	}
+}
+
+func evidenceDataFromProto(pb *tmrpc.EventDataNewEvidence) (Evidence, error) {
+	if pb.Evidence == nil {
+		return nil, nil
+	}
+	return EvidenceFromProto(pb.Evidence)
+}
+
 // PUBSUB
 
 const (
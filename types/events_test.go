@@ -1,11 +1,14 @@
 package types
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ari-anchor/sei-tendermint/abci/types"
+	"github.com/ari-anchor/sei-tendermint/crypto/ed25519"
 	"github.com/ari-anchor/sei-tendermint/internal/jsontypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -64,3 +67,48 @@ func TestTryUnmarshalForEvent(t *testing.T) {
 	_, err = TryUnmarshalEventData(garbage)
 	require.Error(t, err)
 }
+
+func TestEventDataProto(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := time.Now().UTC()
+	block := MakeBlock(3, []Tx{Tx("foo")}, randCommit(ctx, t, now), EvidenceList{})
+	ev, err := NewMockDuplicateVoteEvidence(ctx, 2, now, "test-chain")
+	require.NoError(t, err)
+	block.Header.Time = now
+	block.ProposerAddress = ev.VoteA.ValidatorAddress
+	blockID := makeBlockIDRandom()
+	result := types.ResponseFinalizeBlock{
+		Events: []types.Event{{Type: "app", Attributes: []types.EventAttribute{{Key: []byte("k"), Value: []byte("v")}}}},
+	}
+
+	for _, data := range []EventData{
+		EventDataNewBlock{Block: block, BlockID: blockID, ResultFinalizeBlock: result},
+		EventDataNewBlockHeader{Header: block.Header, NumTxs: 1, ResultFinalizeBlock: result},
+		EventDataNewEvidence{Evidence: ev, Height: 2},
+		EventDataEvidenceValidated{Evidence: ev, Height: 2},
+		EventDataTx{TxResult: types.TxResult{Height: 3, Index: 1, Tx: []byte("foo")}},
+		EventDataRoundState{Height: 3, Round: 1, Step: "RoundStepPropose"},
+		EventDataNewRound{Height: 3, Round: 1, Step: "RoundStepNewRound", Proposer: ValidatorInfo{Address: ev.VoteA.ValidatorAddress, Index: 2}},
+		EventDataCompleteProposal{Height: 3, Round: 1, Step: "RoundStepPropose", BlockID: blockID},
+		EventDataVote{Vote: examplePrevote(t)},
+		EventDataString("proposal"),
+		EventDataValidatorSetUpdates{ValidatorUpdates: []*Validator{NewValidator(ed25519.GenPrivKey().PubKey(), 10)}},
+		EventDataBlockSyncStatus{Complete: true, Height: 3},
+		EventDataStateSyncStatus{Complete: false, Height: 3},
+	} {
+		t.Run(data.TypeTag(), func(t *testing.T) {
+			pb, err := EventDataToProto(data)
+			require.NoError(t, err)
+			bz, err := pb.Marshal()
+			require.NoError(t, err)
+			pb.Reset()
+			require.NoError(t, pb.Unmarshal(bz))
+
+			got, err := EventDataFromProto(pb)
+			require.NoError(t, err)
+			assert.Equal(t, data, got)
+		})
+	}
+}
@@ -85,6 +85,27 @@ func (e ErrMempoolIsFull) Error() string {
 	)
 }
 
+// ErrMempoolQuotaExceeded defines an error where a single sender or peer would
+// exceed its quota of the mempool.
+type ErrMempoolQuotaExceeded struct {
+	Quota       string // "sender" or "peer"
+	NumTxs      int
+	MaxTxs      int
+	TxsBytes    int64
+	MaxTxsBytes int64
+}
+
+func (e ErrMempoolQuotaExceeded) Error() string {
+	return fmt.Sprintf(
+		"mempool %s quota exceeded: number of txs %d (max: %d), total txs bytes %d (max: %d)",
+		e.Quota,
+		e.NumTxs,
+		e.MaxTxs,
+		e.TxsBytes,
+		e.MaxTxsBytes,
+	)
+}
+
 // ErrPreCheck defines an error where a transaction fails a pre-check.
 type ErrPreCheck struct {
 	Reason error
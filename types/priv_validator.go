@@ -21,6 +21,7 @@ const (
 	SignerSocketClient    = PrivValidatorType(0x03) // signer client via socket
 	ErrorMockSignerClient = PrivValidatorType(0x04) // error mock signer
 	SignerGRPCClient      = PrivValidatorType(0x05) // signer client via gRPC
+	ThresholdSignerClient = PrivValidatorType(0x06) // threshold of signer clients
 )
 
 // PrivValidator defines the functionality of a local Tendermint validator
@@ -156,11 +156,28 @@ func (voteSet *VoteSet) AddVote(vote *Vote) (added bool, err error) {
 	voteSet.mtx.Lock()
 	defer voteSet.mtx.Unlock()
 
-	return voteSet.addVote(vote)
+	return voteSet.addVote(vote, false)
+}
+
+// AddPreVerifiedVote is like AddVote, but skips the verification of the
+// signatures of the vote. The caller must have verified the signature of the
+// vote, and its extension signature if the VoteSet is extended, against the
+// chain ID of the VoteSet and the public key of the validator with the
+// address of the vote.
+// NOTE: VoteSet must not be nil
+// NOTE: Vote must not be nil
+func (voteSet *VoteSet) AddPreVerifiedVote(vote *Vote) (added bool, err error) {
+	if voteSet == nil {
+		panic("AddPreVerifiedVote() on nil VoteSet")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	return voteSet.addVote(vote, true)
 }
 
 // NOTE: Validates as much as possible before attempting to verify the signature.
-func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
+func (voteSet *VoteSet) addVote(vote *Vote, preVerified bool) (added bool, err error) {
 	if vote == nil {
 		return false, ErrVoteNil
 	}
@@ -210,12 +227,20 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 
 	// Check signature.
 	if voteSet.extensionsEnabled {
-		if err := vote.VerifyVoteAndExtension(voteSet.chainID, val.PubKey); err != nil {
+		if preVerified {
+			// The extension signature of non-nil precommits is mandatory.
+			if vote.Type == tmproto.PrecommitType && !vote.BlockID.IsNil() && len(vote.ExtensionSignature) == 0 {
+				return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w",
+					voteSet.chainID, val.PubKey, ErrVoteInvalidSignature)
+			}
+		} else if err := vote.VerifyVoteAndExtension(voteSet.chainID, val.PubKey); err != nil {
 			return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
 		}
 	} else {
-		if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
-			return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
+		if !preVerified {
+			if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
+				return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
+			}
 		}
 		if len(vote.ExtensionSignature) > 0 || len(vote.Extension) > 0 {
 			return false, errors.New("unexpected vote extension data present in vote")
@@ -130,6 +130,44 @@ func TestVoteSet_AddVote_Bad(t *testing.T) {
 
 }
 
+func TestVoteSet_AddPreVerifiedVote(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	height, round := int64(1), int32(0)
+	valSet, privValidators := randValidatorPrivValSet(ctx, t, 5, 10)
+	voteSet := NewExtendedVoteSet("test_chain_id", height, round, tmproto.PrecommitType, valSet)
+
+	val0p, err := privValidators[0].GetPubKey(ctx)
+	require.NoError(t, err)
+	blockHash := crypto.CRandBytes(32)
+	vote := &Vote{
+		ValidatorAddress: val0p.Address(),
+		ValidatorIndex:   0,
+		Height:           height,
+		Round:            round,
+		Type:             tmproto.PrecommitType,
+		Timestamp:        tmtime.Now(),
+		BlockID:          BlockID{blockHash, PartSetHeader{123, crypto.CRandBytes(32)}},
+		Signature:        []byte("signature"),
+	}
+
+	// The signatures are not verified, but the mandatory extension signature
+	// must be present.
+	_, err = voteSet.AddPreVerifiedVote(vote)
+	require.ErrorIs(t, err, ErrVoteInvalidSignature)
+	vote.ExtensionSignature = []byte("extension signature")
+	added, err := voteSet.AddPreVerifiedVote(vote)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// The vote must still come from the validator at its index.
+	vote = vote.Copy()
+	vote.ValidatorIndex = 1
+	_, err = voteSet.AddPreVerifiedVote(vote)
+	require.ErrorIs(t, err, ErrVoteInvalidValidatorAddress)
+}
+
 func TestVoteSet_2_3Majority(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()